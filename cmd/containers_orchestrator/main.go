@@ -3,6 +3,7 @@ package main
 import (
 	"github.com/golangci/golangci-api/internal/shared/config"
 	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/tracing"
 	"github.com/golangci/golangci-api/pkg/buildagent/containers"
 )
 
@@ -11,13 +12,20 @@ func main() {
 	log.SetLevel(logutil.LogLevelInfo)
 	cfg := config.NewEnvConfig(log)
 
+	shutdownTracing, err := tracing.Init(cfg, "containers-orchestrator")
+	if err != nil {
+		log.Fatalf("Can't init tracing: %s", err)
+	}
+	tracing.ShutdownOnSignal(log, shutdownTracing)
+	defer tracing.Shutdown(log, shutdownTracing)
+
 	// shutdown server after maxLifetime to prevent staling containers
 	// eating all system resources
 	token := cfg.GetString("TOKEN")
 	r := containers.NewOrchestrator(log, token)
 
 	port := cfg.GetInt("PORT", 8001)
-	if err := r.Run(port); err != nil {
+	if err = r.Run(port); err != nil {
 		log.Warnf("Orchestrator running error: %s", err)
 	}
 }
@@ -109,7 +109,7 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 			httptransport.ServerErrorEncoder(transportutil.EncodeError),
 			httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 		)
-		r.Methods("{{.HTTPMethod}}").Path("{{.URL}}").Handler(h{{.Name}})
+		r.Methods("{{.HTTPMethod}}").Path("{{.URL}}").Handler(metrics.InstrumentHandler("{{$.PkgName}}", "{{.Name}}", h{{.Name}}))
 
 	{{end}}
 }
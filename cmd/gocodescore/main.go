@@ -2,7 +2,9 @@ package main
 
 import (
 	"encoding/json"
+	"flag"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os/exec"
 
@@ -12,6 +14,17 @@ import (
 )
 
 func main() {
+	profilePath := flag.String("profile", "", "path to json file with scoring profile, the default profile is used if it's empty")
+	flag.Parse()
+
+	var profile *score.Profile
+	if *profilePath != "" {
+		var err error
+		if profile, err = loadProfile(*profilePath); err != nil {
+			log.Fatalf("Failed to load scoring profile: %s", err)
+		}
+	}
+
 	cmd := exec.Command("golangci-lint", "run", "--out-format=json", "--issues-exit-code=0")
 	out, err := cmd.Output()
 	if err != nil {
@@ -23,7 +36,7 @@ func main() {
 		log.Fatalf("Failed to json unmarshal golangci-lint output %s: %s", string(out), err)
 	}
 
-	calcRes := score.Calculator{}.Calc(&runRes)
+	calcRes := score.Calculator{Profile: profile}.Calc(&runRes)
 	fmt.Printf("Score: %d/%d\n", calcRes.Score, calcRes.MaxScore)
 	if len(calcRes.Recommendations) != 0 {
 		for _, rec := range calcRes.Recommendations {
@@ -31,3 +44,21 @@ func main() {
 		}
 	}
 }
+
+func loadProfile(path string) (*score.Profile, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile score.Profile
+	if err = json.Unmarshal(data, &profile); err != nil {
+		return nil, fmt.Errorf("invalid json in %s: %s", path, err)
+	}
+
+	if err = profile.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid profile in %s: %s", path, err)
+	}
+
+	return &profile, nil
+}
@@ -101,6 +101,29 @@ func (e PendingError) IsErrorLikeResult() bool {
 	return true
 }
 
+// FileResult responds with the file instead of json, e.g. with a report for CI
+type FileResult struct {
+	ContentType string
+	FileName    string
+	Body        []byte
+}
+
+func (e FileResult) Error() string {
+	return fmt.Sprintf("file %s of type %s, %d bytes", e.FileName, e.ContentType, len(e.Body))
+}
+
+func (e FileResult) IsErrorLikeResult() bool {
+	return true
+}
+
+func NewFileResult(contentType, fileName string, body []byte) *FileResult {
+	return &FileResult{
+		ContentType: contentType,
+		FileName:    fileName,
+		Body:        body,
+	}
+}
+
 type NotAcceptableError struct {
 	code    string
 	message string
@@ -11,6 +11,7 @@ import (
 	"github.com/golangci/golangci-api/internal/shared/logutil"
 	"github.com/golangci/golangci-api/pkg/api/request"
 	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
 )
 
 type contextKey string
@@ -46,7 +47,10 @@ func Error(ctx context.Context) error {
 }
 
 func makeBaseRequestContext(ctx context.Context, sctx *session.RequestContext, hctx *HandlerRegContext) *request.BaseContext {
-	lctx := logutil.Context{}
+	requestID := uuid.NewV4().String()
+	lctx := logutil.Context{
+		"request_id": requestID,
+	}
 	log := hctx.Log
 	log = logutil.WrapLogWithContext(log, lctx)
 	log = apperrors.WrapLogWithTracker(log, lctx, hctx.ErrTracker)
@@ -56,6 +60,7 @@ func makeBaseRequestContext(ctx context.Context, sctx *session.RequestContext, h
 		Log:       log,
 		Lctx:      lctx,
 		DB:        hctx.DB,
+		RequestID: requestID,
 		StartedAt: time.Now(),
 		SessCtx:   sctx,
 	}
@@ -8,14 +8,16 @@ import (
 
 	"github.com/golangci/golangci-api/internal/shared/logutil"
 	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/api/workers/primaryqueue/emails"
 	"github.com/gorilla/schema"
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
 )
 
 type EventProcessor struct {
-	Tx  *gorm.DB
-	Log logutil.Log
+	Tx       *gorm.DB
+	Log      logutil.Log
+	Notifier *emails.SenderProducer
 }
 
 const (
@@ -121,6 +123,32 @@ func (ep EventProcessor) processSubCancelledEvent(ev *subCancelledEvent) error {
 	return nil
 }
 
+func (ep EventProcessor) processSubPaymentFailedEvent(ev *subPaymentFailedEvent) error {
+	var sub models.OrgSub
+	providerSubID := strconv.FormatInt(ev.SubscriptionID, 10)
+	qs := models.NewOrgSubQuerySet(ep.Tx).PaymentGatewaySubscriptionIDEq(providerSubID)
+	if err := qs.One(&sub); err != nil {
+		return errors.Wrapf(err, "failed to fetch sub with payment provider id %s", providerSubID)
+	}
+
+	var org models.Org
+	if err := models.NewOrgQuerySet(ep.Tx).IDEq(sub.OrgID).One(&org); err != nil {
+		return errors.Wrapf(err, "failed to fetch org %d", sub.OrgID)
+	}
+
+	ep.Log.Warnf("Payment for subscription %d of org %s failed, hard failure: %t", sub.ID, org.Name, ev.HardFailure)
+	data := map[string]string{
+		"Org":           org.DisplayName,
+		"NextRetryDate": ev.NextRetryDate,
+		"UpdateURL":     ev.UpdateURL,
+	}
+	if err := ep.Notifier.Put(models.NotificationEventSubPaymentFailed, []uint{sub.BillingUserID}, data, ev.GetID()); err != nil {
+		return errors.Wrap(err, "failed to notify about failed payment")
+	}
+
+	return nil
+}
+
 func (ep EventProcessor) saveEvent(ev eventWithID) error {
 	userID, err := ev.GetUserID()
 	if err != nil {
@@ -168,6 +196,10 @@ func (ep EventProcessor) Process(payload string, eventUUID string) error {
 		if err = ep.processSubCancelledEvent(evWithID.(*subCancelledEvent)); err != nil {
 			return errors.Wrapf(err, "failed to process %s event", evWithID.GetType())
 		}
+	case eventSubPaymentFailed:
+		if err = ep.processSubPaymentFailedEvent(evWithID.(*subPaymentFailedEvent)); err != nil {
+			return errors.Wrapf(err, "failed to process %s event", evWithID.GetType())
+		}
 	}
 
 	return ep.saveEvent(evWithID)
@@ -1,15 +1,22 @@
 package score
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"sort"
 	"strings"
 
 	"github.com/golangci/golangci-lint/pkg/printers"
+	"github.com/pkg/errors"
 )
 
-type Calculator struct{}
+const maxScore = 100
+
+// Calculator uses DefaultProfile if Profile isn't set
+type Calculator struct {
+	Profile *Profile
+}
 
 type Recommendation struct {
 	Text          string
@@ -27,9 +34,15 @@ type weightedLinter struct {
 	weight float64 // importance of linter
 }
 
-func (c Calculator) Calc(runRes *printers.JSONResult) *CalcResult {
-	const maxScore = 100
+func (c Calculator) profile() *Profile {
+	if c.Profile == nil {
+		return DefaultProfile()
+	}
 
+	return c.Profile
+}
+
+func (c Calculator) Calc(runRes *printers.JSONResult) *CalcResult {
 	if runRes.Report == nil {
 		return &CalcResult{
 			Score:    maxScore,
@@ -37,19 +50,26 @@ func (c Calculator) Calc(runRes *printers.JSONResult) *CalcResult {
 		}
 	}
 
+	p := c.profile()
+
 	var recomendations []Recommendation
-	if rec := c.buildRecommendationForDisabledLinters(runRes); rec != nil {
+	if rec := c.buildRecommendationForDisabledLinters(p, runRes); rec != nil {
 		recomendations = append(recomendations, *rec)
 	}
 
-	if rec := c.buildRecommendationForIssues(runRes); rec != nil {
+	if rec := c.buildRecommendationForIssues(p, runRes); rec != nil {
 		recomendations = append(recomendations, *rec)
 	}
 
+	recomendations = append(recomendations, c.buildRecommendationsForRules(p, runRes)...)
+
 	score := maxScore
 	for _, rec := range recomendations {
 		score -= rec.ScoreIncrease
 	}
+	if score < 0 {
+		score = 0
+	}
 
 	return &CalcResult{
 		Score:           score,
@@ -58,7 +78,19 @@ func (c Calculator) Calc(runRes *printers.JSONResult) *CalcResult {
 	}
 }
 
-func (c Calculator) buildRecommendationForDisabledLinters(runRes *printers.JSONResult) *Recommendation {
+// CalcForResultJSON calculates the score for the stored result of the analysis made by the worker
+func (c Calculator) CalcForResultJSON(data []byte) (*CalcResult, error) {
+	var res struct {
+		GolangciLintRes printers.JSONResult
+	}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal result json")
+	}
+
+	return c.Calc(&res.GolangciLintRes), nil
+}
+
+func getEnabledLinters(runRes *printers.JSONResult) map[string]bool {
 	enabledLinters := map[string]bool{}
 	for _, linter := range runRes.Report.Linters {
 		if linter.Enabled {
@@ -66,7 +98,20 @@ func (c Calculator) buildRecommendationForDisabledLinters(runRes *printers.JSONR
 		}
 	}
 
-	linters := c.getNeededLinters(enabledLinters)
+	return enabledLinters
+}
+
+func capPenalty(penalty, maxPenalty int) int {
+	if maxPenalty != 0 && penalty > maxPenalty {
+		return maxPenalty
+	}
+
+	return penalty
+}
+
+func (c Calculator) buildRecommendationForDisabledLinters(p *Profile, runRes *printers.JSONResult) *Recommendation {
+	enabledLinters := getEnabledLinters(runRes)
+	linters := c.getNeededLinters(p, enabledLinters)
 
 	var disabledNeededLinters []weightedLinter
 	for _, wl := range linters {
@@ -88,8 +133,7 @@ func (c Calculator) buildRecommendationForDisabledLinters(runRes *printers.JSONR
 
 	sort.Strings(disabledNeededLinterNames)
 
-	const maxScore = 100
-	score := int(weight * maxScore)
+	score := capPenalty(int(weight*maxScore), p.MaxDisabledLintersPenalty)
 	if score == 0 { // rounded to zero
 		return nil
 	}
@@ -100,27 +144,26 @@ func (c Calculator) buildRecommendationForDisabledLinters(runRes *printers.JSONR
 	}
 }
 
-//nolint:gocyclo
-func (c Calculator) buildRecommendationForIssues(runRes *printers.JSONResult) *Recommendation {
-	enabledLinters := map[string]bool{}
-	for _, linter := range runRes.Report.Linters {
-		if linter.Enabled {
-			enabledLinters[linter.Name] = true
-		}
+func getIssuesPerLinter(runRes *printers.JSONResult) map[string]int {
+	issuesPerLinter := map[string]int{}
+	for _, issue := range runRes.Issues {
+		issuesPerLinter[issue.FromLinter]++
 	}
 
-	linters := c.getNeededLinters(enabledLinters)
+	return issuesPerLinter
+}
+
+//nolint:gocyclo
+func (c Calculator) buildRecommendationForIssues(p *Profile, runRes *printers.JSONResult) *Recommendation {
+	enabledLinters := getEnabledLinters(runRes)
+	linters := c.getNeededLinters(p, enabledLinters)
 
 	lintersMap := map[string]*weightedLinter{}
 	for i := range linters {
 		lintersMap[linters[i].name] = &linters[i]
 	}
 
-	issuesPerLinter := map[string]int{}
-	for _, issue := range runRes.Issues {
-		issuesPerLinter[issue.FromLinter]++
-	}
-
+	issuesPerLinter := getIssuesPerLinter(runRes)
 	if len(issuesPerLinter) == 0 {
 		return nil
 	}
@@ -132,14 +175,13 @@ func (c Calculator) buildRecommendationForIssues(runRes *printers.JSONResult) *R
 			continue // not needed linter
 		}
 
-		if issueCount > 100 {
-			issueCount = 100
+		if issueCount > p.IssuesCap {
+			issueCount = p.IssuesCap
 		}
 
-		// 100 -> 1, 50 -> 0.85, 10 -> 0.5, 5 -> 0.35, 1 -> 0
-		normalizedLog := math.Log10(float64(issueCount)) / 2
-		const minScoreForAnyIssue = 0.2
-		weight += wl.weight * (minScoreForAnyIssue + (1-minScoreForAnyIssue)*normalizedLog)
+		// for the cap 100: 100 -> 1, 50 -> 0.85, 10 -> 0.5, 5 -> 0.35, 1 -> 0
+		normalizedLog := math.Log10(float64(issueCount)) / math.Log10(float64(p.IssuesCap))
+		weight += wl.weight * (p.MinIssuePenalty + (1-p.MinIssuePenalty)*normalizedLog)
 	}
 
 	var neededLintersWithIssues []string
@@ -151,8 +193,7 @@ func (c Calculator) buildRecommendationForIssues(runRes *printers.JSONResult) *R
 
 	sort.Strings(neededLintersWithIssues)
 
-	const maxScore = 100
-	score := int(weight * maxScore)
+	score := capPenalty(int(weight*maxScore), p.MaxIssuesPenalty)
 	if score == 0 { // rounded to zero
 		return nil
 	}
@@ -163,18 +204,38 @@ func (c Calculator) buildRecommendationForIssues(runRes *printers.JSONResult) *R
 	}
 }
 
-func (c Calculator) getNeededLinters(enabledLinters map[string]bool) []weightedLinter {
-	bugsLinters := c.getNeededBugsLintersWeights()
-	styleLinters := c.getNeededStyleLintersWeights(enabledLinters)
+func (c Calculator) buildRecommendationsForRules(p *Profile, runRes *printers.JSONResult) []Recommendation {
+	enabledLinters := getEnabledLinters(runRes)
+	issuesPerLinter := getIssuesPerLinter(runRes)
+
+	var ret []Recommendation
+	for _, r := range p.Rules {
+		triggered := (r.WhenDisabled && !enabledLinters[r.Linter]) ||
+			(r.MinIssues > 0 && issuesPerLinter[r.Linter] >= r.MinIssues)
+		if !triggered || r.Penalty == 0 {
+			continue
+		}
+
+		ret = append(ret, Recommendation{
+			ScoreIncrease: r.Penalty,
+			Text:          r.Text,
+		})
+	}
+
+	return ret
+}
+
+func (c Calculator) getNeededLinters(p *Profile, enabledLinters map[string]bool) []weightedLinter {
+	bugsLinters := c.getNeededLintersWeights(p.BugsLinters, enabledLinters)
+	styleLinters := c.getNeededLintersWeights(p.StyleLinters, enabledLinters)
 
-	const bugsWeight = 0.7
 	var linters []weightedLinter
 	for _, wl := range bugsLinters {
-		wl.weight *= bugsWeight
+		wl.weight *= p.BugsWeight
 		linters = append(linters, wl)
 	}
 	for _, wl := range styleLinters {
-		wl.weight *= 1 - bugsWeight
+		wl.weight *= 1 - p.BugsWeight
 		linters = append(linters, wl)
 	}
 
@@ -188,6 +249,10 @@ func (c Calculator) normalizeWeightedLinters(linters []weightedLinter) []weighte
 		totalWeight += wl.weight
 	}
 
+	if totalWeight == 0 {
+		return res // the group doesn't affect the score
+	}
+
 	for _, wl := range linters {
 		res = append(res, weightedLinter{wl.name, wl.weight / totalWeight})
 	}
@@ -195,40 +260,22 @@ func (c Calculator) normalizeWeightedLinters(linters []weightedLinter) []weighte
 	return res
 }
 
-func (c Calculator) getNeededBugsLintersWeights() []weightedLinter {
-	return c.normalizeWeightedLinters([]weightedLinter{
-		{"govet", 1},
-		{"staticcheck", 1},
-		{"errcheck", 0.8},
-		{"bodyclose", 0.7}, // low because can have false-positives
-		{"typecheck", 0.5},
-	})
-}
-
-func (c Calculator) getNeededStyleLintersWeights(enabledLinters map[string]bool) []weightedLinter {
-	linters := []weightedLinter{
-		{"goimports", 1},
-		{"dogsled", 0.5},
-		{"gochecknoglobals", 0.4}, // low because can have false-positives
-		{"gochecknoinits", 0.4},
-		{"goconst", 0.3},
-		{"golint", 1},
-		{"gosimple", 0.6},
-		{"lll", 0.1},
-		{"misspell", 0.4},
-		{"unconvert", 0.4},
-		{"ineffassign", 0.5},
-	}
-
-	const (
-		gocognit = "gocognit"
-		gocyclo  = "gocyclo"
-	)
-	complexityLinter := gocognit
-	if !enabledLinters[gocognit] && enabledLinters[gocyclo] {
-		complexityLinter = gocyclo
-	}
-	linters = append(linters, weightedLinter{complexityLinter, 0.8})
+// getNeededLintersWeights replaces disabled linters by their enabled alternatives
+func (c Calculator) getNeededLintersWeights(profileLinters []LinterWeight, enabledLinters map[string]bool) []weightedLinter {
+	linters := make([]weightedLinter, 0, len(profileLinters))
+	for _, lw := range profileLinters {
+		name := lw.Name
+		if !enabledLinters[name] {
+			for _, alt := range lw.Alternatives {
+				if enabledLinters[alt] {
+					name = alt
+					break
+				}
+			}
+		}
+
+		linters = append(linters, weightedLinter{name, lw.Weight})
+	}
 
 	return c.normalizeWeightedLinters(linters)
 }
@@ -0,0 +1,111 @@
+package score
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/golangci/golangci-lint/pkg/printers"
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeRunResult(enabledLinters []string, issuesPerLinter map[string]int) *printers.JSONResult {
+	res := printers.JSONResult{Report: &report.Data{}}
+	for _, name := range enabledLinters {
+		res.Report.Linters = append(res.Report.Linters, report.LinterData{Name: name, Enabled: true})
+	}
+	for linter, n := range issuesPerLinter {
+		for i := 0; i < n; i++ {
+			res.Issues = append(res.Issues, result.Issue{FromLinter: linter})
+		}
+	}
+
+	return &res
+}
+
+func TestDefaultProfileIsValid(t *testing.T) {
+	assert.NoError(t, DefaultProfile().Validate())
+}
+
+func TestCalcWithDefaultProfile(t *testing.T) {
+	var linters []string
+	p := DefaultProfile()
+	for _, lw := range append(p.BugsLinters, p.StyleLinters...) {
+		linters = append(linters, lw.Name)
+	}
+
+	res := Calculator{}.Calc(makeRunResult(linters, nil))
+	assert.Equal(t, &CalcResult{Score: 100, MaxScore: 100}, res)
+
+	res = Calculator{}.Calc(makeRunResult(linters, map[string]int{"govet": 100}))
+	require.Len(t, res.Recommendations, 1)
+	assert.Equal(t, "fix issues from linters govet", res.Recommendations[0].Text)
+	assert.Equal(t, 100-res.Recommendations[0].ScoreIncrease, res.Score)
+}
+
+func TestCalcWithCustomProfile(t *testing.T) {
+	p := &Profile{
+		Name:             "security",
+		BugsLinters:      []LinterWeight{{Name: "gosec", Weight: 1}},
+		StyleLinters:     []LinterWeight{{Name: "golint", Weight: 1}},
+		BugsWeight:       0.75,
+		MinIssuePenalty:  0.5,
+		IssuesCap:        10,
+		MaxIssuesPenalty: 50,
+		Rules: []RecommendationRule{
+			{Linter: "gosec", MinIssues: 10, Penalty: 20, Text: "fix security issues first"},
+		},
+	}
+	require.NoError(t, p.Validate())
+
+	res := Calculator{Profile: p}.Calc(makeRunResult([]string{"gosec"}, map[string]int{"gosec": 10}))
+	assert.Equal(t, []Recommendation{
+		{Text: "enable linters golint", ScoreIncrease: 25},
+		{Text: "fix issues from linters gosec", ScoreIncrease: 50}, // capped from 75
+		{Text: "fix security issues first", ScoreIncrease: 20},
+	}, res.Recommendations)
+	assert.Equal(t, 5, res.Score)
+}
+
+func TestProfileValidate(t *testing.T) {
+	p := DefaultProfile()
+	p.IssuesCap = 1
+	assert.Error(t, p.Validate())
+
+	p = DefaultProfile()
+	p.Rules = []RecommendationRule{{Linter: "gosec", Penalty: 10, Text: "never triggered"}}
+	assert.Error(t, p.Validate())
+}
+
+func TestCalcForResultJSON(t *testing.T) {
+	p := &Profile{
+		Name:             "security",
+		BugsLinters:      []LinterWeight{{Name: "gosec", Weight: 1}},
+		StyleLinters:     []LinterWeight{{Name: "golint", Weight: 1}},
+		BugsWeight:       0.75,
+		MinIssuePenalty:  0.5,
+		IssuesCap:        10,
+		MaxIssuesPenalty: 50,
+	}
+	runRes := makeRunResult([]string{"gosec", "golint"}, map[string]int{"gosec": 3})
+	resultJSON, err := json.Marshal(map[string]interface{}{"GolangciLintRes": runRes})
+	require.NoError(t, err)
+
+	c := Calculator{Profile: p}
+	res, err := c.CalcForResultJSON(resultJSON)
+	require.NoError(t, err)
+	assert.Equal(t, c.Calc(runRes), res)
+	assert.True(t, res.Score < maxScore)
+}
+
+func TestCalcForResultJSONWithoutReport(t *testing.T) {
+	// the score is max if golangci-lint returned no report
+	res, err := Calculator{}.CalcForResultJSON([]byte(`{"GolangciLintRes":{"Issues":[]}}`))
+	require.NoError(t, err)
+	assert.Equal(t, &CalcResult{Score: maxScore, MaxScore: maxScore}, res)
+
+	_, err = Calculator{}.CalcForResultJSON([]byte(`{`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,147 @@
+package score
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const DefaultProfileName = "default"
+
+// Profile configures the scoring: orgs can have own profiles, DefaultProfile is used otherwise
+type Profile struct {
+	Name string `json:"name"`
+
+	// weights are relative inside of every group of linters
+	BugsLinters  []LinterWeight `json:"bugsLinters"`
+	StyleLinters []LinterWeight `json:"styleLinters"`
+
+	// BugsWeight is the share of bugs linters in the score, the rest is the share of style linters
+	BugsWeight float64 `json:"bugsWeight"`
+
+	// MinIssuePenalty is the share of the linter weight lost because of one issue:
+	// the penalty grows logarithmically up to the full weight for IssuesCap issues
+	MinIssuePenalty float64 `json:"minIssuePenalty"`
+	IssuesCap       int     `json:"issuesCap"`
+
+	// caps of the score decrease by recommendations, 0 means no cap
+	MaxDisabledLintersPenalty int `json:"maxDisabledLintersPenalty,omitempty"`
+	MaxIssuesPenalty          int `json:"maxIssuesPenalty,omitempty"`
+
+	Rules []RecommendationRule `json:"rules,omitempty"`
+}
+
+type LinterWeight struct {
+	Name   string  `json:"name"`
+	Weight float64 `json:"weight"`
+
+	// alternative linter is needed instead of this one if only the alternative is enabled
+	Alternatives []string `json:"alternatives,omitempty"`
+}
+
+// RecommendationRule is an extra recommendation: it's triggered if the linter is disabled
+// and WhenDisabled is set or if the linter found at least MinIssues issues
+type RecommendationRule struct {
+	Linter       string `json:"linter"`
+	WhenDisabled bool   `json:"whenDisabled,omitempty"`
+	MinIssues    int    `json:"minIssues,omitempty"`
+	Penalty      int    `json:"penalty"`
+	Text         string `json:"text"`
+}
+
+// DefaultProfile returns a new copy of the built-in profile every time: callers can modify it
+func DefaultProfile() *Profile {
+	return &Profile{
+		Name: DefaultProfileName,
+		BugsLinters: []LinterWeight{
+			{Name: "govet", Weight: 1},
+			{Name: "staticcheck", Weight: 1},
+			{Name: "errcheck", Weight: 0.8},
+			{Name: "bodyclose", Weight: 0.7}, // low because can have false-positives
+			{Name: "typecheck", Weight: 0.5},
+		},
+		StyleLinters: []LinterWeight{
+			{Name: "goimports", Weight: 1},
+			{Name: "dogsled", Weight: 0.5},
+			{Name: "gochecknoglobals", Weight: 0.4}, // low because can have false-positives
+			{Name: "gochecknoinits", Weight: 0.4},
+			{Name: "goconst", Weight: 0.3},
+			{Name: "golint", Weight: 1},
+			{Name: "gosimple", Weight: 0.6},
+			{Name: "lll", Weight: 0.1},
+			{Name: "misspell", Weight: 0.4},
+			{Name: "unconvert", Weight: 0.4},
+			{Name: "ineffassign", Weight: 0.5},
+			{Name: "gocognit", Weight: 0.8, Alternatives: []string{"gocyclo"}},
+		},
+		BugsWeight:      0.7,
+		MinIssuePenalty: 0.2,
+		IssuesCap:       100,
+	}
+}
+
+func validateLinterWeights(group string, linters []LinterWeight) error {
+	for _, wl := range linters {
+		if wl.Name == "" {
+			return fmt.Errorf("%s linter without name", group)
+		}
+		if wl.Weight < 0 {
+			return fmt.Errorf("negative weight %v of %s linter %s", wl.Weight, group, wl.Name)
+		}
+	}
+
+	return nil
+}
+
+func validatePenalty(name string, penalty int) error {
+	if penalty < 0 || penalty > maxScore {
+		return fmt.Errorf("%s must be in [0; %d], got %d", name, maxScore, penalty)
+	}
+
+	return nil
+}
+
+//nolint:gocyclo
+func (p Profile) Validate() error {
+	if p.Name == "" {
+		return errors.New("no profile name")
+	}
+
+	if err := validateLinterWeights("bugs", p.BugsLinters); err != nil {
+		return err
+	}
+	if err := validateLinterWeights("style", p.StyleLinters); err != nil {
+		return err
+	}
+
+	if p.BugsWeight < 0 || p.BugsWeight > 1 {
+		return fmt.Errorf("bugs weight must be in [0; 1], got %v", p.BugsWeight)
+	}
+	if p.MinIssuePenalty < 0 || p.MinIssuePenalty > 1 {
+		return fmt.Errorf("min issue penalty must be in [0; 1], got %v", p.MinIssuePenalty)
+	}
+	if p.IssuesCap < 2 {
+		return fmt.Errorf("issues cap must be at least 2, got %d", p.IssuesCap)
+	}
+
+	if err := validatePenalty("max disabled linters penalty", p.MaxDisabledLintersPenalty); err != nil {
+		return err
+	}
+	if err := validatePenalty("max issues penalty", p.MaxIssuesPenalty); err != nil {
+		return err
+	}
+
+	for _, r := range p.Rules {
+		if r.Linter == "" || r.Text == "" {
+			return errors.New("rule must have linter and text")
+		}
+		if !r.WhenDisabled && r.MinIssues <= 0 {
+			return fmt.Errorf("rule for linter %s is never triggered: set min issues or when disabled", r.Linter)
+		}
+		if err := validatePenalty(fmt.Sprintf("penalty of rule for linter %s", r.Linter), r.Penalty); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
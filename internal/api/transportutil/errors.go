@@ -99,6 +99,15 @@ func HandleErrorLikeResult(ctx context.Context, w http.ResponseWriter, e error)
 	case *apierrors.PendingError:
 		writeJSONHeader(http.StatusAccepted)
 		return nil
+	case *apierrors.FileResult:
+		// generated encoders set json content type before handling the result
+		w.Header().Set("Content-Type", err.ContentType)
+		if err.FileName != "" {
+			w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", err.FileName))
+		}
+		w.WriteHeader(http.StatusOK)
+		_, werr := w.Write(err.Body)
+		return errors.Wrapf(werr, "while writing file %s", err.FileName)
 	}
 
 	return fmt.Errorf("unknown error like result type: %#v (%T)", e, e)
@@ -0,0 +1,24 @@
+package gormdbtest
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/require"
+)
+
+// NewMockDB returns postgres gorm db backed by sqlmock: tests set expected queries by regexps
+// and must call the returned func to check that all expectations were met
+func NewMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock, func()) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	db, err := gorm.Open("postgres", sqlDB)
+	require.NoError(t, err)
+
+	return db, mock, func() {
+		require.NoError(t, mock.ExpectationsWereMet())
+		db.Close()
+	}
+}
@@ -0,0 +1,151 @@
+package email
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/smtp"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/golangci/golangci-api/internal/shared/config"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/pkg/errors"
+)
+
+type Message struct {
+	To      []string
+	Subject string
+	Body    string // plain text
+}
+
+type Sender interface {
+	Send(ctx context.Context, m *Message) error
+}
+
+// NewSenderFromConfig returns SMTP sender if SMTP_HOST is set, file sender if EMAIL_FILE_DIR is set
+// and logging sender otherwise
+func NewSenderFromConfig(cfg config.Config, log logutil.Log) Sender {
+	host := cfg.GetString("SMTP_HOST")
+	if host == "" {
+		if dir := cfg.GetString("EMAIL_FILE_DIR"); dir != "" {
+			return NewFileSender(dir)
+		}
+		return NewLogSender(log)
+	}
+
+	return &SMTPSender{
+		addr:     net.JoinHostPort(host, strconv.Itoa(cfg.GetInt("SMTP_PORT", 587))),
+		host:     host,
+		user:     cfg.GetString("SMTP_USER"),
+		password: cfg.GetString("SMTP_PASSWORD"),
+		from:     cfg.GetString("EMAIL_FROM"),
+	}
+}
+
+type SMTPSender struct {
+	addr     string
+	host     string
+	user     string
+	password string
+	from     string
+}
+
+func (s SMTPSender) Send(_ context.Context, m *Message) error {
+	if len(m.To) == 0 {
+		return errors.New("no recipients")
+	}
+
+	var auth smtp.Auth
+	if s.user != "" {
+		auth = smtp.PlainAuth("", s.user, s.password, s.host)
+	}
+
+	headers := []string{
+		fmt.Sprintf("From: %s", s.from),
+		fmt.Sprintf("To: %s", strings.Join(m.To, ", ")),
+		fmt.Sprintf("Subject: %s", m.Subject),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+	}
+	msg := strings.Join(headers, "\r\n") + "\r\n\r\n" + m.Body
+
+	if err := smtp.SendMail(s.addr, auth, s.from, m.To, []byte(msg)); err != nil {
+		return errors.Wrapf(err, "failed to send email %q", m.Subject)
+	}
+
+	return nil
+}
+
+// LogSender only logs emails: it's used when SMTP isn't configured, e.g. in development
+type LogSender struct {
+	log logutil.Log
+}
+
+func NewLogSender(log logutil.Log) *LogSender {
+	return &LogSender{
+		log: log,
+	}
+}
+
+func (s LogSender) Send(_ context.Context, m *Message) error {
+	s.log.Infof("Email %q to %v:\n%s", m.Subject, m.To, m.Body)
+	return nil
+}
+
+// FileSender saves every email as a JSON file in the directory: it's used in tests
+type FileSender struct {
+	dir string
+}
+
+func NewFileSender(dir string) *FileSender {
+	return &FileSender{
+		dir: dir,
+	}
+}
+
+func (s FileSender) Send(_ context.Context, m *Message) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal email")
+	}
+
+	f, err := ioutil.TempFile(s.dir, "email-*.json")
+	if err != nil {
+		return errors.Wrapf(err, "failed to create email file in %s", s.dir)
+	}
+	defer f.Close()
+
+	if _, err = f.Write(data); err != nil {
+		return errors.Wrapf(err, "failed to write email to %s", f.Name())
+	}
+
+	return nil
+}
+
+// ReadSentEmails returns emails saved by FileSender into the directory
+func ReadSentEmails(dir string) ([]Message, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "email-*.json"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list email files")
+	}
+
+	var ret []Message
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s", path)
+		}
+
+		var m Message
+		if err = json.Unmarshal(data, &m); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal %s", path)
+		}
+		ret = append(ret, m)
+	}
+
+	return ret, nil
+}
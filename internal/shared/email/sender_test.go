@@ -0,0 +1,28 @@
+package email
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSender(t *testing.T) {
+	dir, err := ioutil.TempDir("", "emails")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	m := Message{
+		To:      []string{"user@example.com"},
+		Subject: "subject",
+		Body:    "body",
+	}
+	require.NoError(t, NewFileSender(dir).Send(context.Background(), &m))
+
+	sent, err := ReadSentEmails(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []Message{m}, sent)
+}
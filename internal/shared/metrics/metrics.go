@@ -0,0 +1,128 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "golangci"
+
+var (
+	httpRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Count of HTTP requests by service, method and response code.",
+	}, []string{"service", "method", "code"})
+
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of HTTP requests by service and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "method"})
+
+	queueConsumeDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "queue",
+		Name:      "consume_duration_seconds",
+		Help:      "Duration of queue message consuming by queue, subqueue and result.",
+		Buckets:   []float64{.05, .1, .5, 1, 5, 10, 30, 60, 120, 300, 600},
+	}, []string{"queue", "subqueue", "result"})
+
+	queueRetriesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "queue",
+		Name:      "retries_total",
+		Help:      "Count of repeated receives of queue messages by queue and subqueue.",
+	}, []string{"queue", "subqueue"})
+
+	queueDeadLetteredTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "queue",
+		Name:      "dead_lettered_total",
+		Help:      "Count of message deliveries from dead letter queues by queue and subqueue.",
+	}, []string{"queue", "subqueue"})
+
+	analysisStageDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "analysis",
+		Name:      "stage_duration_seconds",
+		Help:      "Duration of analysis stages (In Queue, Start Container, Prepare, Analysis) by analysis type.",
+		Buckets:   []float64{1, 5, 10, 30, 60, 120, 300, 600, 1200, 3600},
+	}, []string{"type", "stage"})
+
+	providerCallsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "provider",
+		Name:      "api_calls_total",
+		Help:      "Count of provider (e.g. GitHub) API calls by provider and response status.",
+	}, []string{"provider", "status"})
+)
+
+// Handler serves metrics in the Prometheus format
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// InstrumentHandler tracks count and duration of requests to the handler of the service method
+func InstrumentHandler(service, method string, h http.Handler) http.Handler {
+	labels := prometheus.Labels{"service": service, "method": method}
+	return promhttp.InstrumentHandlerDuration(httpRequestDuration.MustCurryWith(labels),
+		promhttp.InstrumentHandlerCounter(httpRequestsTotal.MustCurryWith(labels), h))
+}
+
+func ObserveQueueConsume(queue, subqueue string, startedAt time.Time, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+
+	queueConsumeDuration.WithLabelValues(queue, subqueue, result).Observe(time.Since(startedAt).Seconds())
+}
+
+func IncQueueRetries(queue, subqueue string) {
+	queueRetriesTotal.WithLabelValues(queue, subqueue).Inc()
+}
+
+func IncQueueDeadLettered(queue, subqueue string) {
+	queueDeadLetteredTotal.WithLabelValues(queue, subqueue).Inc()
+}
+
+func ObserveAnalysisStage(analysisType, stage string, d time.Duration) {
+	analysisStageDuration.WithLabelValues(analysisType, stage).Observe(d.Seconds())
+}
+
+type providerTransport struct {
+	provider string
+	base     http.RoundTripper
+}
+
+// NewProviderTransport counts provider API calls made through base by response status
+func NewProviderTransport(provider string, base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &providerTransport{
+		provider: provider,
+		base:     base,
+	}
+}
+
+func (t providerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	status := "error"
+	if err == nil {
+		status = strconv.Itoa(resp.StatusCode)
+	}
+
+	providerCallsTotal.WithLabelValues(t.provider, status).Inc()
+	return resp, err
+}
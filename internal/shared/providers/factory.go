@@ -1,12 +1,15 @@
 package providers
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/providers/githubapp"
 	"github.com/golangci/golangci-api/internal/shared/providers/implementations"
 	"github.com/golangci/golangci-api/internal/shared/providers/provider"
+	"github.com/golangci/golangci-api/internal/shared/providers/ratelimit"
 	"github.com/golangci/golangci-api/pkg/api/models"
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
@@ -16,10 +19,17 @@ type Factory interface {
 	Build(auth *models.Auth) (provider.Provider, error)
 	BuildForUser(db *gorm.DB, userID uint) (provider.Provider, error)
 	BuildForToken(providerName, accessToken string) (provider.Provider, error)
+
+	// BuildForRepo builds provider acting as the app installation if the repo
+	// was connected through it and acting as the user who connected the repo otherwise
+	BuildForRepo(db *gorm.DB, repo *models.Repo) (provider.Provider, error)
+	BuildForInstallation(providerName string, installationID int) (provider.Provider, error)
 }
 
 type BasicFactory struct {
-	log logutil.Log
+	log         logutil.Log
+	rateLimiter *ratelimit.Manager
+	githubApp   *githubapp.App
 }
 
 func NewBasicFactory(log logutil.Log) *BasicFactory {
@@ -28,10 +38,24 @@ func NewBasicFactory(log logutil.Log) *BasicFactory {
 	}
 }
 
+// SetRateLimiter makes built providers share the rate limit quota of access tokens
+func (f *BasicFactory) SetRateLimiter(m *ratelimit.Manager) {
+	f.rateLimiter = m
+}
+
+// SetGithubApp enables building providers for GitHub App installations
+func (f *BasicFactory) SetGithubApp(app *githubapp.App) {
+	f.githubApp = app
+}
+
 func (f BasicFactory) BuildForToken(providerName, accessToken string) (provider.Provider, error) {
 	switch providerName {
 	case implementations.GithubProviderName:
-		return implementations.NewGithub(f.log, accessToken), nil
+		p := implementations.NewGithub(f.log, accessToken)
+		if f.rateLimiter != nil {
+			p.SetRateLimiter(f.rateLimiter)
+		}
+		return p, nil
 	}
 
 	return nil, fmt.Errorf("invalid provider name %q", providerName)
@@ -63,3 +87,32 @@ func (f BasicFactory) BuildForUser(db *gorm.DB, userID uint) (provider.Provider,
 
 	return f.Build(&auth)
 }
+
+func (f BasicFactory) BuildForInstallation(providerName string, installationID int) (provider.Provider, error) {
+	if providerName != implementations.GithubProviderName || f.githubApp == nil {
+		return nil, fmt.Errorf("no app for provider %q", providerName)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	accessToken, err := f.githubApp.InstallationToken(ctx, installationID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get installation %d access token", installationID)
+	}
+
+	p, err := f.BuildForToken(providerName, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return implementations.NewStableProvider(p, time.Second*30, 3), nil
+}
+
+func (f BasicFactory) BuildForRepo(db *gorm.DB, repo *models.Repo) (provider.Provider, error) {
+	if repo.ProviderInstallationID != 0 {
+		return f.BuildForInstallation(repo.Provider, repo.ProviderInstallationID)
+	}
+
+	return f.BuildForUser(db, repo.UserID)
+}
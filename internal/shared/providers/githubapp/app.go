@@ -0,0 +1,250 @@
+package githubapp
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golangci/golangci-api/internal/shared/cache"
+	"github.com/golangci/golangci-api/internal/shared/config"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/metrics"
+	"github.com/golangci/golangci-api/internal/shared/providers/provider"
+	"github.com/pkg/errors"
+)
+
+const defaultBaseURL = "https://api.github.com"
+
+// App authenticates as GitHub App: it signs JWTs with the app private key
+// and exchanges them for installation access tokens. Installation tokens live for an hour,
+// they are cached and shared between api instances.
+type App struct {
+	id            int
+	privateKey    *rsa.PrivateKey
+	webhookSecret string
+	baseURL       string
+
+	cache cache.Cache
+	log   logutil.Log
+	hc    *http.Client
+}
+
+// IsConfigured returns true if GitHub App credentials are set in the config
+func IsConfigured(cfg config.Config) bool {
+	return cfg.GetInt("GITHUB_APP_ID", 0) != 0
+}
+
+func NewFromConfig(cfg config.Config, c cache.Cache, log logutil.Log) (*App, error) {
+	id := cfg.GetInt("GITHUB_APP_ID", 0)
+	if id == 0 {
+		return nil, errors.New("no GITHUB_APP_ID in config")
+	}
+
+	keyPEM := cfg.GetString("GITHUB_APP_PRIVATE_KEY")
+	if keyPEM == "" {
+		keyPath := cfg.GetString("GITHUB_APP_PRIVATE_KEY_PATH")
+		if keyPath == "" {
+			return nil, errors.New("no GITHUB_APP_PRIVATE_KEY or GITHUB_APP_PRIVATE_KEY_PATH in config")
+		}
+
+		data, err := ioutil.ReadFile(keyPath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read private key from %s", keyPath)
+		}
+		keyPEM = string(data)
+	}
+
+	privateKey, err := parsePrivateKey([]byte(keyPEM))
+	if err != nil {
+		return nil, err
+	}
+
+	// app webhooks url is the same for all installations: unlike repo hooks it isn't secret
+	webhookSecret := cfg.GetString("GITHUB_APP_WEBHOOK_SECRET")
+	if webhookSecret == "" {
+		return nil, errors.New("no GITHUB_APP_WEBHOOK_SECRET in config")
+	}
+
+	baseURL := cfg.GetString("GITHUB_APP_API_URL")
+	if baseURL == "" {
+		baseURL = defaultBaseURL
+	}
+
+	return &App{
+		id:            id,
+		privateKey:    privateKey,
+		webhookSecret: webhookSecret,
+		baseURL:       strings.TrimSuffix(baseURL, "/"),
+		cache:         c,
+		log:           log,
+		hc: &http.Client{
+			Transport: metrics.NewProviderTransport("github.com", http.DefaultTransport),
+			Timeout:   30 * time.Second,
+		},
+	}, nil
+}
+
+func parsePrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("failed to decode private key pem")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse private key")
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key isn't RSA key")
+	}
+
+	return rsaKey, nil
+}
+
+// JWT returns RS256 signed token to authenticate as the app itself
+func (a App) JWT(now time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal jwt header")
+	}
+
+	claims, err := json.Marshal(map[string]interface{}{
+		"iat": now.Add(-time.Minute).Unix(), // allow clock drift
+		"exp": now.Add(9 * time.Minute).Unix(),
+		"iss": a.id,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal jwt claims")
+	}
+
+	enc := base64.RawURLEncoding
+	unsigned := enc.EncodeToString(header) + "." + enc.EncodeToString(claims)
+
+	hash := sha256.Sum256([]byte(unsigned))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, a.privateKey, crypto.SHA256, hash[:])
+	if err != nil {
+		return "", errors.Wrap(err, "failed to sign jwt")
+	}
+
+	return unsigned + "." + enc.EncodeToString(sig), nil
+}
+
+type installationToken struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func installationTokenKey(installationID int) string {
+	return fmt.Sprintf("github/app/installations/%d/token", installationID)
+}
+
+// InstallationToken returns access token to act as the app in the installation
+func (a App) InstallationToken(ctx context.Context, installationID int) (string, error) {
+	key := installationTokenKey(installationID)
+
+	var cached *installationToken
+	if err := a.cache.Get(key, &cached); err != nil {
+		a.log.Warnf("Can't get installation %d token from cache: %s", installationID, err)
+	} else if cached != nil {
+		return cached.Token, nil
+	}
+
+	t, err := a.createInstallationToken(ctx, installationID)
+	if err != nil {
+		return "", err
+	}
+
+	// refresh the token before the expiration: it must be valid during the whole request
+	ttl := time.Until(t.ExpiresAt) - 10*time.Minute
+	if ttl > 0 {
+		if err = a.cache.Set(key, ttl, t); err != nil {
+			a.log.Warnf("Can't save installation %d token to cache: %s", installationID, err)
+		}
+	}
+
+	return t.Token, nil
+}
+
+func (a App) createInstallationToken(ctx context.Context, installationID int) (*installationToken, error) {
+	jwt, err := a.JWT(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("%s/app/installations/%d/access_tokens", a.baseURL, installationID)
+	req, err := http.NewRequest(http.MethodPost, u, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make http request")
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Authorization", "Bearer "+jwt)
+	req.Header.Set("Accept", "application/vnd.github.machine-man-preview+json")
+
+	resp, err := a.hc.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to perform http request")
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden {
+		// the app was uninstalled or the installation was suspended
+		return nil, errors.Wrapf(provider.ErrUnauthorized, "failed to create installation %d token: status %d",
+			installationID, resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("failed to create installation %d token: status %d: %s",
+			installationID, resp.StatusCode, string(body))
+	}
+
+	var t installationToken
+	if err = json.Unmarshal(body, &t); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal installation token")
+	}
+
+	return &t, nil
+}
+
+// CheckWebhookSignature checks X-Hub-Signature-256 header of app webhooks
+func (a App) CheckWebhookSignature(body []byte, signature string) error {
+	const prefix = "sha256="
+	if !strings.HasPrefix(signature, prefix) {
+		return errors.New("no sha256 webhook signature")
+	}
+
+	got, err := hex.DecodeString(strings.TrimPrefix(signature, prefix))
+	if err != nil {
+		return errors.Wrap(err, "failed to decode webhook signature")
+	}
+
+	mac := hmac.New(sha256.New, []byte(a.webhookSecret))
+	_, _ = mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return errors.New("invalid webhook signature")
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package githubapp
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWT(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	a := App{id: 42, privateKey: key}
+	now := time.Now()
+	jwt, err := a.JWT(now)
+	require.NoError(t, err)
+
+	parts := strings.Split(jwt, ".")
+	require.Len(t, parts, 3)
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.NoError(t, err)
+	hash := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	assert.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, hash[:], sig))
+
+	claimsJSON, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+	var claims struct {
+		Iat, Exp int64
+		Iss      int
+	}
+	require.NoError(t, json.Unmarshal(claimsJSON, &claims))
+	assert.Equal(t, 42, claims.Iss)
+	assert.True(t, claims.Exp-now.Unix() <= 10*60)
+}
+
+func TestCheckWebhookSignature(t *testing.T) {
+	a := App{webhookSecret: "secret"}
+	body := []byte(`{"action":"created"}`)
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	_, _ = mac.Write(body)
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	assert.NoError(t, a.CheckWebhookSignature(body, signature))
+	assert.Error(t, a.CheckWebhookSignature([]byte(`{"action":"deleted"}`), signature))
+	assert.Error(t, a.CheckWebhookSignature(body, ""))
+}
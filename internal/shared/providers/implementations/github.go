@@ -1,7 +1,10 @@
 package implementations
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,7 +13,9 @@ import (
 
 	"github.com/golangci/golangci-api/internal/api/apierrors"
 	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/metrics"
 	"github.com/golangci/golangci-api/internal/shared/providers/provider"
+	"github.com/golangci/golangci-api/internal/shared/providers/ratelimit"
 	"github.com/golangci/golangci-api/pkg/api/models"
 	"github.com/google/go-github/github"
 	"github.com/pkg/errors"
@@ -26,6 +31,7 @@ type Github struct {
 	accessToken string
 	baseURL     *url.URL
 	log         logutil.Log
+	rateLimiter *ratelimit.Manager
 }
 
 func NewGithub(log logutil.Log, accessToken string) *Github {
@@ -43,6 +49,11 @@ func (p Github) LinkToPullRequest(repo *models.Repo, num int) string {
 	return fmt.Sprintf("https://github.com/%s/pull/%d", repo.DisplayFullName, num)
 }
 
+// SetRateLimiter makes calls wait for the shared rate limit quota of the access token
+func (p *Github) SetRateLimiter(m *ratelimit.Manager) {
+	p.rateLimiter = m
+}
+
 func (p *Github) SetBaseURL(s string) error {
 	baseURL, err := url.Parse(s)
 	if err != nil {
@@ -60,6 +71,10 @@ func (p Github) client(ctx context.Context) *github.Client {
 		},
 	)
 	tc := oauth2.NewClient(ctx, ts)
+	tc.Transport = metrics.NewProviderTransport(p.Name(), tc.Transport)
+	if p.rateLimiter != nil {
+		tc.Transport = p.rateLimiter.NewTransport(p.accessToken, tc.Transport)
+	}
 	c := github.NewClient(tc)
 	if p.baseURL != nil {
 		c.BaseURL = p.baseURL
@@ -69,6 +84,10 @@ func (p Github) client(ctx context.Context) *github.Client {
 }
 
 func (p Github) unwrapError(err error) error {
+	if ratelimit.IsRateLimitError(err) {
+		return provider.ErrRateLimited
+	}
+
 	if er, ok := err.(*github.ErrorResponse); ok {
 		respCode := er.Response.StatusCode
 		if respCode == http.StatusNotFound {
@@ -284,6 +303,37 @@ func (p Github) SetCommitStatus(ctx context.Context, owner, repo, ref string, st
 	return nil
 }
 
+func (p Github) UploadCodeScanningSARIF(ctx context.Context, owner, repo string, upload *provider.CodeScanningUpload) error {
+	// the API accepts only gzipped and base64 encoded SARIF
+	var gzipped bytes.Buffer
+	zw := gzip.NewWriter(&gzipped)
+	if _, err := zw.Write(upload.SARIF); err != nil {
+		return errors.Wrap(err, "failed to gzip sarif")
+	}
+	if err := zw.Close(); err != nil {
+		return errors.Wrap(err, "failed to gzip sarif")
+	}
+
+	body := map[string]string{
+		"commit_sha": upload.CommitSHA,
+		"ref":        upload.Ref,
+		"sarif":      base64.StdEncoding.EncodeToString(gzipped.Bytes()),
+		"tool_name":  upload.ToolName,
+	}
+
+	c := p.client(ctx)
+	req, err := c.NewRequest(http.MethodPost, fmt.Sprintf("repos/%s/%s/code-scanning/sarifs", owner, repo), body)
+	if err != nil {
+		return errors.Wrap(err, "failed to build request")
+	}
+
+	if _, err = c.Do(ctx, req, nil); err != nil {
+		return p.unwrapError(err)
+	}
+
+	return nil
+}
+
 func (p Github) ListRepos(ctx context.Context, cfg *provider.ListReposConfig) ([]provider.Repo, error) {
 	opts := github.RepositoryListOptions{
 		Visibility: cfg.Visibility,
@@ -127,6 +127,12 @@ func (p StableProvider) SetCommitStatus(ctx context.Context, owner, repo, ref st
 	})
 }
 
+func (p StableProvider) UploadCodeScanningSARIF(ctx context.Context, owner, repo string, upload *provider.CodeScanningUpload) error {
+	return p.retryErr(func() error {
+		return p.underlying.UploadCodeScanningSARIF(ctx, owner, repo, upload)
+	})
+}
+
 func (p StableProvider) GetPullRequest(ctx context.Context, owner, repo string, number int) (ret *provider.PullRequest, err error) {
 	p.retryVoid(func() {
 		ret, err = p.underlying.GetPullRequest(ctx, owner, repo, number)
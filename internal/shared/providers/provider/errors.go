@@ -7,6 +7,9 @@ var (
 	ErrRepoWasArchived = errors.New("repo was archived so is read-only")
 	ErrNoFreeOrgSeats  = errors.New("no free seats in GitHub organization") // TODO: remove github
 	ErrNotFound        = errors.New("not found in VCS provider")
+
+	// ErrRateLimited is temporary: the call can be retried after the rate limit reset
+	ErrRateLimited = errors.New("VCS provider rate limit exceeded")
 )
 
 func IsPermanentError(err error) bool {
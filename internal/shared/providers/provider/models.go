@@ -78,6 +78,14 @@ type CommitStatus struct {
 	TargetURL   string
 }
 
+// CodeScanningUpload is a SARIF log of analysis results for a commit
+type CodeScanningUpload struct {
+	CommitSHA string
+	Ref       string // e.g. refs/heads/master
+	ToolName  string
+	SARIF     []byte
+}
+
 type PullRequestAction string
 
 const (
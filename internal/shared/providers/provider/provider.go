@@ -28,6 +28,7 @@ type Provider interface {
 
 	ListPullRequestCommits(ctx context.Context, owner, repo string, number int) ([]*Commit, error)
 	SetCommitStatus(ctx context.Context, owner, repo, ref string, status *CommitStatus) error
+	UploadCodeScanningSARIF(ctx context.Context, owner, repo string, upload *CodeScanningUpload) error
 
 	ParsePullRequestEvent(ctx context.Context, payload []byte) (*PullRequestEvent, error)
 
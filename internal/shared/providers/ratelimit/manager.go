@@ -0,0 +1,156 @@
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/golangci/golangci-api/internal/shared/config"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/providers/provider"
+	"github.com/google/go-github/github"
+	"github.com/pkg/errors"
+)
+
+// Quota is the last known state of the provider rate limit for an access token
+type Quota struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// Manager records the remaining provider quota per access token in redis: all api instances
+// and workers share the same tokens, therefore they must share the quota too.
+// Low priority calls (crons, orgs fetching) are delayed or rejected when the quota is low
+// to leave it for the user-facing calls and analyzes.
+type Manager struct {
+	pool *redis.Pool
+	log  logutil.Log
+
+	lowPriorityReserve int
+	maxDelay           time.Duration
+}
+
+func NewManager(pool *redis.Pool, log logutil.Log, cfg config.Config) *Manager {
+	return &Manager{
+		pool:               pool,
+		log:                log,
+		lowPriorityReserve: cfg.GetInt("PROVIDER_RATE_LIMIT_LOW_PRIORITY_RESERVE", 1000),
+		maxDelay:           cfg.GetDuration("PROVIDER_RATE_LIMIT_MAX_DELAY", time.Minute),
+	}
+}
+
+func quotaKey(accessToken string) string {
+	// don't store access tokens in redis
+	h := sha256.Sum256([]byte(accessToken))
+	return "ratelimit/" + hex.EncodeToString(h[:])
+}
+
+func (m Manager) Record(accessToken string, q *Quota) error {
+	ttl := int(time.Until(q.Reset)/time.Second) + 1
+	if ttl <= 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(q)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal quota")
+	}
+
+	conn := m.pool.Get()
+	defer conn.Close()
+
+	if _, err = conn.Do("SET", quotaKey(accessToken), data, "EX", ttl); err != nil {
+		return errors.Wrap(err, "failed to save quota to redis")
+	}
+
+	return nil
+}
+
+// Get returns nil if the quota for the token is unknown or was already reset
+func (m Manager) Get(accessToken string) (*Quota, error) {
+	conn := m.pool.Get()
+	defer conn.Close()
+
+	data, err := redis.Bytes(conn.Do("GET", quotaKey(accessToken)))
+	if err != nil {
+		if err == redis.ErrNil {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to get quota from redis")
+	}
+
+	var q Quota
+	if err = json.Unmarshal(data, &q); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal quota")
+	}
+
+	if !time.Now().Before(q.Reset) {
+		return nil, nil
+	}
+
+	return &q, nil
+}
+
+// Wait returns provider.ErrRateLimited if the call with the token must not be made now.
+// It blocks low priority calls until the quota reset if it's soon enough.
+func (m Manager) Wait(ctx context.Context, accessToken string) error {
+	q, err := m.Get(accessToken)
+	if err != nil {
+		// redis problems must not break provider calls
+		m.log.Warnf("Failed to get provider rate limit quota: %s", err)
+		return nil
+	}
+	if q == nil {
+		return nil
+	}
+
+	if PriorityFromContext(ctx) != PriorityLow {
+		if q.Remaining == 0 {
+			return provider.ErrRateLimited
+		}
+		return nil
+	}
+
+	if q.Remaining > m.lowPriorityReserve {
+		return nil
+	}
+
+	delay := time.Until(q.Reset)
+	if delay > m.maxDelay {
+		return provider.ErrRateLimited
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+		return provider.ErrRateLimited
+	}
+
+	m.log.Infof("Delaying low priority provider call for %s: %d/%d calls remain", delay, q.Remaining, q.Limit)
+	t := time.NewTimer(delay)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// IsRateLimitError returns true if err is a rate limit error from the provider or from the Manager
+func IsRateLimitError(err error) bool {
+	err = errors.Cause(err)
+	if ue, ok := err.(*url.Error); ok { // errors of http transport are wrapped by http.Client
+		err = ue.Err
+	}
+
+	switch err.(type) {
+	case *github.RateLimitError, *github.AbuseRateLimitError:
+		return true
+	}
+
+	return err == provider.ErrRateLimited
+}
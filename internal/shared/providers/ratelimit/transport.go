@@ -0,0 +1,96 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Priority int
+
+const (
+	PriorityHigh Priority = iota
+	PriorityLow
+)
+
+type priorityCtxKey struct{}
+
+// ContextWithPriority marks provider calls made with the context, calls are high priority by default
+func ContextWithPriority(ctx context.Context, p Priority) context.Context {
+	return context.WithValue(ctx, priorityCtxKey{}, p)
+}
+
+func PriorityFromContext(ctx context.Context) Priority {
+	if p, ok := ctx.Value(priorityCtxKey{}).(Priority); ok {
+		return p
+	}
+
+	return PriorityHigh
+}
+
+type transport struct {
+	m           *Manager
+	accessToken string
+	base        http.RoundTripper
+}
+
+// NewTransport waits for the quota before provider calls made through base
+// and records the quota from the rate limit headers of responses
+func (m *Manager) NewTransport(accessToken string, base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return &transport{
+		m:           m,
+		accessToken: accessToken,
+		base:        base,
+	}
+}
+
+func (t transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.m.Wait(req.Context(), t.accessToken); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// search API has its own small rate limit
+	if !strings.HasPrefix(req.URL.Path, "/search/") {
+		if q := parseQuota(resp.Header); q != nil {
+			if err := t.m.Record(t.accessToken, q); err != nil {
+				t.m.log.Warnf("Failed to record provider rate limit quota: %s", err)
+			}
+		}
+	}
+
+	return resp, nil
+}
+
+func parseQuota(h http.Header) *Quota {
+	limit, err := strconv.Atoi(h.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return nil
+	}
+
+	remaining, err := strconv.Atoi(h.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return nil
+	}
+
+	reset, err := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return nil
+	}
+
+	return &Quota{
+		Limit:     limit,
+		Remaining: remaining,
+		Reset:     time.Unix(reset, 0),
+	}
+}
@@ -0,0 +1,43 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golangci/golangci-api/internal/shared/providers/provider"
+	"github.com/google/go-github/github"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQuota(t *testing.T) {
+	h := http.Header{}
+	assert.Nil(t, parseQuota(h))
+
+	h.Set("X-RateLimit-Limit", "5000")
+	h.Set("X-RateLimit-Remaining", "42")
+	h.Set("X-RateLimit-Reset", "1372700873")
+	assert.Equal(t, &Quota{
+		Limit:     5000,
+		Remaining: 42,
+		Reset:     time.Unix(1372700873, 0),
+	}, parseQuota(h))
+}
+
+func TestIsRateLimitError(t *testing.T) {
+	assert.True(t, IsRateLimitError(provider.ErrRateLimited))
+	assert.True(t, IsRateLimitError(errors.Wrap(provider.ErrRateLimited, "failed")))
+	assert.True(t, IsRateLimitError(&url.Error{Op: "Get", URL: "https://api.github.com", Err: provider.ErrRateLimited}))
+	assert.True(t, IsRateLimitError(&github.RateLimitError{}))
+	assert.True(t, IsRateLimitError(&github.AbuseRateLimitError{}))
+	assert.False(t, IsRateLimitError(provider.ErrNotFound))
+}
+
+func TestPriorityFromContext(t *testing.T) {
+	assert.Equal(t, PriorityHigh, PriorityFromContext(context.Background()))
+	ctx := ContextWithPriority(context.Background(), PriorityLow)
+	assert.Equal(t, PriorityLow, PriorityFromContext(ctx))
+}
@@ -15,16 +15,30 @@ import (
 	"github.com/golangci/golangci-api/internal/shared/logutil"
 	"github.com/golangci/golangci-api/internal/shared/queue/aws/sqs"
 	"github.com/golangci/golangci-api/internal/shared/queue/consumers"
+	"github.com/golangci/golangci-api/internal/shared/queue/pause"
 	"github.com/pkg/errors"
 )
 
+// pausedRequeueDelaySec is how long a message received by the lambda trigger
+// from a paused queue stays invisible after returning it to the queue
+const pausedRequeueDelaySec = 60
+
+// sqsQueue is the part of the sqs.Queue used by the consumer
+type sqsQueue interface {
+	TryReceive() (*awssqs.Message, error)
+	Ack(receiptHandle, messageID string, receiveCount int, ok bool) error
+	PutRawDelayed(body string, delaySec int) error
+}
+
 type SQS struct {
-	sqsQueue             *sqs.Queue
+	sqsQueue             sqsQueue
 	log                  logutil.Log
 	useLambdaTrigger     bool
 	consumer             consumers.Consumer
 	visibilityTimeoutSec int
 	name                 string
+	isDeadLetter         bool
+	pauseStorage         pause.Storage
 }
 
 func NewSQS(log logutil.Log, cfg config.Config, sqsQueue *sqs.Queue, consumer consumers.Consumer, sqsName string, visibilityTimeoutSec int) *SQS {
@@ -41,6 +55,32 @@ func NewSQS(log logutil.Log, cfg config.Config, sqsQueue *sqs.Queue, consumer co
 	}
 }
 
+// SetIsDeadLetter marks the consumed queue as a dead letter queue: it's used for metrics
+func (c *SQS) SetIsDeadLetter(isDeadLetter bool) {
+	c.isDeadLetter = isDeadLetter
+}
+
+// SetPauseStorage allows pausing and resuming of the queue consuming without a redeploy
+func (c *SQS) SetPauseStorage(ps pause.Storage) {
+	c.pauseStorage = ps
+}
+
+// isPaused returns true if consuming must be backed off: the queue was paused or the pause can't be checked
+func (c SQS) isPaused() bool {
+	if c.pauseStorage == nil {
+		return false
+	}
+
+	paused, err := c.pauseStorage.IsPaused(c.name)
+	if err != nil {
+		// don't consume messages of the possibly paused queue
+		c.log.Errorf("Failed to check %q queue pause, backing off: %s", c.name, err)
+		return true
+	}
+
+	return paused
+}
+
 func (c SQS) Run() {
 	if c.useLambdaTrigger {
 		c.log.Infof("Use lambda consumer")
@@ -53,6 +93,11 @@ func (c SQS) Run() {
 }
 
 func (c SQS) poll() {
+	if c.isPaused() {
+		time.Sleep(10 * time.Second)
+		return
+	}
+
 	message, err := c.sqsQueue.TryReceive()
 	if err != nil {
 		c.log.Errorf("Polling failed: %s", err)
@@ -103,26 +148,33 @@ func (c SQS) onReceiveMessage(ctx context.Context, message *awssqs.Message) erro
 		return errors.New("nil message body")
 	}
 
-	err := c.consumer.ConsumeMessage(ctx, []byte(*message.Body))
-	if err != nil {
-		if logger := c.consumer.ResultLogger(); logger != nil {
-			logger(err)
-		} else {
-			c.log.Warnf("Consumer failed: %s", err)
-		}
-	}
-	handledOk := err == nil || errors.Cause(err) == consumers.ErrPermanent
-
 	receiveCount := 0
 	receiveCountStrPtr := message.Attributes[awssqs.MessageSystemAttributeNameApproximateReceiveCount]
 	if receiveCountStrPtr == nil {
 		c.log.Warnf("No receive count message attribute: %#v", message.Attributes)
 	} else {
+		var err error
 		receiveCount, err = strconv.Atoi(*receiveCountStrPtr)
 		if err != nil {
 			c.log.Warnf("Invalid receive count attribute %q: %s", *receiveCountStrPtr, err)
 		}
 	}
+
+	ctx = consumers.ContextWithDelivery(ctx, consumers.Delivery{
+		Queue:          c.name,
+		ReceiveCount:   receiveCount,
+		IsDeadLettered: c.isDeadLetter,
+	})
+	err := c.consumer.ConsumeMessage(ctx, []byte(*message.Body))
+	if err != nil {
+		if logger := c.consumer.ResultLogger(); logger != nil {
+			logger(err)
+		} else {
+			c.log.Warnf("Consumer failed: %s", err)
+		}
+	}
+	handledOk := err == nil || errors.Cause(err) == consumers.ErrPermanent
+
 	if err = c.sqsQueue.Ack(*message.ReceiptHandle, *message.MessageId, receiveCount, handledOk); err != nil {
 		return errors.Wrapf(err, "failed to ack message %s with receive count %d",
 			*message.ReceiptHandle, receiveCount)
@@ -137,6 +189,18 @@ func (c SQS) handleLambdaCall(ctx context.Context, sqsEvent events.SQSEvent) err
 	}
 
 	event := sqsEvent.Records[0]
+	if c.isPaused() {
+		// Returning an error would increase the receive count of the message
+		// and eventually move it to the dead letter queue. Instead return the message
+		// to the queue as a new one with a reset receive count and let the lambda delete the received one.
+		if err := c.sqsQueue.PutRawDelayed(event.Body, pausedRequeueDelaySec); err != nil {
+			return errors.Wrapf(err, "failed to return message %s to the paused queue %q", event.MessageId, c.name)
+		}
+
+		c.log.Infof("Lambda trigger: returned message %s to the paused queue %q", event.MessageId, c.name)
+		return nil
+	}
+
 	receiveCountAttr, ok := event.Attributes[awssqs.MessageSystemAttributeNameApproximateReceiveCount]
 	if !ok {
 		c.log.Warnf("No receive count attr: %#v", event.Attributes)
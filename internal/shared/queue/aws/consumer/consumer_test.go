@@ -0,0 +1,98 @@
+package consumer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-lambda-go/events"
+	awssqs "github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/queue/consumers"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePauseStorage struct {
+	paused bool
+	err    error
+}
+
+func (s fakePauseStorage) IsPaused(queue string) (bool, error) {
+	return s.paused, s.err
+}
+
+func (s fakePauseStorage) SetPaused(queue string, paused bool) error {
+	return nil
+}
+
+type fakeQueue struct {
+	sqsQueue
+	requeued []string
+	acked    int
+}
+
+func (q *fakeQueue) PutRawDelayed(body string, delaySec int) error {
+	q.requeued = append(q.requeued, body)
+	return nil
+}
+
+func (q *fakeQueue) Ack(receiptHandle, messageID string, receiveCount int, ok bool) error {
+	q.acked++
+	return nil
+}
+
+type fakeConsumer struct {
+	consumed int
+}
+
+func (c *fakeConsumer) ConsumeMessage(ctx context.Context, message []byte) error {
+	c.consumed++
+	return nil
+}
+
+func (c *fakeConsumer) ResultLogger() consumers.ResultLogger {
+	return nil
+}
+
+func TestPausedQueueNeverDeadLettersLambdaMessages(t *testing.T) {
+	cases := []struct {
+		name         string
+		pauseStorage fakePauseStorage
+	}{
+		{name: "paused", pauseStorage: fakePauseStorage{paused: true}},
+		{name: "pause can't be checked", pauseStorage: fakePauseStorage{err: errors.New("redis is down")}},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			q := &fakeQueue{}
+			consumer := &fakeConsumer{}
+			c := SQS{
+				sqsQueue: q,
+				log:      logutil.NewStderrLog("test"),
+				consumer: consumer,
+				name:     "primary",
+			}
+			c.SetPauseStorage(tc.pauseStorage)
+
+			event := events.SQSEvent{Records: []events.SQSMessage{{
+				MessageId: "id",
+				Body:      `{"a":1}`,
+				Attributes: map[string]string{
+					awssqs.MessageSystemAttributeNameApproximateReceiveCount: "1",
+				},
+			}}}
+
+			// an error would make the message be received again with an increased receive count
+			for i := 0; i < 3; i++ {
+				require.NoError(t, c.handleLambdaCall(context.Background(), event))
+			}
+
+			assert.Zero(t, consumer.consumed)
+			assert.Zero(t, q.acked)
+			assert.Equal(t, []string{`{"a":1}`, `{"a":1}`, `{"a":1}`}, q.requeued)
+		})
+	}
+}
@@ -121,3 +121,69 @@ func (q Queue) Purge() error {
 	})
 	return err
 }
+
+// ReceiveBatch receives up to maxCount (<= 10) messages without a long polling,
+// received messages are hidden from other consumers for visibilityTimeoutSec
+func (q Queue) ReceiveBatch(maxCount, visibilityTimeoutSec int) ([]*sqs.Message, error) {
+	result, err := q.sqsClient.ReceiveMessage(&sqs.ReceiveMessageInput{
+		AttributeNames: []*string{
+			aws.String(sqs.MessageSystemAttributeNameApproximateReceiveCount),
+			aws.String(sqs.MessageSystemAttributeNameSentTimestamp),
+		},
+		QueueUrl:            &q.url,
+		MaxNumberOfMessages: aws.Int64(int64(maxCount)),
+		VisibilityTimeout:   aws.Int64(int64(visibilityTimeoutSec)),
+		WaitTimeSeconds:     aws.Int64(1),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "can't receive messages from sqs")
+	}
+
+	return result.Messages, nil
+}
+
+// PutRaw sends already marshaled message body, e.g. received from another queue
+func (q Queue) PutRaw(body string) error {
+	return q.PutRawDelayed(body, 0)
+}
+
+// PutRawDelayed sends already marshaled message body as a new message
+// which becomes visible to consumers after delaySec (<= 900)
+func (q Queue) PutRawDelayed(body string, delaySec int) error {
+	_, err := q.sqsClient.SendMessage(&sqs.SendMessageInput{
+		MessageBody:  aws.String(body),
+		QueueUrl:     aws.String(q.url),
+		DelaySeconds: aws.Int64(int64(delaySec)),
+	})
+	if err != nil {
+		return errors.Wrap(err, "can't send message to queue")
+	}
+
+	return nil
+}
+
+func (q Queue) Delete(receiptHandle string) error {
+	_, err := q.sqsClient.DeleteMessage(&sqs.DeleteMessageInput{
+		QueueUrl:      &q.url,
+		ReceiptHandle: aws.String(receiptHandle),
+	})
+	if err != nil {
+		return errors.Wrap(err, "can't delete message from queue")
+	}
+
+	return nil
+}
+
+// Release makes the received message immediately visible to other consumers
+func (q Queue) Release(receiptHandle string) error {
+	_, err := q.sqsClient.ChangeMessageVisibility(&sqs.ChangeMessageVisibilityInput{
+		ReceiptHandle:     aws.String(receiptHandle),
+		QueueUrl:          &q.url,
+		VisibilityTimeout: aws.Int64(0),
+	})
+	if err != nil {
+		return errors.Wrap(err, "can't change message visibility")
+	}
+
+	return nil
+}
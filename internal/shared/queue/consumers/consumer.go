@@ -10,3 +10,21 @@ type Consumer interface {
 	ConsumeMessage(ctx context.Context, message []byte) error
 	ResultLogger() ResultLogger
 }
+
+// Delivery describes how the message was received from the queue
+type Delivery struct {
+	Queue          string
+	ReceiveCount   int
+	IsDeadLettered bool
+}
+
+type deliveryCtxKey struct{}
+
+func ContextWithDelivery(ctx context.Context, d Delivery) context.Context {
+	return context.WithValue(ctx, deliveryCtxKey{}, d)
+}
+
+func DeliveryFromContext(ctx context.Context) Delivery {
+	d, _ := ctx.Value(deliveryCtxKey{}).(Delivery)
+	return d
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
+	"github.com/golangci/golangci-api/internal/shared/metrics"
 	"github.com/pkg/errors"
 )
 
@@ -52,7 +54,17 @@ func (m *Multiplexer) ConsumeMessage(ctx context.Context, message []byte) error
 		return fmt.Errorf("no consumer with id %s, registered consumers: %v", sm.SubqueueID, m.consumerNames())
 	}
 
-	return consumer.ConsumeMessage(ctx, []byte(sm.Message))
+	d := DeliveryFromContext(ctx)
+	if d.IsDeadLettered {
+		metrics.IncQueueDeadLettered(d.Queue, sm.SubqueueID)
+	} else if d.ReceiveCount > 1 {
+		metrics.IncQueueRetries(d.Queue, sm.SubqueueID)
+	}
+
+	startedAt := time.Now()
+	err := consumer.ConsumeMessage(ctx, []byte(sm.Message))
+	metrics.ObserveQueueConsume(d.Queue, sm.SubqueueID, startedAt, err)
+	return err
 }
 
 func (m *Multiplexer) RegisterConsumer(id string, consumer Consumer) error {
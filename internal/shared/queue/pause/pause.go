@@ -0,0 +1,53 @@
+package pause
+
+import (
+	"github.com/garyburd/redigo/redis"
+	"github.com/pkg/errors"
+)
+
+// Storage stores paused queues: consumers don't receive messages from a paused queue
+type Storage interface {
+	IsPaused(queue string) (bool, error)
+	SetPaused(queue string, paused bool) error
+}
+
+const keyPrefix = "queue/paused/"
+
+type Redis struct {
+	pool *redis.Pool
+}
+
+func NewRedis(pool *redis.Pool) *Redis {
+	return &Redis{
+		pool: pool,
+	}
+}
+
+func (r Redis) IsPaused(queue string) (bool, error) {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	paused, err := redis.Bool(conn.Do("EXISTS", keyPrefix+queue))
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to check queue %s pause", queue)
+	}
+
+	return paused, nil
+}
+
+func (r Redis) SetPaused(queue string, paused bool) error {
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	var err error
+	if paused {
+		_, err = conn.Do("SET", keyPrefix+queue, 1)
+	} else {
+		_, err = conn.Do("DEL", keyPrefix+queue)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to set queue %s pause to %t", queue, paused)
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/golangci/golangci-api/internal/shared/config"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/golangci/golangci-api"
+
+const shutdownTimeout = 5 * time.Second
+
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+// Init sets up the global tracer provider with the exporter from TRACING_EXPORTER config:
+// "otlp", "stdout" or nothing to disable tracing. The returned func flushes and stops the exporter.
+func Init(cfg config.Config, serviceName string) (func(ctx context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{}))
+
+	noop := func(context.Context) error { return nil }
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch exporterName := cfg.GetString("TRACING_EXPORTER"); exporterName {
+	case "":
+		return noop, nil
+	case ExporterOTLP:
+		var opts []otlptracehttp.Option
+		if endpoint := cfg.GetString("TRACING_OTLP_ENDPOINT"); endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpoint(endpoint))
+		}
+		if cfg.GetBool("TRACING_OTLP_INSECURE", false) {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		exporter, err = otlptracehttp.New(context.Background(), opts...)
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	default:
+		return nil, errors.Errorf("unknown tracing exporter %q", exporterName)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create tracing exporter")
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+	)
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
+
+// Shutdown flushes not exported spans by the func returned by Init
+func Shutdown(log logutil.Log, shutdown func(ctx context.Context) error) {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := shutdown(ctx); err != nil {
+		log.Warnf("Failed to shutdown tracing: %s", err)
+	}
+}
+
+// ShutdownOnSignal shuts tracing down and exits when the process is interrupted or terminated:
+// spans are exported in batches and the last ones would be lost otherwise.
+func ShutdownOnSignal(log logutil.Log, shutdown func(ctx context.Context) error) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		sig := <-signals
+		log.Infof("Got signal %s, shutting down tracing", sig)
+		Shutdown(log, shutdown)
+		os.Exit(128 + int(sig.(syscall.Signal)))
+	}()
+}
+
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan ends the span marking it as failed if err isn't nil
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Carrier is a trace context serialized into a queue message or other payload
+type Carrier map[string]string
+
+func Inject(ctx context.Context) Carrier {
+	c := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, c)
+	if len(c) == 0 {
+		return nil
+	}
+
+	return Carrier(c)
+}
+
+func Extract(ctx context.Context, c Carrier) context.Context {
+	if len(c) == 0 {
+		return ctx
+	}
+
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(c))
+}
+
+// InjectHeaders returns a copy of headers with the trace context from ctx
+func InjectHeaders(ctx context.Context, headers map[string]string) map[string]string {
+	ret := map[string]string{}
+	for k, v := range headers {
+		ret[k] = v
+	}
+	for k, v := range Inject(ctx) {
+		ret[k] = v
+	}
+
+	return ret
+}
+
+// InstrumentHandler starts a span for every request, the span continues the trace from the request headers
+func InstrumentHandler(name string, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := otel.Tracer(tracerName).Start(ctx, name,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.method", r.Method),
+				attribute.String("http.target", r.URL.Path),
+			))
+		defer span.End()
+
+		h.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// MuxMiddleware traces requests named by the matched route path template
+func MuxMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := r.URL.Path
+		if route := mux.CurrentRoute(r); route != nil {
+			if tmpl, err := route.GetPathTemplate(); err == nil {
+				name = tmpl
+			}
+		}
+
+		InstrumentHandler(r.Method+" "+name, next).ServeHTTP(w, r)
+	})
+}
+
+// RecordSpan records an already finished span started at startedAt, it's useful for tracked timings
+func RecordSpan(ctx context.Context, name string, startedAt time.Time) {
+	_, span := otel.Tracer(tracerName).Start(ctx, name, trace.WithTimestamp(startedAt))
+	span.End()
+}
@@ -0,0 +1,60 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestCarrierRoundTrip(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	otel.SetTracerProvider(sdktrace.NewTracerProvider())
+
+	assert.Nil(t, Inject(context.Background()))
+
+	ctx, span := StartSpan(context.Background(), "test")
+	defer span.End()
+
+	c := Inject(ctx)
+	require.NotEmpty(t, c)
+
+	extracted := trace.SpanContextFromContext(Extract(context.Background(), c))
+	assert.Equal(t, span.SpanContext().TraceID(), extracted.TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), extracted.SpanID())
+
+	headers := InjectHeaders(ctx, map[string]string{"X-Token": "token"})
+	assert.Equal(t, "token", headers["X-Token"])
+	assert.Equal(t, c["traceparent"], headers["traceparent"])
+}
+
+type countingExporter struct {
+	exported int
+}
+
+func (e *countingExporter) ExportSpans(_ context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.exported += len(spans)
+	return nil
+}
+
+func (e *countingExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+func TestShutdownFlushesSpans(t *testing.T) {
+	exporter := &countingExporter{}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter))
+
+	_, span := tp.Tracer(tracerName).Start(context.Background(), "test")
+	span.End()
+	assert.Zero(t, exporter.exported) // spans are exported in batches
+
+	Shutdown(logutil.NewStderrLog("test"), tp.Shutdown)
+	assert.Equal(t, 1, exporter.exported)
+}
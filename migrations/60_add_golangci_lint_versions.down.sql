@@ -0,0 +1 @@
+DROP TABLE golangci_lint_versions;
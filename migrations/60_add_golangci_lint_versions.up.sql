@@ -0,0 +1,16 @@
+CREATE TABLE golangci_lint_versions (
+    id SERIAL PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    deleted_at TIMESTAMP,
+
+    version VARCHAR(32) NOT NULL,
+    checksum VARCHAR(64) NOT NULL CHECK (checksum ~ '^[0-9a-f]{64}$'),
+    is_default BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE UNIQUE INDEX golangci_lint_versions_uniq_version ON golangci_lint_versions(version) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX golangci_lint_versions_uniq_default ON golangci_lint_versions(is_default) WHERE is_default AND deleted_at IS NULL;
+
+-- releases aren't seeded: every release is added with the sha256 of its archive
+-- by POST /v1/golangci-lint/versions, the default one by PUT /v1/golangci-lint/default-version
@@ -0,0 +1,2 @@
+ALTER TABLE repos DROP COLUMN provider_installation_id;
+DROP TABLE installations;
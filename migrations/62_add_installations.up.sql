@@ -0,0 +1,17 @@
+CREATE TABLE installations (
+    id SERIAL PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    deleted_at TIMESTAMP,
+
+    provider VARCHAR(64) NOT NULL DEFAULT 'github.com',
+    provider_id INTEGER NOT NULL,
+
+    account_name VARCHAR(128) NOT NULL,
+    account_provider_id INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE UNIQUE INDEX installations_provider_id_uniq_idx ON installations(provider, provider_id) WHERE deleted_at IS NULL;
+CREATE INDEX installations_account_name_idx ON installations(provider, account_name) WHERE deleted_at IS NULL;
+
+ALTER TABLE repos ADD COLUMN provider_installation_id INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,14 @@
+CREATE TABLE repo_owner_transfers (
+    id SERIAL PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    deleted_at TIMESTAMP,
+
+    repo_id INTEGER NOT NULL REFERENCES repos(id),
+    from_user_id INTEGER NOT NULL REFERENCES users(id),
+    to_user_id INTEGER NOT NULL DEFAULT 0,
+
+    reason VARCHAR(256) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX repo_owner_transfers_repo_id_idx ON repo_owner_transfers(repo_id);
@@ -0,0 +1,2 @@
+DROP TABLE audit_log_entries;
+DROP FUNCTION audit_log_entries_forbid_changes();
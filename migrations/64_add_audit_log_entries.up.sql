@@ -0,0 +1,29 @@
+CREATE TABLE audit_log_entries (
+    id SERIAL PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL,
+
+    provider VARCHAR(64) NOT NULL,
+    org_name VARCHAR(128) NOT NULL,
+
+    actor_user_id INTEGER NOT NULL DEFAULT 0,
+    action VARCHAR(64) NOT NULL,
+    target_id INTEGER NOT NULL DEFAULT 0,
+
+    before JSON,
+    after JSON,
+
+    request_id VARCHAR(64) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX audit_log_entries_org_idx ON audit_log_entries(provider, org_name, id);
+
+-- the audit log is append-only
+CREATE FUNCTION audit_log_entries_forbid_changes() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit log entries can''t be changed';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_entries_append_only
+    BEFORE UPDATE OR DELETE ON audit_log_entries
+    FOR EACH ROW EXECUTE PROCEDURE audit_log_entries_forbid_changes();
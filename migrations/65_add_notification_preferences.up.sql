@@ -0,0 +1,12 @@
+CREATE TABLE notification_preferences (
+    id SERIAL PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    deleted_at TIMESTAMP,
+
+    user_id INTEGER NOT NULL REFERENCES users(id),
+    event VARCHAR(64) NOT NULL,
+    enabled BOOLEAN NOT NULL
+);
+
+CREATE UNIQUE INDEX notification_preferences_user_event_uniq_idx ON notification_preferences(user_id, event) WHERE deleted_at IS NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE pull_request_analyzes DROP COLUMN issues_stored;
+ALTER TABLE repo_analyzes DROP COLUMN issues_stored;
+DROP TABLE issues;
@@ -0,0 +1,27 @@
+CREATE TABLE issues (
+    id SERIAL PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL,
+
+    analysis_type VARCHAR(16) NOT NULL,
+    analysis_id INTEGER NOT NULL,
+
+    from_linter VARCHAR(64) NOT NULL,
+    file VARCHAR(1024) NOT NULL,
+    line_from INTEGER NOT NULL,
+    line_to INTEGER NOT NULL,
+    column_number INTEGER NOT NULL DEFAULT 0,
+    text TEXT NOT NULL,
+    fingerprint VARCHAR(32) NOT NULL,
+    severity VARCHAR(32) NOT NULL DEFAULT '',
+    replacement JSONB
+);
+
+CREATE INDEX issues_analysis_idx ON issues(analysis_type, analysis_id, id);
+CREATE INDEX issues_fingerprint_idx ON issues(fingerprint);
+
+-- issues of existing analyzes are stored from result_json in background by the issues backfiller
+ALTER TABLE repo_analyzes ADD COLUMN issues_stored BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE pull_request_analyzes ADD COLUMN issues_stored BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX repo_analyzes_issues_not_stored_idx ON repo_analyzes(id) WHERE NOT issues_stored;
+CREATE INDEX pull_request_analyzes_issues_not_stored_idx ON pull_request_analyzes(id) WHERE NOT issues_stored;
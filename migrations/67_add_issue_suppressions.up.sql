@@ -0,0 +1,14 @@
+CREATE TABLE issue_suppressions (
+    id SERIAL PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    deleted_at TIMESTAMP,
+
+    repo_id INTEGER NOT NULL REFERENCES repos(id),
+    fingerprint VARCHAR(32) NOT NULL,
+    source VARCHAR(16) NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    author_user_id INTEGER NOT NULL REFERENCES users(id)
+);
+
+CREATE UNIQUE INDEX issue_suppressions_repo_fingerprint_uniq_idx ON issue_suppressions(repo_id, fingerprint) WHERE deleted_at IS NULL;
@@ -0,0 +1,8 @@
+ALTER TABLE issues
+  DROP COLUMN is_new;
+
+ALTER TABLE repo_analyzes
+  DROP COLUMN previous_analysis_id,
+  DROP COLUMN new_issues_count,
+  DROP COLUMN fixed_issues_count,
+  DROP COLUMN unchanged_issues_count;
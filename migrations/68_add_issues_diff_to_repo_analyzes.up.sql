@@ -0,0 +1,8 @@
+ALTER TABLE repo_analyzes
+  ADD COLUMN previous_analysis_id INTEGER NOT NULL DEFAULT 0,
+  ADD COLUMN new_issues_count INTEGER NOT NULL DEFAULT 0,
+  ADD COLUMN fixed_issues_count INTEGER NOT NULL DEFAULT 0,
+  ADD COLUMN unchanged_issues_count INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE issues
+  ADD COLUMN is_new BOOLEAN NOT NULL DEFAULT FALSE;
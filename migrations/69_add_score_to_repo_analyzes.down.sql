@@ -0,0 +1,6 @@
+DROP INDEX repo_analyzes_scored_idx;
+
+ALTER TABLE repo_analyzes
+  DROP COLUMN score,
+  DROP COLUMN max_score,
+  DROP COLUMN score_recommendations;
@@ -0,0 +1,6 @@
+ALTER TABLE repo_analyzes
+  ADD COLUMN score INTEGER NOT NULL DEFAULT 0,
+  ADD COLUMN max_score INTEGER NOT NULL DEFAULT 0,
+  ADD COLUMN score_recommendations JSONB;
+
+CREATE INDEX repo_analyzes_scored_idx ON repo_analyzes(repo_analysis_status_id, id) WHERE max_score != 0;
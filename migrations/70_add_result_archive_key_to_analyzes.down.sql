@@ -0,0 +1,5 @@
+DROP INDEX repo_analyzes_not_archived_idx;
+DROP INDEX pull_request_analyzes_not_archived_idx;
+
+ALTER TABLE repo_analyzes DROP COLUMN result_archive_key;
+ALTER TABLE pull_request_analyzes DROP COLUMN result_archive_key;
@@ -0,0 +1,5 @@
+ALTER TABLE repo_analyzes ADD COLUMN result_archive_key VARCHAR(256) NOT NULL DEFAULT '';
+ALTER TABLE pull_request_analyzes ADD COLUMN result_archive_key VARCHAR(256) NOT NULL DEFAULT '';
+
+CREATE INDEX repo_analyzes_not_archived_idx ON repo_analyzes(id) WHERE result_archive_key = '';
+CREATE INDEX pull_request_analyzes_not_archived_idx ON pull_request_analyzes(id) WHERE result_archive_key = '';
@@ -0,0 +1 @@
+ALTER TABLE repo_analysis_statuses DROP COLUMN last_analyzed_linters_version_range;
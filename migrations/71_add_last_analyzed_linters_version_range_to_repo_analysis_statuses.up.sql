@@ -0,0 +1 @@
+ALTER TABLE repo_analysis_statuses ADD COLUMN last_analyzed_linters_version_range VARCHAR(64) NOT NULL DEFAULT '';
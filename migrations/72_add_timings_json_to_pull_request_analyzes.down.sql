@@ -0,0 +1 @@
+ALTER TABLE pull_request_analyzes DROP COLUMN timings_json;
@@ -0,0 +1,5 @@
+ALTER TABLE pull_request_analyzes ADD COLUMN timings_json JSONB;
+
+-- timings of archived results are kept in the column: results are archived only after this migration
+UPDATE pull_request_analyzes SET timings_json = result_json->'WorkerRes'->'Timings'
+  WHERE result_archive_key = '' AND jsonb_typeof(result_json->'WorkerRes'->'Timings') = 'array';
@@ -12,6 +12,7 @@ import (
 	"github.com/golangci/golangci-api/pkg/worker/analyze/analyzesqueue"
 	"github.com/golangci/golangci-api/pkg/worker/analyze/analyzesqueue/pullanalyzesqueue"
 	"github.com/golangci/golangci-api/pkg/worker/analyze/analyzesqueue/repoanalyzesqueue"
+	"github.com/golangci/golangci-api/pkg/worker/analyze/analyzesqueue/scheduler"
 	"github.com/golangci/golangci-api/pkg/worker/lib/experiments"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -26,25 +27,44 @@ import (
 	"github.com/golangci/golangci-api/internal/shared/db/gormdb"
 	"github.com/golangci/golangci-api/internal/shared/db/migrations"
 	"github.com/golangci/golangci-api/internal/shared/db/redis"
+	"github.com/golangci/golangci-api/internal/shared/email"
 	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/metrics"
 	"github.com/golangci/golangci-api/internal/shared/providers"
+	"github.com/golangci/golangci-api/internal/shared/providers/githubapp"
+	"github.com/golangci/golangci-api/internal/shared/providers/ratelimit"
 	"github.com/golangci/golangci-api/internal/shared/queue/aws/consumer"
 	"github.com/golangci/golangci-api/internal/shared/queue/aws/sqs"
 	"github.com/golangci/golangci-api/internal/shared/queue/consumers"
+	"github.com/golangci/golangci-api/internal/shared/queue/pause"
 	"github.com/golangci/golangci-api/internal/shared/queue/producers"
+	"github.com/golangci/golangci-api/internal/shared/tracing"
 	apiauth "github.com/golangci/golangci-api/pkg/api/auth"
 	"github.com/golangci/golangci-api/pkg/api/auth/oauth"
+	"github.com/golangci/golangci-api/pkg/api/crons/issuesbackfill"
 	"github.com/golangci/golangci-api/pkg/api/crons/pranalyzes"
 	"github.com/golangci/golangci-api/pkg/api/crons/repoinfo"
+	"github.com/golangci/golangci-api/pkg/api/crons/repoowners"
+	"github.com/golangci/golangci-api/pkg/api/crons/retention"
+	"github.com/golangci/golangci-api/pkg/api/crons/scheduling"
+	"github.com/golangci/golangci-api/pkg/api/resultsarchive"
+	"github.com/golangci/golangci-api/pkg/api/services/admin"
+	"github.com/golangci/golangci-api/pkg/api/services/apphook"
 	"github.com/golangci/golangci-api/pkg/api/services/auth"
 	"github.com/golangci/golangci-api/pkg/api/services/events"
+	"github.com/golangci/golangci-api/pkg/api/services/golangcilint"
+	"github.com/golangci/golangci-api/pkg/api/services/notification"
 	"github.com/golangci/golangci-api/pkg/api/services/organization"
 	"github.com/golangci/golangci-api/pkg/api/services/pranalysis"
 	"github.com/golangci/golangci-api/pkg/api/services/repo"
 	"github.com/golangci/golangci-api/pkg/api/services/repoanalysis"
 	"github.com/golangci/golangci-api/pkg/api/services/repohook"
+	"github.com/golangci/golangci-api/pkg/api/services/serviceconfig"
 	"github.com/golangci/golangci-api/pkg/api/services/subscription"
+	"github.com/golangci/golangci-api/pkg/api/services/suppression"
 	"github.com/golangci/golangci-api/pkg/api/workers/primaryqueue"
+	"github.com/golangci/golangci-api/pkg/api/workers/primaryqueue/codescanning"
+	"github.com/golangci/golangci-api/pkg/api/workers/primaryqueue/emails"
 	"github.com/golangci/golangci-api/pkg/api/workers/primaryqueue/invitations"
 	"github.com/golangci/golangci-api/pkg/api/workers/primaryqueue/paymentevents"
 	"github.com/golangci/golangci-api/pkg/api/workers/primaryqueue/repoanalyzes"
@@ -59,29 +79,39 @@ import (
 )
 
 type appServices struct {
-	repoanalysis repoanalysis.Service
-	repo         repo.Service
-	repohook     repohook.Service
-	pranalysis   pranalysis.Service
-	events       events.Service
-	auth         auth.Service
-	organisation organization.Service
-	subscription subscription.Service
+	repoanalysis  repoanalysis.Service
+	repo          repo.Service
+	repohook      repohook.Service
+	apphook       apphook.Service
+	pranalysis    pranalysis.Service
+	events        events.Service
+	auth          auth.Service
+	organisation  organization.Service
+	subscription  subscription.Service
+	serviceconfig serviceconfig.Service
+	golangcilint  golangcilint.Service
+	admin         admin.Service
+	notification  notification.Service
+	suppression   suppression.Service
 }
 
 type queues struct {
 	primarySQS    *sqs.Queue
 	primaryDLQSQS *sqs.Queue
 
-	analyzesSQS *sqs.Queue
+	analyzesSQS       *sqs.Queue
+	analyzesScheduler *scheduler.Scheduler // nil if analyzes go directly into the queue
 
 	producers struct {
 		primaryMultiplexer  *producers.Multiplexer
 		analyzesMultiplexer *producers.Multiplexer
 
 		repoAnalyzesLauncher *repoanalyzes.LauncherProducer
+		repoReanalyzer       *repoanalyzes.ReanalyzerProducer
 		repoAnalyzesRunner   *repoanalyzesqueue.Producer
 		pullAnalyzesRunner   *pullanalyzesqueue.Producer
+		emailsSender         *emails.SenderProducer
+		codeScanningUploader *codescanning.UploaderProducer
 	}
 }
 
@@ -107,12 +137,19 @@ type App struct {
 	paymentProviderFactory paymentproviders.Factory
 	distLockFactory        *redsync.Redsync
 	redisPool              *redigo.Pool
+	pauseStorage           pause.Storage
 	ec                     *experiments.Checker
 	policies               policies
 	cache                  cache.Cache
-
-	PRAnalyzesStaler *pranalyzes.Staler // TODO: make private
-	repoInfoUpdater  *repoinfo.Updater
+	githubApp              *githubapp.App
+	resultsArchive         *resultsarchive.Archive
+
+	PRAnalyzesStaler     *pranalyzes.Staler // TODO: make private
+	repoInfoUpdater      *repoinfo.Updater
+	repoOwnersTransferer *repoowners.Transferer
+	analyzesDispatcher   *scheduling.Dispatcher
+	resultsArchiver      *retention.Archiver
+	issuesBackfiller     *issuesbackfill.Backfiller
 }
 
 func (a App) GetDB() *gorm.DB { // TODO: remove
@@ -161,10 +198,6 @@ func (a *App) buildDeps() {
 		}
 	}
 
-	if a.providerFactory == nil {
-		a.providerFactory = providers.NewBasicFactory(a.trackedLog)
-	}
-
 	if a.paymentProviderFactory == nil {
 		a.paymentProviderFactory = paymentproviders.NewBasicFactory(a.trackedLog, a.cfg)
 	}
@@ -177,6 +210,10 @@ func (a *App) buildDeps() {
 		a.redisPool = redisPool
 	}
 
+	if a.pauseStorage == nil {
+		a.pauseStorage = pause.NewRedis(a.redisPool)
+	}
+
 	if a.ec == nil {
 		a.ec = experiments.NewChecker(a.cfg, a.trackedLog)
 	}
@@ -189,6 +226,25 @@ func (a *App) buildDeps() {
 		a.cache = cache.NewRedis(redisURL + "/1")
 	}
 
+	if a.githubApp == nil && githubapp.IsConfigured(a.cfg) {
+		githubApp, err := githubapp.NewFromConfig(a.cfg, a.cache, a.trackedLog)
+		if err != nil {
+			a.log.Fatalf("Can't make GitHub App: %s", err)
+		}
+		a.githubApp = githubApp
+	}
+
+	if a.providerFactory == nil {
+		pf := providers.NewBasicFactory(a.trackedLog)
+		if a.cfg.GetBool("PROVIDER_RATE_LIMIT_ENABLED", true) {
+			pf.SetRateLimiter(ratelimit.NewManager(a.redisPool, a.trackedLog, a.cfg))
+		}
+		if a.githubApp != nil {
+			pf.SetGithubApp(a.githubApp)
+		}
+		a.providerFactory = pf
+	}
+
 	if a.authorizer == nil {
 		authSessFactory, err := apisession.NewFactory(a.redisPool, a.cfg, 365*24*time.Hour) // 1 year
 		if err != nil {
@@ -225,6 +281,14 @@ func (a *App) buildAwsSess() {
 	a.awsSess = awsSess
 }
 
+func (a *App) buildResultsArchive() {
+	resultsArchive, err := resultsarchive.NewFromConfig(a.cfg, a.awsSess)
+	if err != nil {
+		a.log.Fatalf("Can't make results archive: %s", err)
+	}
+	a.resultsArchive = resultsArchive
+}
+
 func (a App) getQueueURL(name string, required bool) string {
 	key := fmt.Sprintf("SQS_%s_QUEUE_URL", strings.ToUpper(name))
 	url := a.cfg.GetString(key)
@@ -262,30 +326,84 @@ func (a *App) buildQueues() {
 	}
 	a.queues.producers.pullAnalyzesRunner = pullAnalyzesRunner
 
+	if a.cfg.GetBool("SCHEDULER_ENABLED", false) {
+		a.queues.analyzesScheduler = scheduler.NewScheduler(a.redisPool, a.trackedLog,
+			a.cfg.GetDuration("SCHEDULER_SLOT_TIMEOUT", scheduler.DefaultSlotTimeout))
+		repoAnalyzesRunner.SetScheduler(a.queues.analyzesScheduler)
+		pullAnalyzesRunner.SetScheduler(a.queues.analyzesScheduler)
+	}
+
 	repoAnalyzesLauncher := &repoanalyzes.LauncherProducer{}
 	if err := repoAnalyzesLauncher.Register(a.queues.producers.primaryMultiplexer); err != nil {
 		a.log.Fatalf("Failed to create 'launch repo analysis' producer: %s", err)
 	}
 	a.queues.producers.repoAnalyzesLauncher = repoAnalyzesLauncher
+
+	repoReanalyzer := &repoanalyzes.ReanalyzerProducer{}
+	if err := repoReanalyzer.Register(a.queues.producers.primaryMultiplexer); err != nil {
+		a.log.Fatalf("Failed to create 'reanalyze repos' producer: %s", err)
+	}
+	a.queues.producers.repoReanalyzer = repoReanalyzer
+
+	emailsSender := &emails.SenderProducer{}
+	if err := emailsSender.Register(a.queues.producers.primaryMultiplexer); err != nil {
+		a.log.Fatalf("Failed to create 'send emails' producer: %s", err)
+	}
+	a.queues.producers.emailsSender = emailsSender
+
+	codeScanningUploader := &codescanning.UploaderProducer{}
+	if err := codeScanningUploader.Register(a.queues.producers.primaryMultiplexer); err != nil {
+		a.log.Fatalf("Failed to create 'upload to code scanning' producer: %s", err)
+	}
+	a.queues.producers.codeScanningUploader = codeScanningUploader
 }
 
 func (a *App) buildServices() {
 	a.services.repoanalysis = repoanalysis.BasicService{
-		RepoPolicy: a.policies.repo,
+		RepoPolicy:           a.policies.repo,
+		Notifier:             a.queues.producers.emailsSender,
+		CodeScanningUploader: a.queues.producers.codeScanningUploader,
+		ResultsArchive:       a.resultsArchive,
+		Cfg:                  a.cfg,
 	}
 	a.services.repohook = repohook.BasicService{
 		ProviderFactory:       a.providerFactory,
 		AnalysisLauncherQueue: a.queues.producers.repoAnalyzesLauncher,
 		PullAnalyzeQueue:      a.queues.producers.pullAnalyzesRunner,
 		ActiveSubPolicy:       a.policies.activeSub,
+		Notifier:              a.queues.producers.emailsSender,
 		Cfg:                   a.cfg,
 	}
+	if a.githubApp != nil {
+		a.services.apphook = apphook.BasicService{
+			GithubApp: a.githubApp,
+			RepoHooks: a.services.repohook,
+		}
+	}
 	a.services.pranalysis = pranalysis.BasicService{
-		RepoPolicy: a.policies.repo,
-		Pf:         a.providerFactory,
-		Cfg:        a.cfg,
+		RepoPolicy:     a.policies.repo,
+		Pf:             a.providerFactory,
+		ResultsArchive: a.resultsArchive,
+		Cfg:            a.cfg,
 	}
 	a.services.events = events.BasicService{}
+	a.services.serviceconfig = serviceconfig.BasicService{}
+	a.services.notification = notification.BasicService{}
+	a.services.suppression = suppression.BasicService{
+		ProviderFactory: a.providerFactory,
+	}
+	a.services.golangcilint = golangcilint.BasicService{
+		ReanalyzerQueue: a.queues.producers.repoReanalyzer,
+	}
+	a.services.admin = admin.BasicService{
+		Cfg:                   a.cfg,
+		PullAnalyzesRunner:    a.queues.producers.pullAnalyzesRunner,
+		AnalysisLauncherQueue: a.queues.producers.repoAnalyzesLauncher,
+		PrimaryQueue:          a.queues.primarySQS,
+		DeadLetterQueue:       a.queues.primaryDLQSQS,
+		PauseStorage:          a.pauseStorage,
+		Queues:                []string{"primary", "primaryDeadLetter", "analyzes"},
+	}
 
 	sf, err := apisession.NewFactory(a.redisPool, a.cfg, time.Hour)
 	if err != nil {
@@ -297,7 +415,7 @@ func (a *App) buildServices() {
 		Authorizer:   a.authorizer,
 	}
 
-	a.services.organisation = organization.NewBasicService(a.policies.org)
+	a.services.organisation = organization.NewBasicService(a.policies.org, a.cache)
 
 	a.buildRepoService()
 	a.buildSubService()
@@ -364,6 +482,7 @@ func NewApp(modifiers ...Modifier) *App {
 	}
 	a.buildDeps()
 	a.buildAwsSess()
+	a.buildResultsArchive()
 	a.buildQueues()
 	a.buildServices()
 	a.buildMigrationsRunner()
@@ -380,6 +499,39 @@ func NewApp(modifiers ...Modifier) *App {
 		Log: a.trackedLog,
 		Pf:  a.providerFactory,
 	}
+	a.repoOwnersTransferer = &repoowners.Transferer{
+		Cfg:             a.cfg,
+		DB:              a.gormDB,
+		Log:             a.trackedLog,
+		ProviderFactory: a.providerFactory,
+		Notifier:        a.queues.producers.emailsSender,
+	}
+	if a.queues.analyzesScheduler != nil {
+		a.analyzesDispatcher = &scheduling.Dispatcher{
+			Cfg:             a.cfg,
+			DB:              a.gormDB,
+			Log:             a.trackedLog,
+			ProviderFactory: a.providerFactory,
+			Scheduler:       a.queues.analyzesScheduler,
+			DistLockFactory: a.distLockFactory,
+		}
+	}
+	if a.resultsArchive != nil {
+		a.resultsArchiver = &retention.Archiver{
+			Cfg:             a.cfg,
+			DB:              a.gormDB,
+			Log:             a.trackedLog,
+			Archive:         a.resultsArchive,
+			DistLockFactory: a.distLockFactory,
+		}
+	}
+	a.issuesBackfiller = &issuesbackfill.Backfiller{
+		Cfg:             a.cfg,
+		DB:              a.gormDB,
+		Log:             a.trackedLog,
+		Archive:         a.resultsArchive,
+		DistLockFactory: a.distLockFactory,
+	}
 
 	return &a
 }
@@ -395,11 +547,19 @@ func (a App) registerHandlers(r *mux.Router) {
 	repoanalysis.RegisterHandlers(a.services.repoanalysis, r, regCtx)
 	repo.RegisterHandlers(a.services.repo, r, regCtx)
 	repohook.RegisterHandlers(a.services.repohook, r, regCtx)
+	if a.services.apphook != nil {
+		apphook.RegisterHandlers(a.services.apphook, r, regCtx)
+	}
 	pranalysis.RegisterHandlers(a.services.pranalysis, r, regCtx)
 	events.RegisterHandlers(a.services.events, r, regCtx)
 	auth.RegisterHandlers(a.services.auth, r, regCtx)
 	organization.RegisterHandlers(a.services.organisation, r, regCtx)
 	subscription.RegisterHandlers(a.services.subscription, r, regCtx)
+	serviceconfig.RegisterHandlers(a.services.serviceconfig, r, regCtx)
+	golangcilint.RegisterHandlers(a.services.golangcilint, r, regCtx)
+	admin.RegisterHandlers(a.services.admin, r, regCtx)
+	notification.RegisterHandlers(a.services.notification, r, regCtx)
+	suppression.RegisterHandlers(a.services.suppression, r, regCtx)
 }
 
 func (a App) runMigrations() {
@@ -441,7 +601,8 @@ func (a App) buildMultiplexedPrimaryQueueConsumer() *consumers.Multiplexer {
 		a.log.Fatalf("Failed to register sub deleter consumer: %s", err)
 	}
 
-	paymentEventCreator := paymentevents.NewCreatorConsumer(a.trackedLog, a.sqlDB, a.cfg, a.paymentProviderFactory)
+	paymentEventCreator := paymentevents.NewCreatorConsumer(a.trackedLog, a.sqlDB, a.cfg,
+		a.paymentProviderFactory, a.queues.producers.emailsSender)
 	if err := paymentEventCreator.Register(multiplexer, a.distLockFactory); err != nil {
 		a.log.Fatalf("Failed to register payment event creator consumer: %s", err)
 	}
@@ -452,11 +613,27 @@ func (a App) buildMultiplexedPrimaryQueueConsumer() *consumers.Multiplexer {
 		a.log.Fatalf("Failed to register analyzes launcher consumer: %s", err)
 	}
 
+	reanalyzer := repoanalyzes.NewReanalyzerConsumer(a.trackedLog, a.sqlDB, a.queues.producers.repoAnalyzesLauncher)
+	if err := reanalyzer.Register(multiplexer, a.distLockFactory); err != nil {
+		a.log.Fatalf("Failed to register repos reanalyzer consumer: %s", err)
+	}
+
 	invitationsAcceptor := invitations.NewAcceptorConsumer(a.trackedLog, a.cfg, a.providerFactory)
 	if err := invitationsAcceptor.Register(multiplexer, a.distLockFactory); err != nil {
 		a.log.Fatalf("Failed to register invitations acceptor consumer: %s", err)
 	}
 
+	emailsSender := emails.NewSenderConsumer(a.trackedLog, a.sqlDB,
+		email.NewSenderFromConfig(a.cfg, a.trackedLog), a.cache)
+	if err := emailsSender.Register(multiplexer, a.distLockFactory); err != nil {
+		a.log.Fatalf("Failed to register emails sender consumer: %s", err)
+	}
+
+	codeScanningUploader := codescanning.NewUploaderConsumer(a.trackedLog, a.sqlDB, a.providerFactory)
+	if err := codeScanningUploader.Register(multiplexer, a.distLockFactory); err != nil {
+		a.log.Fatalf("Failed to register code scanning uploader consumer: %s", err)
+	}
+
 	return multiplexer
 }
 
@@ -464,6 +641,7 @@ func (a App) runConsumers() {
 	primaryQueueConsumerMultiplexer := a.buildMultiplexedPrimaryQueueConsumer()
 	primaryQueueConsumer := consumer.NewSQS(a.trackedLog, a.cfg, a.queues.primarySQS,
 		primaryQueueConsumerMultiplexer, "primary", primaryqueue.VisibilityTimeoutSec)
+	primaryQueueConsumer.SetPauseStorage(a.pauseStorage)
 
 	go primaryQueueConsumer.Run()
 }
@@ -472,6 +650,8 @@ func (a App) RunDeadLetterConsumers() {
 	primaryDLQConsumerMultiplexer := a.buildMultiplexedPrimaryQueueConsumer()
 	primaryDLQConsumer := consumer.NewSQS(a.trackedLog, a.cfg, a.queues.primaryDLQSQS,
 		primaryDLQConsumerMultiplexer, "primaryDeadLetter", primaryqueue.VisibilityTimeoutSec)
+	primaryDLQConsumer.SetIsDeadLetter(true)
+	primaryDLQConsumer.SetPauseStorage(a.pauseStorage)
 
 	primaryDLQConsumer.Run()
 }
@@ -491,23 +671,52 @@ func (a App) RunEnvironment() {
 
 	go a.PRAnalyzesStaler.Run()
 	go a.repoInfoUpdater.Run()
+	go a.repoOwnersTransferer.Run()
+	if a.analyzesDispatcher != nil {
+		go a.analyzesDispatcher.Run()
+	}
+	if a.resultsArchiver != nil {
+		go a.resultsArchiver.Run()
+	}
+	go a.issuesBackfiller.Run()
 }
 
 func (a App) RunForever() {
+	shutdownTracing, err := tracing.Init(a.cfg, "golangci-api")
+	if err != nil {
+		a.log.Fatalf("Can't init tracing: %s", err)
+	}
+	tracing.ShutdownOnSignal(a.log, shutdownTracing)
+
 	a.RunEnvironment()
+	go a.runMetricsServer()
 
 	http.Handle("/", a.GetHTTPHandler())
 
 	addr := fmt.Sprintf(":%d", a.cfg.GetInt("PORT", 3000))
 	a.log.Infof("Listening on %s...", addr)
-	if err := http.ListenAndServe(addr, nil); err != nil {
+	if err = http.ListenAndServe(addr, nil); err != nil {
 		a.log.Errorf("Can't listen HTTP on %s: %s", addr, err)
+		tracing.Shutdown(a.log, shutdownTracing)
 		os.Exit(1)
 	}
 }
 
+// runMetricsServer serves metrics on a separate port: the public API port must not expose them
+func (a App) runMetricsServer() {
+	addr := fmt.Sprintf(":%d", a.cfg.GetInt("METRICS_PORT", 9090))
+	a.log.Infof("Serving metrics on %s/metrics...", addr)
+
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("/metrics", metrics.Handler())
+	if err := http.ListenAndServe(addr, metricsMux); err != nil {
+		a.log.Errorf("Can't listen HTTP on %s: %s", addr, err)
+	}
+}
+
 func (a App) GetHTTPHandler() http.Handler {
 	r := mux.NewRouter()
+	r.Use(tracing.MuxMiddleware)
 	a.registerHandlers(r)
 
 	c := cors.New(cors.Options{
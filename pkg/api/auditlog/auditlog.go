@@ -0,0 +1,56 @@
+package auditlog
+
+import (
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/api/request"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// Write saves the entry with the diff of before and after states.
+// db must be the transaction of the change: the entry and the change are committed together.
+// rc is nil for changes made by the system.
+func Write(rc *request.AuthorizedContext, db *gorm.DB, e *models.AuditLogEntry, before, after interface{}) error {
+	if rc != nil {
+		e.ActorUserID = rc.User.ID
+		e.RequestID = rc.RequestID
+	}
+
+	if err := e.SetDiff(before, after); err != nil {
+		return errors.Wrapf(err, "failed to set diff for audit log entry %s", e.Action)
+	}
+
+	if err := e.Create(db); err != nil {
+		return errors.Wrapf(err, "failed to create audit log entry %s", e.Action)
+	}
+
+	return nil
+}
+
+// RepoState is the audited part of the repo
+type RepoState struct {
+	UserID                 uint                   `json:"userId"`
+	Name                   string                 `json:"name"`
+	IsPrivate              bool                   `json:"isPrivate"`
+	ProviderInstallationID int                    `json:"providerInstallationId,omitempty"`
+	CommitState            models.RepoCommitState `json:"commitState"`
+}
+
+func NewRepoState(r *models.Repo) *RepoState {
+	return &RepoState{
+		UserID:                 r.UserID,
+		Name:                   r.DisplayFullName,
+		IsPrivate:              r.IsPrivate,
+		ProviderInstallationID: r.ProviderInstallationID,
+		CommitState:            r.CommitState,
+	}
+}
+
+func NewRepoEntry(r *models.Repo, action models.AuditLogAction) *models.AuditLogEntry {
+	return &models.AuditLogEntry{
+		Provider: r.Provider,
+		OrgName:  r.Owner(),
+		Action:   action,
+		TargetID: r.ID,
+	}
+}
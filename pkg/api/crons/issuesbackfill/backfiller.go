@@ -0,0 +1,74 @@
+package issuesbackfill
+
+import (
+	"context"
+	"time"
+
+	"github.com/golangci/golangci-api/internal/shared/config"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/pkg/api/resultsarchive"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	redsync "gopkg.in/redsync.v1"
+)
+
+// Backfiller stores issues of analyzes saved before the issues table existed.
+// It parses result json of a batch of such analyzes per iteration to not load the db.
+type Backfiller struct {
+	Cfg             config.Config
+	DB              *gorm.DB
+	Log             logutil.Log
+	Archive         *resultsarchive.Archive
+	DistLockFactory *redsync.Redsync
+}
+
+func (b Backfiller) Run() {
+	interval := b.Cfg.GetDuration("ISSUES_BACKFILL_INTERVAL", time.Minute)
+	for range time.Tick(interval) {
+		if err := b.RunIteration(); err != nil {
+			b.Log.Warnf("Can't run iteration of backfilling issues: %s", err)
+		}
+	}
+}
+
+func (b Backfiller) RunIteration() error {
+	// only one api instance backfills issues at a time
+	mutex := b.DistLockFactory.NewMutex("locks/issues/backfill",
+		redsync.SetExpiry(b.Cfg.GetDuration("ISSUES_BACKFILL_INTERVAL", time.Minute)), redsync.SetTries(1))
+	if err := mutex.Lock(); err != nil {
+		return nil
+	}
+	defer mutex.Unlock()
+
+	return b.backfill(context.Background())
+}
+
+func (b Backfiller) backfill(ctx context.Context) error {
+	batchSize := b.Cfg.GetInt("ISSUES_BACKFILL_BATCH_SIZE", 100)
+	tables := []table{
+		repoAnalyzesTable{db: b.DB, log: b.Log, results: b.Archive},
+		pullRequestAnalyzesTable{db: b.DB, log: b.Log, results: b.Archive},
+	}
+	for _, t := range tables {
+		ids, err := t.fetchNotStored(batchSize)
+		if err != nil {
+			return errors.Wrapf(err, "failed to fetch %s", t.name())
+		}
+		if len(ids) == 0 {
+			continue
+		}
+
+		var failed int
+		for _, id := range ids {
+			if err = t.store(ctx, id); err != nil {
+				// don't stop on the broken analysis: it will be retried on the next iteration
+				b.Log.Warnf("Failed to store issues of %s %d: %s", t.name(), id, err)
+				failed++
+			}
+		}
+
+		b.Log.Infof("Backfilled issues of %d %s, failed %d", len(ids)-failed, t.name(), failed)
+	}
+
+	return nil
+}
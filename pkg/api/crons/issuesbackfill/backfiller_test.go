@@ -0,0 +1,91 @@
+package issuesbackfill
+
+import (
+	"context"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/golangci/golangci-api/internal/shared/config"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb/gormdbtest"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/require"
+)
+
+const testResultJSON = `{"GolangciLintRes":{"Issues":[{"FromLinter":"govet","Text":"x","Pos":{"Filename":"main.go","Line":3}}]}}`
+
+func newTestBackfiller(db *gorm.DB) Backfiller {
+	log := logutil.NewStderrLog("test")
+	return Backfiller{
+		Cfg: config.NewEnvConfig(log),
+		DB:  db,
+		Log: log,
+	}
+}
+
+func expectAnalysis(mock sqlmock.Sqlmock, table string, id int, resultJSON string) {
+	mock.ExpectQuery(`SELECT \* FROM "` + table + `" WHERE .*\(id = \$1\)`).
+		WithArgs(id).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "result_json"}).AddRow(id, []byte(resultJSON)))
+	mock.ExpectBegin()
+}
+
+func expectMarkStored(mock sqlmock.Sqlmock, table string, id int, updated int64) {
+	mock.ExpectExec(`UPDATE "`+table+`" SET "issues_stored" = \$1, "updated_at" = \$2 WHERE .*\(id = \$3\) AND \(issues_stored = \$4\)`).
+		WithArgs(true, sqlmock.AnyArg(), id, false).
+		WillReturnResult(sqlmock.NewResult(0, updated))
+}
+
+func TestBackfill(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	mock.ExpectQuery(`SELECT id FROM "repo_analyzes" WHERE .*\(issues_stored = \$1\)\) ORDER BY id ASC LIMIT 100`).
+		WithArgs(false).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2).AddRow(3))
+
+	// issues are stored
+	expectAnalysis(mock, "repo_analyzes", 1, testResultJSON)
+	expectMarkStored(mock, "repo_analyzes", 1, 1)
+	mock.ExpectExec(`DELETE FROM "issues" WHERE \(analysis_type = \$1\) AND \(analysis_id = \$2\)`).
+		WithArgs("repo", 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`INSERT INTO issues \(.*\) VALUES \(\$1, .*\$13\)$`).
+		WithArgs(sqlmock.AnyArg(), "repo", 1, "govet", "main.go", 3, 3, 0, "x", sqlmock.AnyArg(), "", nil, false).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// issues were stored by the worker in parallel
+	expectAnalysis(mock, "repo_analyzes", 2, testResultJSON)
+	expectMarkStored(mock, "repo_analyzes", 2, 0)
+	mock.ExpectCommit()
+
+	// broken result json isn't retried
+	expectAnalysis(mock, "repo_analyzes", 3, "{")
+	expectMarkStored(mock, "repo_analyzes", 3, 1)
+	mock.ExpectCommit()
+
+	mock.ExpectQuery(`SELECT id FROM "pull_request_analyzes" WHERE .*\(issues_stored = \$1\)`).
+		WithArgs(false).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	require.NoError(t, newTestBackfiller(db).backfill(context.Background()))
+}
+
+func TestBackfillRetriesArchivedResult(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	mock.ExpectQuery(`SELECT id FROM "repo_analyzes"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`SELECT id FROM "pull_request_analyzes" WHERE .*\(issues_stored = \$1\)`).
+		WithArgs(false).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	// the results archive isn't configured: the analysis stays not stored
+	mock.ExpectQuery(`SELECT \* FROM "pull_request_analyzes" WHERE .*\(id = \$1\)`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "result_archive_key"}).AddRow(1, "pull_request_analyzes/1"))
+
+	require.NoError(t, newTestBackfiller(db).backfill(context.Background()))
+}
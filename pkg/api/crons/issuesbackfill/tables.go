@@ -0,0 +1,143 @@
+package issuesbackfill
+
+import (
+	"context"
+
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/pkg/api/issues"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/api/resultsarchive"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// table abstracts backfilling of issues of one kind of analyzes
+type table interface {
+	name() string
+	fetchNotStored(limit int) ([]uint, error)
+	store(ctx context.Context, id uint) error
+}
+
+type repoAnalyzesTable struct {
+	db      *gorm.DB
+	log     logutil.Log
+	results *resultsarchive.Archive
+}
+
+func (t repoAnalyzesTable) name() string {
+	return "repo analyzes"
+}
+
+func (t repoAnalyzesTable) fetchNotStored(limit int) ([]uint, error) {
+	var analyzes []models.RepoAnalysis
+	err := models.NewRepoAnalysisQuerySet(t.db.Select("id")).
+		IssuesStoredEq(false).
+		OrderAscByID().
+		Limit(limit).
+		All(&analyzes)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint, 0, len(analyzes))
+	for _, a := range analyzes {
+		ids = append(ids, a.ID)
+	}
+	return ids, nil
+}
+
+func (t repoAnalyzesTable) store(ctx context.Context, id uint) error {
+	var analysis models.RepoAnalysis
+	if err := models.NewRepoAnalysisQuerySet(t.db).IDEq(id).One(&analysis); err != nil {
+		return errors.Wrap(err, "failed to fetch analysis")
+	}
+
+	if err := t.results.LoadRepoAnalysisResult(ctx, &analysis); err != nil {
+		return errors.Wrap(err, "failed to load archived result")
+	}
+
+	return storeIssues(t.db, t.log, models.IssueAnalysisTypeRepo, id, analysis.ResultJSON, func(tx *gorm.DB) (int64, error) {
+		// the analysis could be saved by the worker or backfilled by another api instance in parallel
+		return models.NewRepoAnalysisQuerySet(tx).IDEq(id).IssuesStoredEq(false).
+			GetUpdater().
+			SetIssuesStored(true).
+			UpdateNum()
+	})
+}
+
+type pullRequestAnalyzesTable struct {
+	db      *gorm.DB
+	log     logutil.Log
+	results *resultsarchive.Archive
+}
+
+func (t pullRequestAnalyzesTable) name() string {
+	return "pull request analyzes"
+}
+
+func (t pullRequestAnalyzesTable) fetchNotStored(limit int) ([]uint, error) {
+	var analyzes []models.PullRequestAnalysis
+	err := models.NewPullRequestAnalysisQuerySet(t.db.Select("id")).
+		IssuesStoredEq(false).
+		OrderAscByID().
+		Limit(limit).
+		All(&analyzes)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint, 0, len(analyzes))
+	for _, a := range analyzes {
+		ids = append(ids, a.ID)
+	}
+	return ids, nil
+}
+
+func (t pullRequestAnalyzesTable) store(ctx context.Context, id uint) error {
+	var analysis models.PullRequestAnalysis
+	if err := models.NewPullRequestAnalysisQuerySet(t.db).IDEq(id).One(&analysis); err != nil {
+		return errors.Wrap(err, "failed to fetch analysis")
+	}
+
+	if err := t.results.LoadPullRequestAnalysisResult(ctx, &analysis); err != nil {
+		return errors.Wrap(err, "failed to load archived result")
+	}
+
+	return storeIssues(t.db, t.log, models.IssueAnalysisTypePullRequest, id, analysis.ResultJSON, func(tx *gorm.DB) (int64, error) {
+		// the analysis could be saved by the worker or backfilled by another api instance in parallel
+		return models.NewPullRequestAnalysisQuerySet(tx).IDEq(id).IssuesStoredEq(false).
+			GetUpdater().
+			SetIssuesStored(true).
+			UpdateNum()
+	})
+}
+
+// storeIssues parses issues from the result json and stores them if markStored marked the analysis.
+// A broken result json can't be fixed by retries, so such analysis is marked as stored without issues.
+func storeIssues(db *gorm.DB, log logutil.Log, analysisType models.IssueAnalysisType, id uint, resultJSON []byte,
+	markStored func(tx *gorm.DB) (int64, error)) (retErr error) {
+
+	analysisIssues, parseErr := issues.Parse(resultJSON)
+
+	tx, finishTx, err := gormdb.StartTx(db)
+	if err != nil {
+		return err
+	}
+	defer finishTx(&retErr)
+
+	n, err := markStored(tx)
+	if err != nil {
+		return errors.Wrap(err, "failed to mark issues as stored")
+	}
+	if n == 0 {
+		return nil
+	}
+
+	if parseErr != nil {
+		log.Warnf("Failed to parse issues of %s analysis %d, storing no issues: %s", analysisType, id, parseErr)
+		return nil
+	}
+
+	return issues.Replace(tx, analysisType, id, analysisIssues)
+}
@@ -167,9 +167,9 @@ func (r *Reanalyzer) processAnalysis(ctx context.Context, a *models.PullRequestA
 		return errors.Wrapf(err, "failed to fetch repo %d", a.RepoID)
 	}
 
-	p, err := r.pf.BuildForUser(r.db, repo.UserID)
+	p, err := r.pf.BuildForRepo(r.db, &repo)
 	if err != nil {
-		return errors.Wrapf(err, "failed to build provider for user %d", repo.UserID)
+		return errors.Wrapf(err, "failed to build provider for repo %d", repo.ID)
 	}
 
 	prLink := p.LinkToPullRequest(&repo, a.PullRequestNumber)
@@ -232,7 +232,7 @@ func (r *Reanalyzer) processAnalysis(ctx context.Context, a *models.PullRequestA
 	r.log.Infof("#%d: %s in state %s with status %s is starting reanalyzing (%s ago): reason is %s",
 		i, link, pr.State, a.Status, time.Since(a.CreatedAt), reason)
 
-	if err = r.restartAnalysis(a, &repo); err != nil {
+	if err = RestartAnalysis(r.db, r.runQueue, a, &repo); err != nil {
 		return errors.Wrapf(err, "failed to restart pull request %s analysis", link)
 	}
 
@@ -260,32 +260,54 @@ func (r *Reanalyzer) processAnalysis(ctx context.Context, a *models.PullRequestA
 	return nil
 }
 
-func (r Reanalyzer) restartAnalysis(a *models.PullRequestAnalysis, repo *models.Repo) error {
-	var auth models.Auth
-	if err := models.NewAuthQuerySet(r.db).UserIDEq(repo.UserID).One(&auth); err != nil {
-		return errors.Wrapf(err, "failed to get auth for repo %d", repo.ID)
-	}
+// RestartAnalysis sends the pull request analysis into the queue again
+func RestartAnalysis(db *gorm.DB, runQueue *pullanalyzesqueue.Producer, a *models.PullRequestAnalysis, repo *models.Repo) error {
+	var accessToken string
+	if repo.ProviderInstallationID == 0 {
+		var auth models.Auth
+		if err := models.NewAuthQuerySet(db).UserIDEq(repo.UserID).One(&auth); err != nil {
+			return errors.Wrapf(err, "failed to get auth for repo %d", repo.ID)
+		}
 
-	accessToken := auth.AccessToken
-	if repo.IsPrivate {
-		accessToken = auth.PrivateAccessToken // TODO: check it's not empty
+		accessToken = auth.AccessToken
+		if repo.IsPrivate {
+			accessToken = auth.PrivateAccessToken // TODO: check it's not empty
+		}
 	}
 
 	githubCtx := github.Context{
 		Repo: github.Repo{
-			Owner: repo.Owner(),
-			Name:  repo.Repo(),
+			Owner:     repo.Owner(),
+			Name:      repo.Repo(),
+			IsPrivate: repo.IsPrivate,
 		},
 		GithubAccessToken: accessToken,
 		PullRequestNumber: a.PullRequestNumber,
+
+		GithubInstallationID: repo.ProviderInstallationID,
 	}
 
-	err := r.runQueue.Put(&pullanalyzesqueue.RunMessage{
+	newerCount, err := models.NewPullRequestAnalysisQuerySet(db).
+		RepoIDEq(a.RepoID).
+		PullRequestNumberEq(a.PullRequestNumber).
+		CreatedAtGt(a.CreatedAt).
+		Count()
+	if err != nil {
+		return errors.Wrapf(err, "failed to count newer analyzes of pull request %d", a.PullRequestNumber)
+	}
+
+	m := &pullanalyzesqueue.RunMessage{
 		Context:      githubCtx,
 		UserID:       repo.UserID,
 		AnalysisGUID: a.GithubDeliveryGUID,
 		CommitSHA:    a.CommitSHA,
-	})
+	}
+	if newerCount == 0 {
+		err = runQueue.Put(m)
+	} else {
+		// don't cancel the queued analysis of a newer commit
+		err = runQueue.PutRestarted(m)
+	}
 	if err != nil {
 		return errors.Wrap(err, "can't send pull request for analysis into queue: %s")
 	}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/golangci/golangci-api/internal/shared/providers"
 	"github.com/golangci/golangci-api/internal/shared/providers/provider"
+	"github.com/golangci/golangci-api/internal/shared/providers/ratelimit"
 
 	"github.com/golangci/golangci-api/internal/shared/config"
 	"github.com/golangci/golangci-api/internal/shared/logutil"
@@ -54,6 +55,9 @@ func (u Updater) runIteration(lastErrors map[uint]time.Time) error {
 			if cause == provider.ErrUnauthorized || cause == provider.ErrNotFound {
 				continue
 			}
+			if cause == provider.ErrRateLimited {
+				continue // will be updated in the next iteration
+			}
 
 			if !printAllErrors {
 				lastErroredAt, ok := lastErrors[r.ID]
@@ -70,12 +74,14 @@ func (u Updater) runIteration(lastErrors map[uint]time.Time) error {
 }
 
 func (u Updater) updateRepoInfo(r *models.Repo) error {
-	p, err := u.Pf.BuildForUser(u.DB, r.UserID)
+	p, err := u.Pf.BuildForRepo(u.DB, r)
 	if err != nil {
 		return errors.Wrap(err, "failed to build provider")
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute*2)
+	// don't spend the quota of users' tokens needed for analyzes
+	ctx := ratelimit.ContextWithPriority(context.Background(), ratelimit.PriorityLow)
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*2)
 	defer cancel()
 
 	providerRepo, err := p.GetRepoByName(ctx, r.Owner(), r.Repo())
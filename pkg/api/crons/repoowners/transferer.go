@@ -0,0 +1,351 @@
+package repoowners
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golangci/golangci-api/internal/shared/config"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/providers"
+	"github.com/golangci/golangci-api/internal/shared/providers/provider"
+	"github.com/golangci/golangci-api/internal/shared/providers/ratelimit"
+	"github.com/golangci/golangci-api/pkg/api/auditlog"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/api/workers/primaryqueue/emails"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+const revokedTokenReason = "access token of the user was revoked"
+
+// Transferer finds repos connected by users who lost access to the provider
+// (e.g. revoked the token or left the organization) and reassigns them to another
+// admin of the organization. Without the transfer hooks stay alive but analyzes
+// of such repos fail because they are made with the token of the repo user.
+type Transferer struct {
+	Cfg             config.Config
+	DB              *gorm.DB
+	Log             logutil.Log
+	ProviderFactory providers.Factory
+	Notifier        Notifier
+}
+
+// Notifier sends emails to users
+type Notifier interface {
+	Put(event models.NotificationEvent, userIDs []uint, data map[string]string, dedupKey string) error
+}
+
+var _ Notifier = &emails.SenderProducer{}
+
+func (t Transferer) Run() {
+	interval := t.Cfg.GetDuration("REPO_OWNERS_CHECK_INTERVAL", 6*time.Hour)
+	for range time.Tick(interval) {
+		if err := t.RunIteration(); err != nil {
+			t.Log.Warnf("Can't run iteration of transferring repo owners: %s", err)
+		}
+	}
+}
+
+func (t Transferer) RunIteration() error {
+	var repos []models.Repo
+	err := models.NewRepoQuerySet(t.DB).
+		ProviderInstallationIDEq(0). // app installations don't depend on users' tokens
+		CommitStateEq(models.RepoCommitStateCreateDone).
+		OrderAscByID().
+		All(&repos)
+	if err != nil {
+		return errors.Wrap(err, "can't get repos")
+	}
+
+	reposByUser := map[uint][]models.Repo{}
+	var userIDs []uint
+	for _, r := range repos {
+		if _, ok := reposByUser[r.UserID]; !ok {
+			userIDs = append(userIDs, r.UserID)
+		}
+		reposByUser[r.UserID] = append(reposByUser[r.UserID], r)
+	}
+
+	var transferredN, notifiedN int
+	for _, userID := range userIDs {
+		lostRepos, err := t.lostAccessRepos(userID, reposByUser[userID])
+		if err != nil {
+			t.Log.Warnf("Failed to check access of user %d: %s", userID, err)
+			continue
+		}
+
+		for _, r := range lostRepos {
+			transferred, err := t.transferRepo(r)
+			if err != nil {
+				t.Log.Warnf("Failed to transfer repo %s ID=%d: %s", r.FullName, r.ID, err)
+				continue
+			}
+
+			if transferred {
+				transferredN++
+			} else {
+				notifiedN++
+			}
+		}
+	}
+
+	if transferredN != 0 || notifiedN != 0 {
+		t.Log.Infof("Transferred %d repos to new owners, %d repos have no replacement owner", transferredN, notifiedN)
+	}
+	return nil
+}
+
+// lostAccessRepos returns repos of the user which the user can't access anymore:
+// all repos if the token was revoked, otherwise only repos which aren't visible
+// for the user, e.g. after leaving their organization
+func (t Transferer) lostAccessRepos(userID uint, repos []models.Repo) ([]*models.Repo, error) {
+	p, err := t.ProviderFactory.BuildForUser(t.DB, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build provider")
+	}
+
+	var ret []*models.Repo
+	for i := range repos {
+		r := &repos[i]
+		_, err = t.getProviderRepo(p, r)
+		if err == nil {
+			continue
+		}
+
+		switch errors.Cause(err) {
+		case provider.ErrUnauthorized:
+			// the token is checked by any call: all repos of the user lost access
+			ret = ret[:0]
+			for j := range repos {
+				ret = append(ret, &repos[j])
+			}
+			return ret, nil
+		case provider.ErrNotFound:
+			ret = append(ret, r)
+		default:
+			t.Log.Warnf("Failed to check access of user %d to repo %s: %s", userID, r.FullName, err)
+		}
+	}
+
+	return ret, nil
+}
+
+func lowPriorityContext() (context.Context, context.CancelFunc) {
+	// don't spend the quota of users' tokens needed for analyzes
+	ctx := ratelimit.ContextWithPriority(context.Background(), ratelimit.PriorityLow)
+	return context.WithTimeout(ctx, time.Minute)
+}
+
+func (t Transferer) getProviderRepo(p provider.Provider, r *models.Repo) (*provider.Repo, error) {
+	ctx, cancel := lowPriorityContext()
+	defer cancel()
+
+	providerRepo, err := p.GetRepoByName(ctx, r.Owner(), r.Repo())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get repo %s", r.FullName)
+	}
+
+	return providerRepo, nil
+}
+
+// transferRepo returns false if no replacement owner was found
+func (t Transferer) transferRepo(r *models.Repo) (bool, error) {
+	candidates, err := t.getCandidates(r)
+	if err != nil {
+		return false, err
+	}
+
+	for _, c := range candidates {
+		ok, err := t.isRepoAdmin(c.ID, r)
+		if err != nil {
+			t.Log.Infof("Can't use user %d as a new owner of repo %s: %s", c.ID, r.FullName, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		if err = t.saveTransfer(r, c.ID); err != nil {
+			return false, err
+		}
+
+		t.Log.Infof("Transferred repo %s ID=%d from user %d to user %d", r.FullName, r.ID, r.UserID, c.ID)
+		return true, nil
+	}
+
+	return false, t.notifyNoReplacement(r, candidates)
+}
+
+// getCandidates returns users who connected repos of the same organization, the most active first
+func (t Transferer) getCandidates(r *models.Repo) ([]models.User, error) {
+	var orgRepos []models.Repo
+	err := models.NewRepoQuerySet(t.DB.Unscoped().Where("name LIKE ?", r.Owner()+"/%")).
+		ProviderEq(r.Provider).
+		UserIDNe(r.UserID).
+		OrderDescByUpdatedAt().
+		All(&orgRepos)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get organization repos")
+	}
+
+	var userIDs []uint
+	seen := map[uint]bool{}
+	for _, orgRepo := range orgRepos {
+		if !seen[orgRepo.UserID] {
+			seen[orgRepo.UserID] = true
+			userIDs = append(userIDs, orgRepo.UserID)
+		}
+	}
+
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	var users []models.User
+	if err = models.NewUserQuerySet(t.DB).IDIn(userIDs...).All(&users); err != nil {
+		return nil, errors.Wrap(err, "failed to get users")
+	}
+
+	userByID := map[uint]models.User{}
+	for _, u := range users {
+		userByID[u.ID] = u
+	}
+
+	var ret []models.User
+	for _, id := range userIDs {
+		if u, ok := userByID[id]; ok {
+			ret = append(ret, u)
+		}
+	}
+
+	return ret, nil
+}
+
+func (t Transferer) isRepoAdmin(userID uint, r *models.Repo) (bool, error) {
+	p, err := t.ProviderFactory.BuildForUser(t.DB, userID)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to build provider")
+	}
+
+	providerRepo, err := t.getProviderRepo(p, r)
+	if err != nil {
+		return false, err
+	}
+
+	return providerRepo.IsAdmin, nil
+}
+
+func (t Transferer) isOrgAdmin(userID uint, org string) (bool, error) {
+	p, err := t.ProviderFactory.BuildForUser(t.DB, userID)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to build provider")
+	}
+
+	ctx, cancel := lowPriorityContext()
+	defer cancel()
+
+	m, err := p.GetOrgMembershipByName(ctx, org)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get membership in org %s", org)
+	}
+
+	return m.IsAdmin, nil
+}
+
+// getOrgAdmins returns candidates who are admins of the repo organization:
+// they can reconnect the repo
+func (t Transferer) getOrgAdmins(r *models.Repo, candidates []models.User) []uint {
+	var ret []uint
+	for _, c := range candidates {
+		ok, err := t.isOrgAdmin(c.ID, r.Owner())
+		if err != nil {
+			t.Log.Infof("Can't check user %d is an admin of %s: %s", c.ID, r.Owner(), err)
+			continue
+		}
+		if ok {
+			ret = append(ret, c.ID)
+		}
+	}
+
+	return ret
+}
+
+func (t Transferer) saveTransfer(r *models.Repo, toUserID uint) (retErr error) {
+	tx, finishTx, err := gormdb.StartTx(t.DB)
+	if err != nil {
+		return err
+	}
+	defer finishTx(&retErr)
+
+	// the user could reconnect the repo concurrently
+	n, err := models.NewRepoQuerySet(tx).IDEq(r.ID).UserIDEq(r.UserID).GetUpdater().
+		SetUserID(toUserID).
+		UpdateNum()
+	if err != nil {
+		return errors.Wrap(err, "failed to update repo user")
+	}
+	if n == 0 {
+		return fmt.Errorf("repo %d was changed concurrently", r.ID)
+	}
+
+	transfer := models.RepoOwnerTransfer{
+		RepoID:     r.ID,
+		FromUserID: r.UserID,
+		ToUserID:   toUserID,
+		Reason:     revokedTokenReason,
+	}
+	if err = transfer.Create(tx); err != nil {
+		return errors.Wrap(err, "failed to create repo owner transfer")
+	}
+
+	entry := auditlog.NewRepoEntry(r, models.AuditLogActionRepoOwnerTransfer)
+	before := map[string]uint{"userId": r.UserID}
+	after := map[string]uint{"userId": toUserID}
+	return auditlog.Write(nil, tx, entry, before, after)
+}
+
+func (t Transferer) notifyNoReplacement(r *models.Repo, candidates []models.User) error {
+	n, err := models.NewRepoOwnerTransferQuerySet(t.DB).
+		RepoIDEq(r.ID).
+		FromUserIDEq(r.UserID).
+		ToUserIDEq(0).
+		Count()
+	if err != nil {
+		return errors.Wrap(err, "failed to check repo owner transfers")
+	}
+	if n != 0 {
+		return nil // already notified
+	}
+
+	var user models.User
+	if err = models.NewUserQuerySet(t.DB).IDEq(r.UserID).One(&user); err != nil {
+		return errors.Wrapf(err, "failed to get user %d", r.UserID)
+	}
+
+	transfer := models.RepoOwnerTransfer{
+		RepoID:     r.ID,
+		FromUserID: r.UserID,
+		Reason:     revokedTokenReason,
+	}
+	if err = transfer.Create(t.DB); err != nil {
+		return errors.Wrap(err, "failed to create repo owner transfer")
+	}
+
+	// the previous owner can't reconnect the repo and other members can't administer it
+	userIDs := t.getOrgAdmins(r, candidates)
+	if len(userIDs) == 0 {
+		t.Log.Infof("No new owner for repo %s ID=%d of user %d and no admins to notify", r.FullName, r.ID, r.UserID)
+		return nil
+	}
+
+	t.Log.Infof("No new owner for repo %s ID=%d of user %d, notifying %d admins", r.FullName, r.ID, r.UserID, len(userIDs))
+	data := map[string]string{
+		"Repo":         r.DisplayFullName,
+		"UserName":     user.Name,
+		"ReconnectURL": fmt.Sprintf("%s/repos/github?refresh=1", t.Cfg.GetString("WEB_ROOT")),
+	}
+	dedupKey := fmt.Sprintf("%d/%d", r.ID, r.UserID)
+	return t.Notifier.Put(models.NotificationEventRepoDisconnected, userIDs, data, dedupKey)
+}
@@ -0,0 +1,268 @@
+package repoowners
+
+import (
+	"context"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/golangci/golangci-api/internal/shared/config"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb/gormdbtest"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/providers"
+	"github.com/golangci/golangci-api/internal/shared/providers/provider"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeUser describes how the provider responds to the user
+type fakeUser struct {
+	repoErr     error
+	repoErrs    map[string]error // by repo full name, overrides repoErr
+	isRepoAdmin bool
+	isOrgAdmin  bool
+}
+
+type fakeProvider struct {
+	provider.Provider
+	user fakeUser
+}
+
+func (p fakeProvider) GetRepoByName(ctx context.Context, owner, repo string) (*provider.Repo, error) {
+	if err := p.user.repoErrs[owner+"/"+repo]; err != nil {
+		return nil, err
+	}
+	if p.user.repoErr != nil {
+		return nil, p.user.repoErr
+	}
+
+	return &provider.Repo{FullName: owner + "/" + repo, IsAdmin: p.user.isRepoAdmin}, nil
+}
+
+func (p fakeProvider) GetOrgMembershipByName(ctx context.Context, org string) (*provider.OrgMembership, error) {
+	return &provider.OrgMembership{Name: org, IsAdmin: p.user.isOrgAdmin}, nil
+}
+
+type fakeFactory struct {
+	providers.Factory
+	users map[uint]fakeUser
+}
+
+func (f fakeFactory) BuildForUser(db *gorm.DB, userID uint) (provider.Provider, error) {
+	u, ok := f.users[userID]
+	if !ok {
+		return nil, errors.Errorf("no auth for user %d", userID)
+	}
+
+	return fakeProvider{user: u}, nil
+}
+
+type sentNotification struct {
+	event   models.NotificationEvent
+	userIDs []uint
+}
+
+type fakeNotifier struct {
+	sent []sentNotification
+}
+
+func (n *fakeNotifier) Put(event models.NotificationEvent, userIDs []uint, data map[string]string, dedupKey string) error {
+	n.sent = append(n.sent, sentNotification{event: event, userIDs: userIDs})
+	return nil
+}
+
+func newTestTransferer(db *gorm.DB, users map[uint]fakeUser) (*Transferer, *fakeNotifier) {
+	log := logutil.NewStderrLog("test")
+	n := &fakeNotifier{}
+	return &Transferer{
+		Cfg:             config.NewEnvConfig(log),
+		DB:              db,
+		Log:             log,
+		ProviderFactory: fakeFactory{users: users},
+		Notifier:        n,
+	}, n
+}
+
+func newTestRepo() *models.Repo {
+	r := &models.Repo{
+		FullName:        "golangci/golangci-api",
+		DisplayFullName: "golangci/golangci-api",
+		Provider:        "github.com",
+		UserID:          1,
+	}
+	r.ID = 10
+	return r
+}
+
+func expectCandidates(mock sqlmock.Sqlmock, userIDs ...uint) {
+	repoRows := sqlmock.NewRows([]string{"id", "user_id"})
+	userRows := sqlmock.NewRows([]string{"id"})
+	for i, id := range userIDs {
+		repoRows.AddRow(100+i, id)
+		userRows.AddRow(id)
+	}
+
+	mock.ExpectQuery(`SELECT \* FROM "repos" WHERE .*name LIKE \$1.*provider = \$2.*user_id != \$3`).
+		WithArgs("golangci/%", "github.com", 1).
+		WillReturnRows(repoRows)
+	if len(userIDs) != 0 {
+		mock.ExpectQuery(`SELECT \* FROM "users" WHERE .*\(id IN \(`).
+			WillReturnRows(userRows)
+	}
+}
+
+func TestLostAccessRepos(t *testing.T) {
+	repoNames := []string{"golangci/a", "golangci/b", "golangci/c"}
+
+	cases := []struct {
+		name     string
+		user     fakeUser
+		expRepos []string
+	}{
+		{name: "has access"},
+		{
+			name:     "revoked token",
+			user:     fakeUser{repoErrs: map[string]error{"golangci/b": provider.ErrUnauthorized}},
+			expRepos: repoNames,
+		},
+		{
+			name:     "left organization of one repo",
+			user:     fakeUser{repoErrs: map[string]error{"golangci/b": provider.ErrNotFound}},
+			expRepos: []string{"golangci/b"},
+		},
+		{
+			name: "provider failure",
+			user: fakeUser{repoErrs: map[string]error{
+				"golangci/a": errors.New("timeout"),
+				"golangci/c": provider.ErrNotFound,
+			}},
+			expRepos: []string{"golangci/c"},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			tr, _ := newTestTransferer(nil, map[uint]fakeUser{1: tc.user})
+
+			var repos []models.Repo
+			for _, name := range repoNames {
+				repos = append(repos, models.Repo{FullName: name, UserID: 1})
+			}
+
+			lostRepos, err := tr.lostAccessRepos(1, repos)
+			require.NoError(t, err)
+
+			var gotRepos []string
+			for _, r := range lostRepos {
+				gotRepos = append(gotRepos, r.FullName)
+			}
+			assert.Equal(t, tc.expRepos, gotRepos)
+		})
+	}
+}
+
+func TestLostAccessReposWithoutAuth(t *testing.T) {
+	tr, _ := newTestTransferer(nil, map[uint]fakeUser{})
+
+	_, err := tr.lostAccessRepos(1, []models.Repo{*newTestRepo()})
+	assert.Error(t, err)
+}
+
+func TestTransferToRepoAdmin(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	tr, n := newTestTransferer(db, map[uint]fakeUser{
+		2: {repoErr: provider.ErrUnauthorized},
+		3: {isRepoAdmin: false},
+		4: {isRepoAdmin: true},
+	})
+
+	expectCandidates(mock, 2, 3, 4)
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "repos" SET "updated_at" = \$1, "user_id" = \$2 WHERE .*\(id = \$3\) AND \(user_id = \$4\)`).
+		WithArgs(sqlmock.AnyArg(), 4, 10, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`INSERT INTO "repo_owner_transfers"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`INSERT INTO "audit_log_entries"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	transferred, err := tr.transferRepo(newTestRepo())
+	require.NoError(t, err)
+	assert.True(t, transferred)
+	assert.Empty(t, n.sent)
+}
+
+func TestTransferNotifiesOnlyOrgAdmins(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	tr, n := newTestTransferer(db, map[uint]fakeUser{
+		1: {isOrgAdmin: true}, // the previous owner lost access
+		2: {isRepoAdmin: false, isOrgAdmin: true},
+		3: {isRepoAdmin: false, isOrgAdmin: false},
+	})
+
+	expectCandidates(mock, 2, 3)
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "repo_owner_transfers"`).
+		WithArgs(10, 1, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(`SELECT \* FROM "users" WHERE .*\(id = \$1\)`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "ex-owner"))
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "repo_owner_transfers"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	transferred, err := tr.transferRepo(newTestRepo())
+	require.NoError(t, err)
+	assert.False(t, transferred)
+
+	require.Len(t, n.sent, 1)
+	assert.Equal(t, models.NotificationEventRepoDisconnected, n.sent[0].event)
+	assert.Equal(t, []uint{2}, n.sent[0].userIDs)
+}
+
+func TestTransferWithoutOrgAdmins(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	tr, n := newTestTransferer(db, map[uint]fakeUser{})
+
+	expectCandidates(mock)
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "repo_owner_transfers"`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(`SELECT \* FROM "users" WHERE .*\(id = \$1\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "ex-owner"))
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "repo_owner_transfers"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	transferred, err := tr.transferRepo(newTestRepo())
+	require.NoError(t, err)
+	assert.False(t, transferred)
+	assert.Empty(t, n.sent)
+}
+
+func TestTransferNotifiesOnce(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	tr, n := newTestTransferer(db, map[uint]fakeUser{2: {isOrgAdmin: true}})
+
+	expectCandidates(mock, 2)
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "repo_owner_transfers"`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	transferred, err := tr.transferRepo(newTestRepo())
+	require.NoError(t, err)
+	assert.False(t, transferred)
+	assert.Empty(t, n.sent)
+}
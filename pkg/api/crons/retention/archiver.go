@@ -0,0 +1,151 @@
+package retention
+
+import (
+	"context"
+	"time"
+
+	"github.com/golangci/golangci-api/internal/shared/config"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/pkg/api/resultsarchive"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	redsync "gopkg.in/redsync.v1"
+)
+
+// Archiver moves results of old analyzes from the db to the results archive.
+// Results of repos of paid orgs stay in the db longer than results of free repos.
+type Archiver struct {
+	Cfg             config.Config
+	DB              *gorm.DB
+	Log             logutil.Log
+	Archive         *resultsarchive.Archive
+	DistLockFactory *redsync.Redsync
+}
+
+func (a Archiver) Run() {
+	interval := a.Cfg.GetDuration("RESULTS_ARCHIVE_INTERVAL", 6*time.Hour)
+	for range time.Tick(interval) {
+		if err := a.RunIteration(); err != nil {
+			a.Log.Warnf("Can't run iteration of archiving results: %s", err)
+		}
+	}
+}
+
+func (a Archiver) RunIteration() error {
+	// only one api instance archives results at a time
+	mutex := a.DistLockFactory.NewMutex("locks/retention/archive",
+		redsync.SetExpiry(a.Cfg.GetDuration("RESULTS_ARCHIVE_INTERVAL", 6*time.Hour)), redsync.SetTries(1))
+	if err := mutex.Lock(); err != nil {
+		return nil
+	}
+	defer mutex.Unlock()
+
+	ctx := context.Background()
+	plans := newPlanResolver(a.DB)
+	tables := []table{
+		repoAnalyzesTable{db: a.DB, results: a.Archive},
+		pullRequestAnalyzesTable{db: a.DB, results: a.Archive},
+	}
+	for _, t := range tables {
+		if err := a.archiveTable(ctx, t, plans); err != nil {
+			return errors.Wrapf(err, "failed to archive results of %s", t.name())
+		}
+	}
+
+	return nil
+}
+
+func (a Archiver) maxAge(isPaid bool) time.Duration {
+	if isPaid {
+		return a.Cfg.GetDuration("RESULTS_ARCHIVE_PAID_AGE", 180*24*time.Hour)
+	}
+
+	return a.Cfg.GetDuration("RESULTS_ARCHIVE_FREE_AGE", 30*24*time.Hour)
+}
+
+func (a Archiver) archiveTable(ctx context.Context, t table, plans *planResolver) error {
+	now := time.Now()
+	minMaxAge := a.maxAge(false)
+	if paidMaxAge := a.maxAge(true); paidMaxAge < minMaxAge {
+		minMaxAge = paidMaxAge
+	}
+	createdBefore := now.Add(-minMaxAge)
+
+	total, err := t.countCandidates(createdBefore)
+	if err != nil {
+		return errors.Wrap(err, "failed to count analyzes")
+	}
+	if total == 0 {
+		return nil
+	}
+
+	p := progress{log: a.Log, tableName: t.name(), total: total, startedAt: now}
+	batchSize := a.Cfg.GetInt("RESULTS_ARCHIVE_BATCH_SIZE", 100)
+	var lastID uint
+	for {
+		batch, fetchErr := t.fetchCandidates(createdBefore, lastID, batchSize)
+		if fetchErr != nil {
+			return fetchErr
+		}
+		if len(batch) == 0 {
+			break
+		}
+		lastID = batch[len(batch)-1].id
+
+		if err = a.archiveBatch(ctx, t, plans, batch, now, &p); err != nil {
+			return err
+		}
+		p.report()
+	}
+
+	p.finish()
+	return nil
+}
+
+func (a Archiver) archiveBatch(ctx context.Context, t table, plans *planResolver,
+	batch []candidate, now time.Time, p *progress) error {
+
+	for _, c := range batch {
+		p.processed++
+
+		isPaid, err := plans.isPaid(c.repoID)
+		if err != nil {
+			return err
+		}
+
+		if c.createdAt.After(now.Add(-a.maxAge(isPaid))) {
+			p.skipped++
+			continue
+		}
+
+		if err = t.archive(ctx, c.id); err != nil {
+			// don't stop on the broken analysis: it will be retried on the next iteration
+			a.Log.Warnf("Failed to archive result of %s %d: %s", t.name(), c.id, err)
+			p.failed++
+			continue
+		}
+
+		p.archived++
+	}
+
+	return nil
+}
+
+type progress struct {
+	log       logutil.Log
+	tableName string
+	startedAt time.Time
+
+	total, processed          int
+	archived, skipped, failed int
+}
+
+func (p progress) report() {
+	p.log.Infof("Archiving results of %s: processed %d/%d (archived %d, skipped %d, failed %d) for %s",
+		p.tableName, p.processed, p.total, p.archived, p.skipped, p.failed, time.Since(p.startedAt))
+}
+
+func (p progress) finish() {
+	p.log.Infof("Finished archiving results of %s: archived %d, skipped %d, failed %d of %d for %s",
+		p.tableName, p.archived, p.skipped, p.failed, p.total, time.Since(p.startedAt))
+}
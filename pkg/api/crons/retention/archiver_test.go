@@ -0,0 +1,116 @@
+package retention
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golangci/golangci-api/internal/shared/config"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTable struct {
+	candidates []candidate
+	failingIDs map[uint]bool
+	archived   []uint
+}
+
+func (t *fakeTable) name() string {
+	return "fake analyzes"
+}
+
+func (t *fakeTable) countCandidates(createdBefore time.Time) (int, error) {
+	n := 0
+	for _, c := range t.candidates {
+		if c.createdAt.Before(createdBefore) {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (t *fakeTable) fetchCandidates(createdBefore time.Time, afterID uint, limit int) ([]candidate, error) {
+	var ret []candidate
+	for _, c := range t.candidates {
+		if c.id > afterID && c.createdAt.Before(createdBefore) && len(ret) < limit {
+			ret = append(ret, c)
+		}
+	}
+	return ret, nil
+}
+
+func (t *fakeTable) archive(ctx context.Context, id uint) error {
+	if t.failingIDs[id] {
+		return errors.New("storage failure")
+	}
+
+	t.archived = append(t.archived, id)
+	return nil
+}
+
+func newTestArchiver() Archiver {
+	log := logutil.NewStderrLog("test")
+	return Archiver{Cfg: config.NewEnvConfig(log), Log: log}
+}
+
+func daysAgo(days int) time.Time {
+	return time.Now().Add(-time.Duration(days) * 24 * time.Hour)
+}
+
+func TestArchiveTable(t *testing.T) {
+	const freeRepoID, paidRepoID = 1, 2
+	plans := newPlanResolver(nil)
+	plans.paidByRepoID[freeRepoID] = false
+	plans.paidByRepoID[paidRepoID] = true
+
+	var candidates []candidate
+	for i := 1; i <= 250; i++ { // more than one batch
+		candidates = append(candidates, candidate{id: uint(i), repoID: freeRepoID, createdAt: daysAgo(40)})
+	}
+	candidates = append(candidates,
+		candidate{id: 300, repoID: freeRepoID, createdAt: daysAgo(10)}, // too new
+		candidate{id: 301, repoID: paidRepoID, createdAt: daysAgo(40)}, // paid repo keeps results longer
+		candidate{id: 302, repoID: paidRepoID, createdAt: daysAgo(200)},
+		candidate{id: 303, repoID: freeRepoID, createdAt: daysAgo(40)},
+	)
+
+	table := &fakeTable{candidates: candidates, failingIDs: map[uint]bool{5: true}}
+	require.NoError(t, newTestArchiver().archiveTable(context.Background(), table, plans))
+
+	assert.Len(t, table.archived, 251)
+	assert.NotContains(t, table.archived, uint(5))
+	assert.NotContains(t, table.archived, uint(300))
+	assert.NotContains(t, table.archived, uint(301))
+	assert.Contains(t, table.archived, uint(302))
+	assert.Contains(t, table.archived, uint(303))
+}
+
+func TestArchiveTableWithoutCandidates(t *testing.T) {
+	table := &fakeTable{candidates: []candidate{{id: 1, repoID: 1, createdAt: daysAgo(1)}}}
+	require.NoError(t, newTestArchiver().archiveTable(context.Background(), table, newPlanResolver(nil)))
+	assert.Empty(t, table.archived)
+}
+
+func TestArchiveBatchCountsProgress(t *testing.T) {
+	plans := newPlanResolver(nil)
+	plans.paidByRepoID[1] = false
+
+	a := newTestArchiver()
+	table := &fakeTable{failingIDs: map[uint]bool{2: true}}
+	batch := []candidate{
+		{id: 1, repoID: 1, createdAt: daysAgo(40)},
+		{id: 2, repoID: 1, createdAt: daysAgo(40)},
+		{id: 3, repoID: 1, createdAt: daysAgo(1)},
+	}
+
+	p := progress{log: a.Log, tableName: table.name(), total: len(batch)}
+	require.NoError(t, a.archiveBatch(context.Background(), table, plans, batch, time.Now(), &p))
+	assert.Equal(t, 3, p.processed)
+	assert.Equal(t, 1, p.archived)
+	assert.Equal(t, 1, p.failed)
+	assert.Equal(t, 1, p.skipped)
+	assert.Equal(t, []uint{1}, table.archived)
+}
@@ -0,0 +1,72 @@
+package retention
+
+import (
+	"strings"
+
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// planResolver caches plans of repos during one iteration of archiving
+type planResolver struct {
+	db           *gorm.DB
+	paidByRepoID map[uint]bool
+	paidByOrg    map[string]bool
+}
+
+func newPlanResolver(db *gorm.DB) *planResolver {
+	return &planResolver{
+		db:           db,
+		paidByRepoID: map[uint]bool{},
+		paidByOrg:    map[string]bool{},
+	}
+}
+
+// isPaid returns true if the repo owner is an org with an active subscription
+func (r *planResolver) isPaid(repoID uint) (bool, error) {
+	if isPaid, ok := r.paidByRepoID[repoID]; ok {
+		return isPaid, nil
+	}
+
+	var repos []models.Repo
+	if err := models.NewRepoQuerySet(r.db.Unscoped()).IDEq(repoID).Limit(1).All(&repos); err != nil {
+		return false, errors.Wrapf(err, "failed to fetch repo %d", repoID)
+	}
+	if len(repos) == 0 {
+		r.paidByRepoID[repoID] = false
+		return false, nil
+	}
+
+	isPaid, err := r.isPaidOrg(repos[0].Provider, repos[0].Owner())
+	if err != nil {
+		return false, err
+	}
+
+	r.paidByRepoID[repoID] = isPaid
+	return isPaid, nil
+}
+
+func (r *planResolver) isPaidOrg(providerName, name string) (bool, error) {
+	key := providerName + "/" + strings.ToLower(name)
+	if isPaid, ok := r.paidByOrg[key]; ok {
+		return isPaid, nil
+	}
+
+	var orgs []models.Org
+	if err := models.NewOrgQuerySet(r.db).ProviderEq(providerName).NameEq(name).Limit(1).All(&orgs); err != nil {
+		return false, errors.Wrapf(err, "failed to fetch org %s", key)
+	}
+
+	isPaid := false
+	if len(orgs) != 0 {
+		var subs []models.OrgSub
+		if err := models.NewOrgSubQuerySet(r.db).OrgIDEq(orgs[0].ID).Limit(1).All(&subs); err != nil {
+			return false, errors.Wrapf(err, "failed to fetch subscription of org %s", key)
+		}
+		isPaid = len(subs) != 0 && subs[0].IsActive() && subs[0].SeatsCount != 0
+	}
+
+	r.paidByOrg[key] = isPaid
+	return isPaid, nil
+}
@@ -0,0 +1,117 @@
+package retention
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb/gormdbtest"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func expectRepo(mock sqlmock.Sqlmock, id uint, rows *sqlmock.Rows) {
+	mock.ExpectQuery(`SELECT \* FROM "repos" WHERE \(id = \$1\) LIMIT 1`).
+		WithArgs(id).
+		WillReturnRows(rows)
+}
+
+func expectOrg(mock sqlmock.Sqlmock, rows *sqlmock.Rows) {
+	mock.ExpectQuery(`SELECT \* FROM "orgs" WHERE .*\(provider = \$1\) AND \(name = \$2\)\) LIMIT 1`).
+		WithArgs("github.com", "golangci").
+		WillReturnRows(rows)
+}
+
+func expectOrgSub(mock sqlmock.Sqlmock, rows *sqlmock.Rows) {
+	mock.ExpectQuery(`SELECT \* FROM "org_subs" WHERE .*\(org_id = \$1\)\) LIMIT 1`).
+		WithArgs(5).
+		WillReturnRows(rows)
+}
+
+func repoRows(fullName string) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "provider", "name"}).AddRow(1, "github.com", fullName)
+}
+
+func orgSubRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "org_id", "seats_count", "commit_state"})
+}
+
+func TestIsPaid(t *testing.T) {
+	cases := []struct {
+		name   string
+		subs   *sqlmock.Rows
+		isPaid bool
+	}{
+		{name: "no subscription", subs: orgSubRows()},
+		{name: "active subscription", subs: orgSubRows().AddRow(1, 5, 3, models.OrgSubCommitStateCreateDone), isPaid: true},
+		{name: "subscription is being created", subs: orgSubRows().AddRow(1, 5, 3, models.OrgSubCommitStateCreateInit)},
+		{name: "deleted subscription", subs: orgSubRows().AddRow(1, 5, 3, models.OrgSubCommitStateDeleteDone)},
+		{name: "subscription without seats", subs: orgSubRows().AddRow(1, 5, 0, models.OrgSubCommitStateUpdateDone)},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock, finish := gormdbtest.NewMockDB(t)
+			defer finish()
+
+			expectRepo(mock, 1, repoRows("golangci/golangci-api"))
+			expectOrg(mock, sqlmock.NewRows([]string{"id", "provider", "name"}).AddRow(5, "github.com", "golangci"))
+			expectOrgSub(mock, tc.subs)
+
+			isPaid, err := newPlanResolver(db).isPaid(1)
+			require.NoError(t, err)
+			assert.Equal(t, tc.isPaid, isPaid)
+		})
+	}
+}
+
+func TestIsPaidOfUserRepo(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	expectRepo(mock, 1, repoRows("user/repo"))
+	mock.ExpectQuery(`SELECT \* FROM "orgs"`).
+		WithArgs("github.com", "user").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	isPaid, err := newPlanResolver(db).isPaid(1)
+	require.NoError(t, err)
+	assert.False(t, isPaid)
+}
+
+func TestIsPaidOfDeletedRepo(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	expectRepo(mock, 1, sqlmock.NewRows([]string{"id"}))
+
+	plans := newPlanResolver(db)
+	isPaid, err := plans.isPaid(1)
+	require.NoError(t, err)
+	assert.False(t, isPaid)
+
+	// the plan is cached
+	isPaid, err = plans.isPaid(1)
+	require.NoError(t, err)
+	assert.False(t, isPaid)
+}
+
+func TestIsPaidCachesOrgs(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	expectRepo(mock, 1, repoRows("golangci/golangci-api"))
+	expectOrg(mock, sqlmock.NewRows([]string{"id", "provider", "name"}).AddRow(5, "github.com", "golangci"))
+	expectOrgSub(mock, orgSubRows().AddRow(1, 5, 3, models.OrgSubCommitStateUpdateDone))
+	// the other repo of the same org doesn't fetch the org again
+	expectRepo(mock, 2, sqlmock.NewRows([]string{"id", "provider", "name"}).
+		AddRow(2, "github.com", "golangci/golangci-lint"))
+
+	plans := newPlanResolver(db)
+	for _, repoID := range []uint{1, 2, 1} {
+		isPaid, err := plans.isPaid(repoID)
+		require.NoError(t, err)
+		assert.True(t, isPaid)
+	}
+}
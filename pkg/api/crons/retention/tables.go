@@ -0,0 +1,180 @@
+package retention
+
+import (
+	"context"
+	"time"
+
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/api/resultsarchive"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// candidate is an analysis which result can be archived, it's fetched without the result
+type candidate struct {
+	id        uint
+	repoID    uint
+	createdAt time.Time
+}
+
+// table abstracts archiving of results of one kind of analyzes
+type table interface {
+	name() string
+	countCandidates(createdBefore time.Time) (int, error)
+	fetchCandidates(createdBefore time.Time, afterID uint, limit int) ([]candidate, error)
+	archive(ctx context.Context, id uint) error
+}
+
+type repoAnalyzesTable struct {
+	db      *gorm.DB
+	results *resultsarchive.Archive
+}
+
+func (t repoAnalyzesTable) name() string {
+	return "repo analyzes"
+}
+
+func (t repoAnalyzesTable) candidatesQS(db *gorm.DB, createdBefore time.Time) models.RepoAnalysisQuerySet {
+	return models.NewRepoAnalysisQuerySet(db).ResultArchiveKeyEq("").CreatedAtLt(createdBefore)
+}
+
+func (t repoAnalyzesTable) countCandidates(createdBefore time.Time) (int, error) {
+	return t.candidatesQS(t.db, createdBefore).Count()
+}
+
+func (t repoAnalyzesTable) fetchCandidates(createdBefore time.Time, afterID uint, limit int) ([]candidate, error) {
+	var analyzes []models.RepoAnalysis
+	err := t.candidatesQS(t.db.Select("id, created_at, repo_analysis_status_id"), createdBefore).
+		IDGt(afterID).
+		OrderAscByID().
+		Limit(limit).
+		All(&analyzes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch repo analyzes")
+	}
+	if len(analyzes) == 0 {
+		return nil, nil
+	}
+
+	statusIDs := make([]uint, 0, len(analyzes))
+	for _, a := range analyzes {
+		statusIDs = append(statusIDs, a.RepoAnalysisStatusID)
+	}
+
+	var statuses []models.RepoAnalysisStatus
+	if err = models.NewRepoAnalysisStatusQuerySet(t.db.Unscoped()).IDIn(statusIDs...).All(&statuses); err != nil {
+		return nil, errors.Wrap(err, "failed to fetch repo analysis statuses")
+	}
+
+	repoIDByStatusID := map[uint]uint{}
+	for _, as := range statuses {
+		repoIDByStatusID[as.ID] = as.RepoID
+	}
+
+	ret := make([]candidate, 0, len(analyzes))
+	for _, a := range analyzes {
+		ret = append(ret, candidate{
+			id:        a.ID,
+			repoID:    repoIDByStatusID[a.RepoAnalysisStatusID],
+			createdAt: a.CreatedAt,
+		})
+	}
+
+	return ret, nil
+}
+
+func (t repoAnalyzesTable) archive(ctx context.Context, id uint) error {
+	var analysis models.RepoAnalysis
+	if err := models.NewRepoAnalysisQuerySet(t.db).IDEq(id).One(&analysis); err != nil {
+		return errors.Wrapf(err, "failed to fetch repo analysis %d", id)
+	}
+
+	key := resultsarchive.RepoAnalysisKey(id)
+	if err := t.results.Put(ctx, key, analysis.ResultJSON); err != nil {
+		return errors.Wrapf(err, "failed to archive result of repo analysis %d", id)
+	}
+
+	// the analysis could be archived by another api instance or its result could be
+	// updated in parallel: the result is archived again on the next iteration then
+	_, err := models.NewRepoAnalysisQuerySet(t.db).
+		IDEq(id).
+		ResultArchiveKeyEq("").
+		UpdatedAtEq(analysis.UpdatedAt).
+		GetUpdater().
+		SetResultArchiveKey(key).
+		SetResultJSON(resultsarchive.ArchivedResultJSON).
+		UpdateNum()
+	if err != nil {
+		return errors.Wrapf(err, "failed to update repo analysis %d", id)
+	}
+
+	return nil
+}
+
+type pullRequestAnalyzesTable struct {
+	db      *gorm.DB
+	results *resultsarchive.Archive
+}
+
+func (t pullRequestAnalyzesTable) name() string {
+	return "pull request analyzes"
+}
+
+func (t pullRequestAnalyzesTable) candidatesQS(db *gorm.DB, createdBefore time.Time) models.PullRequestAnalysisQuerySet {
+	return models.NewPullRequestAnalysisQuerySet(db).ResultArchiveKeyEq("").CreatedAtLt(createdBefore)
+}
+
+func (t pullRequestAnalyzesTable) countCandidates(createdBefore time.Time) (int, error) {
+	return t.candidatesQS(t.db, createdBefore).Count()
+}
+
+func (t pullRequestAnalyzesTable) fetchCandidates(createdBefore time.Time, afterID uint, limit int) ([]candidate, error) {
+	var analyzes []models.PullRequestAnalysis
+	err := t.candidatesQS(t.db.Select("id, created_at, repo_id"), createdBefore).
+		IDGt(afterID).
+		OrderAscByID().
+		Limit(limit).
+		All(&analyzes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch pull request analyzes")
+	}
+
+	ret := make([]candidate, 0, len(analyzes))
+	for _, a := range analyzes {
+		ret = append(ret, candidate{
+			id:        a.ID,
+			repoID:    a.RepoID,
+			createdAt: a.CreatedAt,
+		})
+	}
+
+	return ret, nil
+}
+
+func (t pullRequestAnalyzesTable) archive(ctx context.Context, id uint) error {
+	var analysis models.PullRequestAnalysis
+	if err := models.NewPullRequestAnalysisQuerySet(t.db).IDEq(id).One(&analysis); err != nil {
+		return errors.Wrapf(err, "failed to fetch pull request analysis %d", id)
+	}
+
+	key := resultsarchive.PullRequestAnalysisKey(id)
+	if err := t.results.Put(ctx, key, analysis.ResultJSON); err != nil {
+		return errors.Wrapf(err, "failed to archive result of pull request analysis %d", id)
+	}
+
+	// the analysis could be archived by another api instance or its result could be
+	// updated in parallel: the result is archived again on the next iteration then
+	_, err := models.NewPullRequestAnalysisQuerySet(t.db).
+		IDEq(id).
+		ResultArchiveKeyEq("").
+		UpdatedAtEq(analysis.UpdatedAt).
+		GetUpdater().
+		SetResultArchiveKey(key).
+		SetResultJSON(resultsarchive.ArchivedResultJSON).
+		UpdateNum()
+	if err != nil {
+		return errors.Wrapf(err, "failed to update pull request analysis %d", id)
+	}
+
+	return nil
+}
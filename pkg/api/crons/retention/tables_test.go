@@ -0,0 +1,65 @@
+package retention
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb/gormdbtest"
+	"github.com/golangci/golangci-api/pkg/api/resultsarchive"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestResultsArchive(t *testing.T) (*resultsarchive.Archive, func()) {
+	root, err := ioutil.TempDir("", "retention")
+	require.NoError(t, err)
+
+	return resultsarchive.NewArchive(resultsarchive.NewFS(root)), func() {
+		os.RemoveAll(root)
+	}
+}
+
+func TestArchiveIsGuardedByUpdatedAt(t *testing.T) {
+	cases := []struct {
+		name         string
+		updatedCount int64
+	}{
+		{name: "archived", updatedCount: 1},
+		{name: "changed in parallel"},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock, finish := gormdbtest.NewMockDB(t)
+			defer finish()
+			results, cleanup := newTestResultsArchive(t)
+			defer cleanup()
+
+			updatedAt := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+			resultJSON := `{"GolangciLintRes":{"Issues":[]}}`
+			mock.ExpectQuery(`SELECT \* FROM "repo_analyzes" WHERE .*\(id = \$1\)`).
+				WithArgs(1).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "updated_at", "result_json"}).
+					AddRow(1, updatedAt, []byte(resultJSON)))
+			mock.ExpectBegin()
+			mock.ExpectExec(`UPDATE "repo_analyzes" SET "result_archive_key" = \$1, "result_json" = \$2, "updated_at" = \$3 `+
+				`WHERE .*\(id = \$4\) AND \(result_archive_key = \$5\) AND \(updated_at = \$6\)`).
+				WithArgs(resultsarchive.RepoAnalysisKey(1), resultsarchive.ArchivedResultJSON, sqlmock.AnyArg(),
+					1, "", updatedAt).
+				WillReturnResult(sqlmock.NewResult(0, tc.updatedCount))
+			mock.ExpectCommit()
+
+			table := repoAnalyzesTable{db: db, results: results}
+			require.NoError(t, table.archive(context.Background(), 1))
+
+			archived, err := results.Load(context.Background(), resultsarchive.RepoAnalysisKey(1))
+			require.NoError(t, err)
+			assert.Equal(t, resultJSON, string(archived))
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package scheduling
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/golangci/golangci-api/internal/shared/config"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/providers"
+	"github.com/golangci/golangci-api/internal/shared/providers/provider"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/worker/analyze/analyzesqueue/scheduler"
+	"github.com/golangci/golangci-api/pkg/worker/lib/github"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	redsync "gopkg.in/redsync.v1"
+)
+
+// Dispatcher moves scheduled analyzes into the analyzes queue
+type Dispatcher struct {
+	Cfg             config.Config
+	DB              *gorm.DB
+	Log             logutil.Log
+	ProviderFactory providers.Factory
+	Scheduler       *scheduler.Scheduler
+	DistLockFactory *redsync.Redsync
+}
+
+func (d Dispatcher) Run() {
+	interval := d.Cfg.GetDuration("SCHEDULER_DISPATCH_INTERVAL", 2*time.Second)
+	for range time.Tick(interval) {
+		if err := d.runIteration(); err != nil {
+			d.Log.Warnf("Can't dispatch scheduled analyzes: %s", err)
+		}
+	}
+}
+
+func (d Dispatcher) runIteration() error {
+	// only one api instance dispatches analyzes at a time
+	mutex := d.DistLockFactory.NewMutex("locks/scheduler/dispatch",
+		redsync.SetExpiry(time.Minute), redsync.SetTries(1))
+	if err := mutex.Lock(); err != nil {
+		return nil
+	}
+	defer mutex.Unlock()
+
+	n, err := d.Scheduler.DispatchOnce(d, d.cancelPullAnalysis)
+	if err != nil {
+		return err
+	}
+
+	if n != 0 {
+		d.Log.Infof("Dispatched or canceled %d scheduled analyzes", n)
+	}
+	return nil
+}
+
+// GetLimits returns concurrency limits for the org depending on its subscription
+func (d Dispatcher) GetLimits(org string) (*scheduler.Limits, error) {
+	limits := scheduler.Limits{
+		Org:  d.Cfg.GetInt("SCHEDULER_ORG_CONCURRENCY", 2),
+		Repo: d.Cfg.GetInt("SCHEDULER_REPO_CONCURRENCY", 1),
+	}
+
+	parts := strings.SplitN(org, "/", 2)
+	if len(parts) != 2 {
+		return nil, errors.Errorf("invalid org %q", org)
+	}
+
+	var orgs []models.Org
+	if err := models.NewOrgQuerySet(d.DB).ProviderEq(parts[0]).NameEq(parts[1]).Limit(1).All(&orgs); err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch org %s", org)
+	}
+	if len(orgs) == 0 {
+		return &limits, nil
+	}
+
+	var subs []models.OrgSub
+	if err := models.NewOrgSubQuerySet(d.DB).OrgIDEq(orgs[0].ID).Limit(1).All(&subs); err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch subscription of org %s", org)
+	}
+	if len(subs) == 0 || !subs[0].IsActive() || subs[0].SeatsCount == 0 {
+		return &limits, nil
+	}
+
+	// paid orgs get more slots, big teams get a slot per seat
+	limits.Org = d.Cfg.GetInt("SCHEDULER_PAID_ORG_CONCURRENCY", 8)
+	if subs[0].SeatsCount > limits.Org {
+		limits.Org = subs[0].SeatsCount
+	}
+	limits.Repo = d.Cfg.GetInt("SCHEDULER_PAID_REPO_CONCURRENCY", 4)
+	return &limits, nil
+}
+
+func (d Dispatcher) cancelPullAnalysis(t *scheduler.Task) {
+	if err := d.cancelPullAnalysisInDB(t); err != nil {
+		d.Log.Warnf("Failed to cancel pull analysis %s: %s", t.ID, err)
+	}
+}
+
+func (d Dispatcher) cancelPullAnalysisInDB(t *scheduler.Task) error {
+	var a models.PullRequestAnalysis
+	if err := models.NewPullRequestAnalysisQuerySet(d.DB).GithubDeliveryGUIDEq(t.ID).One(&a); err != nil {
+		return errors.Wrap(err, "failed to fetch analysis")
+	}
+
+	n, err := models.NewPullRequestAnalysisQuerySet(d.DB).
+		IDEq(a.ID).
+		StatusEq("sent_to_queue").
+		GetUpdater().
+		SetStatus("superseded").
+		UpdateNum()
+	if err != nil {
+		return errors.Wrap(err, "failed to update analysis status")
+	}
+	if n == 0 {
+		return nil // was already processed, e.g. by an admin rerun
+	}
+
+	var repo models.Repo
+	if err = models.NewRepoQuerySet(d.DB.Unscoped()).IDEq(a.RepoID).One(&repo); err != nil {
+		return errors.Wrapf(err, "failed to fetch repo %d", a.RepoID)
+	}
+
+	p, err := d.ProviderFactory.BuildForRepo(d.DB, &repo)
+	if err != nil {
+		return errors.Wrapf(err, "failed to build provider for repo %d", repo.ID)
+	}
+
+	// the commit wasn't analyzed: it must neither fail the pull request checks nor stay pending
+	err = p.SetCommitStatus(context.Background(), repo.Owner(), repo.Repo(), a.CommitSHA, &provider.CommitStatus{
+		Description: "Skipped: superseded by a newer commit",
+		State:       string(github.StatusSuccess),
+		Context:     d.Cfg.GetString("APP_NAME"),
+	})
+	if err != nil {
+		if err == provider.ErrUnauthorized || err == provider.ErrNotFound {
+			d.Log.Infof("Can't set commit status of superseded analysis %s: %s", t.ID, err)
+			return nil
+		}
+		return errors.Wrap(err, "failed to set commit status")
+	}
+
+	return nil
+}
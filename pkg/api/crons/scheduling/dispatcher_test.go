@@ -0,0 +1,96 @@
+package scheduling
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/golangci/golangci-api/internal/shared/config"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb/gormdbtest"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/worker/analyze/analyzesqueue/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLimits(t *testing.T) {
+	cases := []struct {
+		name   string
+		orgs   *sqlmock.Rows
+		subs   *sqlmock.Rows
+		limits scheduler.Limits
+	}{
+		{
+			name:   "unknown org",
+			orgs:   sqlmock.NewRows([]string{"id"}),
+			limits: scheduler.Limits{Org: 2, Repo: 1},
+		},
+		{
+			name:   "free org",
+			orgs:   sqlmock.NewRows([]string{"id"}).AddRow(1),
+			subs:   sqlmock.NewRows([]string{"id"}),
+			limits: scheduler.Limits{Org: 2, Repo: 1},
+		},
+		{
+			name: "not active subscription",
+			orgs: sqlmock.NewRows([]string{"id"}).AddRow(1),
+			subs: sqlmock.NewRows([]string{"id", "seats_count", "commit_state"}).
+				AddRow(1, 3, models.OrgSubCommitStateCreateSentToQueue),
+			limits: scheduler.Limits{Org: 2, Repo: 1},
+		},
+		{
+			name: "deleted subscription",
+			orgs: sqlmock.NewRows([]string{"id"}).AddRow(1),
+			subs: sqlmock.NewRows([]string{"id", "seats_count", "commit_state"}).
+				AddRow(1, 3, models.OrgSubCommitStateDeleteDone),
+			limits: scheduler.Limits{Org: 2, Repo: 1},
+		},
+		{
+			name: "paid org",
+			orgs: sqlmock.NewRows([]string{"id"}).AddRow(1),
+			subs: sqlmock.NewRows([]string{"id", "seats_count", "commit_state"}).
+				AddRow(1, 3, models.OrgSubCommitStateUpdateDone),
+			limits: scheduler.Limits{Org: 8, Repo: 4},
+		},
+		{
+			name: "big team",
+			orgs: sqlmock.NewRows([]string{"id"}).AddRow(1),
+			subs: sqlmock.NewRows([]string{"id", "seats_count", "commit_state"}).
+				AddRow(1, 20, models.OrgSubCommitStateCreateDone),
+			limits: scheduler.Limits{Org: 20, Repo: 4},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock, finish := gormdbtest.NewMockDB(t)
+			defer finish()
+
+			mock.ExpectQuery(`SELECT \* FROM "orgs" WHERE .*\(provider = \$1\) AND \(name = \$2\)`).
+				WithArgs("github.com", "golangci").
+				WillReturnRows(tc.orgs)
+			if tc.subs != nil {
+				mock.ExpectQuery(`SELECT \* FROM "org_subs" WHERE .*\(org_id = \$1\)`).
+					WithArgs(1).
+					WillReturnRows(tc.subs)
+			}
+
+			d := Dispatcher{
+				Cfg: config.NewEnvConfig(logutil.NewStderrLog("test")),
+				DB:  db,
+			}
+			limits, err := d.GetLimits("github.com/golangci")
+			require.NoError(t, err)
+			assert.Equal(t, tc.limits, *limits)
+		})
+	}
+}
+
+func TestGetLimitsOfInvalidOrg(t *testing.T) {
+	d := Dispatcher{
+		Cfg: config.NewEnvConfig(logutil.NewStderrLog("test")),
+	}
+	_, err := d.GetLimits("golangci")
+	assert.Error(t, err)
+}
@@ -0,0 +1,324 @@
+package issues
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golangci/golangci-api/pkg/api/models"
+	lintersResult "github.com/golangci/golangci-api/pkg/worker/analyze/linters/result"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 500
+)
+
+// lintIssue is an issue from golangci-lint JSON output: severity is set only by newer versions of golangci-lint
+type lintIssue struct {
+	FromLinter string
+	Text       string
+	Severity   string
+	Pos        struct {
+		Filename string
+		Line     int
+		Column   int
+	}
+	LineRange *struct {
+		From, To int
+	}
+	Replacement json.RawMessage
+}
+
+type resultJSON struct {
+	GolangciLintRes struct {
+		Issues []lintIssue
+	}
+}
+
+// Parse extracts issues from the analysis result JSON
+func Parse(data []byte) ([]models.Issue, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var res resultJSON
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal result json")
+	}
+
+	var ret []models.Issue
+	for _, i := range res.GolangciLintRes.Issues {
+		issue := models.Issue{
+			FromLinter:   i.FromLinter,
+			File:         i.Pos.Filename,
+			LineFrom:     i.Pos.Line,
+			LineTo:       i.Pos.Line,
+			ColumnNumber: i.Pos.Column,
+			Text:         i.Text,
+			Fingerprint:  lintersResult.Fingerprint(i.FromLinter, i.Pos.Filename, i.Text),
+			Severity:     i.Severity,
+		}
+		if i.LineRange != nil && i.LineRange.From != 0 {
+			issue.LineFrom, issue.LineTo = i.LineRange.From, i.LineRange.To
+		}
+		if len(i.Replacement) != 0 && string(i.Replacement) != "null" {
+			issue.Replacement = i.Replacement
+		}
+		ret = append(ret, issue)
+	}
+
+	return ret, nil
+}
+
+type Diff struct {
+	New, Fixed, Unchanged int
+}
+
+// Classify compares issues by fingerprints, so moved issues are unchanged.
+// Issues with the same fingerprint are matched one by one: a duplicated issue is new.
+// It sets IsNew for new issues of cur.
+func Classify(prev, cur []models.Issue) Diff {
+	prevCounts := map[string]int{}
+	for _, i := range prev {
+		prevCounts[i.Fingerprint]++
+	}
+
+	var d Diff
+	for i := range cur {
+		fp := cur[i].Fingerprint
+		if prevCounts[fp] != 0 {
+			prevCounts[fp]--
+			cur[i].IsNew = false
+			d.Unchanged++
+			continue
+		}
+
+		cur[i].IsNew = true
+		d.New++
+	}
+
+	for _, n := range prevCounts {
+		d.Fixed += n
+	}
+
+	return d
+}
+
+// Replace replaces issues of the analysis by the given parsed issues.
+// db should be the transaction updating the analysis.
+func Replace(db *gorm.DB, analysisType models.IssueAnalysisType, analysisID uint, issues []models.Issue) error {
+	if err := models.NewIssueQuerySet(db).AnalysisTypeEq(analysisType).AnalysisIDEq(analysisID).Delete(); err != nil {
+		return errors.Wrapf(err, "failed to delete issues of %s analysis %d", analysisType, analysisID)
+	}
+
+	now := time.Now()
+	for i := range issues {
+		issues[i].CreatedAt = now
+		issues[i].AnalysisType = analysisType
+		issues[i].AnalysisID = analysisID
+	}
+
+	for start := 0; start < len(issues); start += insertBatchSize {
+		end := start + insertBatchSize
+		if end > len(issues) {
+			end = len(issues)
+		}
+
+		if err := insert(db, issues[start:end]); err != nil {
+			return errors.Wrapf(err, "failed to create issues of %s analysis %d", analysisType, analysisID)
+		}
+	}
+
+	return nil
+}
+
+var insertColumns = []string{"created_at", "analysis_type", "analysis_id", "from_linter", "file", "line_from", "line_to",
+	"column_number", "text", "fingerprint", "severity", "replacement", "is_new"}
+
+// insertBatchSize keeps the count of query parameters below the postgres limit of 65535
+const insertBatchSize = 1000
+
+// insert creates issues by one query: analyzes can have thousands of issues
+func insert(db *gorm.DB, issues []models.Issue) error {
+	rowPlaceholder := "(?" + strings.Repeat(", ?", len(insertColumns)-1) + ")"
+	rows := make([]string, 0, len(issues))
+	args := make([]interface{}, 0, len(issues)*len(insertColumns))
+	for _, i := range issues {
+		var replacement interface{}
+		if len(i.Replacement) != 0 {
+			replacement = string(i.Replacement)
+		}
+
+		rows = append(rows, rowPlaceholder)
+		args = append(args, i.CreatedAt, i.AnalysisType, i.AnalysisID, i.FromLinter, i.File, i.LineFrom, i.LineTo,
+			i.ColumnNumber, i.Text, i.Fingerprint, i.Severity, replacement, i.IsNew)
+	}
+
+	query := fmt.Sprintf("INSERT INTO issues (%s) VALUES %s", strings.Join(insertColumns, ", "), strings.Join(rows, ", "))
+	return db.Exec(query, args...).Error
+}
+
+const suppressedFingerprintsQuery = "SELECT fingerprint FROM issue_suppressions WHERE repo_id = ? AND deleted_at IS NULL"
+
+// SuppressedFingerprints returns fingerprints of issues suppressed in the repo
+func SuppressedFingerprints(db *gorm.DB, repoID uint) ([]string, error) {
+	var suppressions []models.IssueSuppression
+	if err := models.NewIssueSuppressionQuerySet(db).RepoIDEq(repoID).All(&suppressions); err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch issue suppressions of repo %d", repoID)
+	}
+
+	var ret []string
+	for _, s := range suppressions {
+		ret = append(ret, s.Fingerprint)
+	}
+	return ret, nil
+}
+
+// SuppressedSet returns fingerprints of issues suppressed in the repo as a set
+func SuppressedSet(db *gorm.DB, repoID uint) (map[string]bool, error) {
+	fingerprints, err := SuppressedFingerprints(db, repoID)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := map[string]bool{}
+	for _, fp := range fingerprints {
+		ret[fp] = true
+	}
+	return ret, nil
+}
+
+// CountSuppressed returns count of issues of the analysis suppressed in the repo
+func CountSuppressed(db *gorm.DB, analysisType models.IssueAnalysisType, analysisID, repoID uint) (int, error) {
+	count, err := models.NewIssueQuerySet(db.Where("fingerprint IN ("+suppressedFingerprintsQuery+")", repoID)).
+		AnalysisTypeEq(analysisType).
+		AnalysisIDEq(analysisID).
+		Count()
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to count suppressed issues of %s analysis %d", analysisType, analysisID)
+	}
+
+	return count, nil
+}
+
+// suppressedRepoIssueCond is true for issues of repo analyzes suppressed in their repo,
+// repo_analysis_statuses must be joined
+const suppressedRepoIssueCond = "EXISTS (SELECT 1 FROM issue_suppressions WHERE " +
+	"issue_suppressions.repo_id = repo_analysis_statuses.repo_id AND " +
+	"issue_suppressions.fingerprint = issues.fingerprint AND issue_suppressions.deleted_at IS NULL)"
+
+// CountByLinterOfRepoAnalyzes returns counts of not suppressed issues by linters by repo analysis ids
+func CountByLinterOfRepoAnalyzes(db *gorm.DB, analysisIDs []uint) (map[uint]map[string]int, error) {
+	ret := map[uint]map[string]int{}
+	if len(analysisIDs) == 0 {
+		return ret, nil
+	}
+
+	var counts []struct {
+		AnalysisID uint
+		FromLinter string
+		Count      int
+	}
+	err := db.Model(&models.Issue{}).
+		Select("issues.analysis_id, issues.from_linter, COUNT(*) AS count").
+		Joins("JOIN repo_analyzes ON repo_analyzes.id = issues.analysis_id").
+		Joins("JOIN repo_analysis_statuses ON repo_analysis_statuses.id = repo_analyzes.repo_analysis_status_id").
+		Where("issues.analysis_type = ? AND issues.analysis_id IN (?)", models.IssueAnalysisTypeRepo, analysisIDs).
+		Where("NOT " + suppressedRepoIssueCond).
+		Group("issues.analysis_id, issues.from_linter").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to count issues of repo analyzes %v", analysisIDs)
+	}
+
+	for _, c := range counts {
+		if ret[c.AnalysisID] == nil {
+			ret[c.AnalysisID] = map[string]int{}
+		}
+		ret[c.AnalysisID][c.FromLinter] = c.Count
+	}
+	return ret, nil
+}
+
+type Filter struct {
+	FromLinter string
+	File       string
+	Severity   string
+
+	IncludeSuppressed bool
+
+	After uint // id of the last issue of the previous page
+	Limit int
+}
+
+type Page struct {
+	Issues    []models.Issue `json:"issues"`
+	NextAfter uint           `json:"nextAfter,omitempty"` // 0 if it's the last page
+}
+
+// List returns issues of the analysis of the repo ordered as golangci-lint reported them
+func List(db *gorm.DB, analysisType models.IssueAnalysisType, analysisID, repoID uint, f *Filter) (*Page, error) {
+	limit := f.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	issuesDB := db
+	if !f.IncludeSuppressed {
+		issuesDB = db.Where("fingerprint NOT IN ("+suppressedFingerprintsQuery+")", repoID)
+	}
+
+	qs := models.NewIssueQuerySet(issuesDB).AnalysisTypeEq(analysisType).AnalysisIDEq(analysisID)
+	if f.FromLinter != "" {
+		qs = qs.FromLinterEq(f.FromLinter)
+	}
+	if f.File != "" {
+		qs = qs.FileEq(f.File)
+	}
+	if f.Severity != "" {
+		qs = qs.SeverityEq(f.Severity)
+	}
+	if f.After != 0 {
+		qs = qs.IDGt(f.After)
+	}
+
+	var issues []models.Issue
+	if err := qs.OrderAscByID().Limit(limit + 1).All(&issues); err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch issues of %s analysis %d", analysisType, analysisID)
+	}
+
+	page := Page{
+		Issues: issues,
+	}
+	if len(issues) > limit {
+		page.Issues = issues[:limit]
+		page.NextAfter = page.Issues[limit-1].ID
+	}
+
+	if f.IncludeSuppressed {
+		if err := markSuppressed(db, repoID, page.Issues); err != nil {
+			return nil, err
+		}
+	}
+
+	return &page, nil
+}
+
+func markSuppressed(db *gorm.DB, repoID uint, issues []models.Issue) error {
+	suppressed, err := SuppressedSet(db, repoID)
+	if err != nil {
+		return err
+	}
+
+	for i := range issues {
+		issues[i].IsSuppressed = suppressed[issues[i].Fingerprint]
+	}
+	return nil
+}
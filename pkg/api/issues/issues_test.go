@@ -0,0 +1,118 @@
+package issues
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb/gormdbtest"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	lintersResult "github.com/golangci/golangci-api/pkg/worker/analyze/linters/result"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	data := []byte(`{"Version":1,"GolangciLintRes":{"Issues":[
+		{"FromLinter":"govet","Text":"unreachable code","Pos":{"Filename":"main.go","Line":10,"Column":2}},
+		{"FromLinter":"gofmt","Text":"File is not gofmt-ed","Pos":{"Filename":"a/b.go","Line":3},
+		 "LineRange":{"From":3,"To":5},"Replacement":{"NewLines":["x"]}}
+	]}}`)
+
+	issues, err := Parse(data)
+	require.NoError(t, err)
+	require.Len(t, issues, 2)
+
+	assert.Equal(t, "govet", issues[0].FromLinter)
+	assert.Equal(t, 10, issues[0].LineFrom)
+	assert.Equal(t, 10, issues[0].LineTo)
+	assert.Equal(t, 2, issues[0].ColumnNumber)
+	assert.Nil(t, issues[0].Replacement)
+
+	assert.Equal(t, 3, issues[1].LineFrom)
+	assert.Equal(t, 5, issues[1].LineTo)
+	assert.JSONEq(t, `{"NewLines":["x"]}`, string(issues[1].Replacement))
+	assert.Equal(t, lintersResult.Fingerprint("gofmt", "a/b.go", "File is not gofmt-ed"), issues[1].Fingerprint)
+
+	// golangci-lint sets zero line range for single line issues
+	issues, err = Parse([]byte(`{"GolangciLintRes":{"Issues":[
+		{"FromLinter":"errcheck","Text":"unchecked","Pos":{"Filename":"main.go","Line":7},"LineRange":{"From":0,"To":0}}
+	]}}`))
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, 7, issues[0].LineFrom)
+	assert.Equal(t, 7, issues[0].LineTo)
+
+	issues, err = Parse([]byte("{}"))
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+}
+
+func TestClassify(t *testing.T) {
+	prev := []models.Issue{{Fingerprint: "a"}, {Fingerprint: "b"}, {Fingerprint: "b"}, {Fingerprint: "c"}}
+	cur := []models.Issue{{Fingerprint: "a"}, {Fingerprint: "b"}, {Fingerprint: "d"}, {Fingerprint: "a"}}
+
+	d := Classify(prev, cur)
+	assert.Equal(t, Diff{New: 2, Fixed: 2, Unchanged: 2}, d)
+
+	var isNew []bool
+	for _, i := range cur {
+		isNew = append(isNew, i.IsNew)
+	}
+	assert.Equal(t, []bool{false, false, true, true}, isNew)
+}
+
+func TestReplace(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	var issues []models.Issue
+	for i := 0; i < insertBatchSize+1; i++ {
+		issues = append(issues, models.Issue{FromLinter: "govet", File: "main.go", LineFrom: i, LineTo: i, Fingerprint: fmt.Sprint(i)})
+	}
+	issues[0].Replacement = json.RawMessage(`{"NewLines":["x"]}`)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "issues" WHERE \(analysis_type = \$1\) AND \(analysis_id = \$2\)`).
+		WithArgs(models.IssueAnalysisTypeRepo, 5).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	// issues are inserted by batches, one query per batch
+	firstArgs := []driver.Value{sqlmock.AnyArg(), models.IssueAnalysisTypeRepo, 5, "govet", "main.go", 0, 0, 0, "", "0", "",
+		`{"NewLines":["x"]}`, false}
+	for i := 1; i < insertBatchSize; i++ {
+		firstArgs = append(firstArgs, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			sqlmock.AnyArg(), sqlmock.AnyArg())
+	}
+	mock.ExpectExec(`INSERT INTO issues \(created_at, .*, is_new\) VALUES \(\$1, .*\), \(\$14, `).
+		WithArgs(firstArgs...).
+		WillReturnResult(sqlmock.NewResult(0, insertBatchSize))
+	mock.ExpectExec(`INSERT INTO issues \(.*\) VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7, \$8, \$9, \$10, \$11, \$12, \$13\)$`).
+		WithArgs(sqlmock.AnyArg(), models.IssueAnalysisTypeRepo, 5, "govet", "main.go", insertBatchSize, insertBatchSize, 0, "",
+			fmt.Sprint(insertBatchSize), "", nil, false).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	tx := db.Begin()
+	require.NoError(t, Replace(tx, models.IssueAnalysisTypeRepo, 5, issues))
+	require.NoError(t, tx.Commit().Error)
+
+	assert.Equal(t, uint(5), issues[0].AnalysisID)
+	assert.False(t, issues[0].CreatedAt.IsZero())
+}
+
+func TestReplaceWithoutIssues(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "issues"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	tx := db.Begin()
+	require.NoError(t, Replace(tx, models.IssueAnalysisTypePullRequest, 5, nil))
+	require.NoError(t, tx.Commit().Error)
+}
@@ -0,0 +1,41 @@
+package issues
+
+import (
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/pkg/api/request"
+)
+
+// ListRequest is the request to list issues of a repo or pull request analysis
+type ListRequest struct {
+	request.Repo
+	AnalysisGUID string `request:",urlPart,"`
+
+	Linter   string `request:"linter,urlParam,optional"`
+	File     string `request:"file,urlParam,optional"`
+	Severity string `request:"severity,urlParam,optional"`
+
+	// suppressed issues are excluded by default
+	Suppressed bool `request:"suppressed,urlParam,optional"`
+
+	After uint `request:"after,urlParam,optional"` // id of the last issue of the previous page
+	Limit int  `request:"limit,urlParam,optional"`
+}
+
+func (r ListRequest) FillLogContext(lctx logutil.Context) {
+	r.Repo.FillLogContext(lctx)
+	lctx["analysis_guid"] = r.AnalysisGUID
+	lctx["after"] = r.After
+}
+
+func (r ListRequest) Filter() *Filter {
+	return &Filter{
+		FromLinter: r.Linter,
+		File:       r.File,
+		Severity:   r.Severity,
+
+		IncludeSuppressed: r.Suppressed,
+
+		After: r.After,
+		Limit: r.Limit,
+	}
+}
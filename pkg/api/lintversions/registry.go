@@ -0,0 +1,65 @@
+package lintversions
+
+import (
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/goenvbuild/config"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// Load loads golangci-lint versions registry from db
+func Load(db *gorm.DB) (*config.GolangciLintRegistry, error) {
+	var versions []models.GolangciLintVersion
+	if err := models.NewGolangciLintVersionQuerySet(db).OrderAscByID().All(&versions); err != nil {
+		return nil, errors.Wrap(err, "failed to fetch golangci-lint versions")
+	}
+
+	if len(versions) == 0 {
+		return nil, errors.New("no golangci-lint versions in db")
+	}
+
+	var r config.GolangciLintRegistry
+	for _, v := range versions {
+		r.Releases = append(r.Releases, config.GolangciLintRelease{
+			Version:  v.Version,
+			Checksum: v.Checksum,
+		})
+		if v.IsDefault {
+			r.DefaultVersion = v.Version
+		}
+	}
+
+	if r.DefaultVersion == "" {
+		return nil, errors.New("no default golangci-lint version in db")
+	}
+
+	return &r, nil
+}
+
+// LoadForOrg loads registry with the default version depending on the org channel:
+// orgs in the auto channel use the latest release by default.
+func LoadForOrg(db *gorm.DB, provider, orgName string) (*config.GolangciLintRegistry, error) {
+	r, err := Load(db)
+	if err != nil {
+		return nil, err
+	}
+
+	var orgs []models.Org
+	if err = models.NewOrgQuerySet(db).ProviderEq(provider).NameEq(orgName).Limit(1).All(&orgs); err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch org %s/%s", provider, orgName)
+	}
+	if len(orgs) == 0 {
+		return r, nil
+	}
+
+	settings, err := orgs[0].UnmarshalSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	if settings.GolangciLintChannel == models.OrgGolangciLintChannelAuto {
+		r.DefaultVersion = r.Latest().Version
+	}
+
+	return r, nil
+}
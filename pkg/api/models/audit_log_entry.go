@@ -0,0 +1,98 @@
+package models
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate goqueryset -in audit_log_entry.go
+
+type AuditLogAction string
+
+const (
+	AuditLogActionOrgUpdate          AuditLogAction = "org/update"
+	AuditLogActionSubscriptionUpdate AuditLogAction = "subscription/update"
+	AuditLogActionRepoCreate         AuditLogAction = "repo/create"
+	AuditLogActionRepoDelete         AuditLogAction = "repo/delete"
+	AuditLogActionRepoOwnerTransfer  AuditLogAction = "repo/owner_transfer"
+)
+
+// AuditLogEntry records who and how changed an organization, its subscription or repos.
+// Entries are append-only: no UpdatedAt and DeletedAt, updates are forbidden by db trigger.
+// gen:qs
+type AuditLogEntry struct {
+	ID        uint      `gorm:"primary_key" json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// org isn't referenced by id: repos of organizations without subscription have no org in db
+	Provider string `json:"-"`
+	OrgName  string `json:"-"` // lower-cased
+
+	ActorUserID uint           `json:"actorUserId"` // 0 for changes made by the system
+	Action      AuditLogAction `json:"action"`
+	TargetID    uint           `json:"targetId"` // id of org, sub or repo depending on the action
+
+	// only changed fields of the target
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+
+	RequestID string `json:"requestId"`
+}
+
+// SetDiff saves fields of before and after which differ: both must be marshaled to JSON objects or be nil
+func (e *AuditLogEntry) SetDiff(before, after interface{}) error {
+	beforeFields, err := toJSONFields(before)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert before state")
+	}
+
+	afterFields, err := toJSONFields(after)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert after state")
+	}
+
+	for k, v := range beforeFields {
+		if afterV, ok := afterFields[k]; ok && reflect.DeepEqual(v, afterV) {
+			delete(beforeFields, k)
+			delete(afterFields, k)
+		}
+	}
+
+	if e.Before, err = marshalJSONFields(beforeFields); err != nil {
+		return errors.Wrap(err, "failed to marshal before state")
+	}
+	if e.After, err = marshalJSONFields(afterFields); err != nil {
+		return errors.Wrap(err, "failed to marshal after state")
+	}
+
+	return nil
+}
+
+func toJSONFields(v interface{}) (map[string]interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]interface{}
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+func marshalJSONFields(fields map[string]interface{}) (json.RawMessage, error) {
+	if fields == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(fields)
+}
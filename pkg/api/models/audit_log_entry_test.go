@@ -0,0 +1,94 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLogEntrySetDiff(t *testing.T) {
+	type state struct {
+		Name  string   `json:"name"`
+		Seats []string `json:"seats,omitempty"`
+		Count int      `json:"count"`
+	}
+
+	cases := []struct {
+		name          string
+		before, after interface{}
+		expBefore     string
+		expAfter      string
+	}{
+		{
+			name:      "changed fields only",
+			before:    state{Name: "a", Seats: []string{"x"}, Count: 1},
+			after:     state{Name: "a", Seats: []string{"x", "y"}, Count: 2},
+			expBefore: `{"seats":["x"],"count":1}`,
+			expAfter:  `{"seats":["x","y"],"count":2}`,
+		},
+		{
+			name:      "added and removed fields",
+			before:    state{Name: "a", Seats: []string{"x"}},
+			after:     state{Name: "a"},
+			expBefore: `{"seats":["x"]}`,
+			expAfter:  `{}`,
+		},
+		{
+			name:      "no changes",
+			before:    map[string]int{"userId": 1},
+			after:     map[string]int{"userId": 1},
+			expBefore: `{}`,
+			expAfter:  `{}`,
+		},
+		{
+			name:     "created",
+			after:    map[string]int{"userId": 1},
+			expAfter: `{"userId":1}`,
+		},
+		{
+			name:      "deleted",
+			before:    map[string]int{"userId": 1},
+			expBefore: `{"userId":1}`,
+		},
+		{
+			name:      "raw json",
+			before:    json.RawMessage(`{"scoreProfile":"strict","seats":[]}`),
+			after:     json.RawMessage(`{"seats":[]}`),
+			expBefore: `{"scoreProfile":"strict"}`,
+			expAfter:  `{}`,
+		},
+		{
+			name:     "null raw json",
+			before:   json.RawMessage(nil),
+			after:    json.RawMessage(`{"seats":[]}`),
+			expAfter: `{"seats":[]}`,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var e AuditLogEntry
+			require.NoError(t, e.SetDiff(tc.before, tc.after))
+
+			assertJSON(t, tc.expBefore, e.Before)
+			assertJSON(t, tc.expAfter, e.After)
+		})
+	}
+}
+
+func TestAuditLogEntrySetDiffOfNotObject(t *testing.T) {
+	var e AuditLogEntry
+	assert.Error(t, e.SetDiff([]int{1}, []int{2}))
+}
+
+func assertJSON(t *testing.T, exp string, got json.RawMessage) {
+	if exp == "" {
+		assert.Nil(t, got)
+		return
+	}
+
+	assert.JSONEq(t, exp, string(got))
+}
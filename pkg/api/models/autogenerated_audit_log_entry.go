@@ -0,0 +1,718 @@
+// Code generated by go-queryset. DO NOT EDIT.
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// ===== BEGIN of all query sets
+
+// ===== BEGIN of query set AuditLogEntryQuerySet
+
+// AuditLogEntryQuerySet is an queryset type for AuditLogEntry
+type AuditLogEntryQuerySet struct {
+	db *gorm.DB
+}
+
+// NewAuditLogEntryQuerySet constructs new AuditLogEntryQuerySet
+func NewAuditLogEntryQuerySet(db *gorm.DB) AuditLogEntryQuerySet {
+	return AuditLogEntryQuerySet{
+		db: db.Model(&AuditLogEntry{}),
+	}
+}
+
+func (qs AuditLogEntryQuerySet) w(db *gorm.DB) AuditLogEntryQuerySet {
+	return NewAuditLogEntryQuerySet(db)
+}
+
+// ActionEq is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) ActionEq(action AuditLogAction) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("action = ?", action))
+}
+
+// ActionIn is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) ActionIn(action ...AuditLogAction) AuditLogEntryQuerySet {
+	if len(action) == 0 {
+		qs.db.AddError(errors.New("must at least pass one action in ActionIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("action IN (?)", action))
+}
+
+// ActionNe is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) ActionNe(action AuditLogAction) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("action != ?", action))
+}
+
+// ActionNotIn is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) ActionNotIn(action ...AuditLogAction) AuditLogEntryQuerySet {
+	if len(action) == 0 {
+		qs.db.AddError(errors.New("must at least pass one action in ActionNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("action NOT IN (?)", action))
+}
+
+// ActorUserIDEq is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) ActorUserIDEq(actorUserID uint) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("actor_user_id = ?", actorUserID))
+}
+
+// ActorUserIDGt is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) ActorUserIDGt(actorUserID uint) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("actor_user_id > ?", actorUserID))
+}
+
+// ActorUserIDGte is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) ActorUserIDGte(actorUserID uint) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("actor_user_id >= ?", actorUserID))
+}
+
+// ActorUserIDIn is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) ActorUserIDIn(actorUserID ...uint) AuditLogEntryQuerySet {
+	if len(actorUserID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one actorUserID in ActorUserIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("actor_user_id IN (?)", actorUserID))
+}
+
+// ActorUserIDLt is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) ActorUserIDLt(actorUserID uint) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("actor_user_id < ?", actorUserID))
+}
+
+// ActorUserIDLte is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) ActorUserIDLte(actorUserID uint) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("actor_user_id <= ?", actorUserID))
+}
+
+// ActorUserIDNe is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) ActorUserIDNe(actorUserID uint) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("actor_user_id != ?", actorUserID))
+}
+
+// ActorUserIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) ActorUserIDNotIn(actorUserID ...uint) AuditLogEntryQuerySet {
+	if len(actorUserID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one actorUserID in ActorUserIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("actor_user_id NOT IN (?)", actorUserID))
+}
+
+// AfterEq is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) AfterEq(after json.RawMessage) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("after = ?", after))
+}
+
+// AfterIn is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) AfterIn(after ...json.RawMessage) AuditLogEntryQuerySet {
+	if len(after) == 0 {
+		qs.db.AddError(errors.New("must at least pass one after in AfterIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("after IN (?)", after))
+}
+
+// AfterNe is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) AfterNe(after json.RawMessage) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("after != ?", after))
+}
+
+// AfterNotIn is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) AfterNotIn(after ...json.RawMessage) AuditLogEntryQuerySet {
+	if len(after) == 0 {
+		qs.db.AddError(errors.New("must at least pass one after in AfterNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("after NOT IN (?)", after))
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) All(ret *[]AuditLogEntry) error {
+	return qs.db.Find(ret).Error
+}
+
+// BeforeEq is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) BeforeEq(before json.RawMessage) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("before = ?", before))
+}
+
+// BeforeIn is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) BeforeIn(before ...json.RawMessage) AuditLogEntryQuerySet {
+	if len(before) == 0 {
+		qs.db.AddError(errors.New("must at least pass one before in BeforeIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("before IN (?)", before))
+}
+
+// BeforeNe is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) BeforeNe(before json.RawMessage) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("before != ?", before))
+}
+
+// BeforeNotIn is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) BeforeNotIn(before ...json.RawMessage) AuditLogEntryQuerySet {
+	if len(before) == 0 {
+		qs.db.AddError(errors.New("must at least pass one before in BeforeNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("before NOT IN (?)", before))
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) Count() (int, error) {
+	var count int
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *AuditLogEntry) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) CreatedAtEq(createdAt time.Time) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("created_at = ?", createdAt))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) CreatedAtGt(createdAt time.Time) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("created_at > ?", createdAt))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) CreatedAtGte(createdAt time.Time) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", createdAt))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) CreatedAtLt(createdAt time.Time) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("created_at < ?", createdAt))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) CreatedAtLte(createdAt time.Time) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("created_at <= ?", createdAt))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) CreatedAtNe(createdAt time.Time) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("created_at != ?", createdAt))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *AuditLogEntry) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) Delete() error {
+	return qs.db.Delete(AuditLogEntry{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(AuditLogEntry{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(AuditLogEntry{})
+	return db.RowsAffected, db.Error
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) GetUpdater() AuditLogEntryUpdater {
+	return NewAuditLogEntryUpdater(qs.db)
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) IDEq(ID uint) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("id = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) IDGt(ID uint) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("id > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) IDGte(ID uint) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("id >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) IDIn(ID ...uint) AuditLogEntryQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id IN (?)", ID))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) IDLt(ID uint) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("id < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) IDLte(ID uint) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("id <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) IDNe(ID uint) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("id != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) IDNotIn(ID ...uint) AuditLogEntryQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) Limit(limit int) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) Offset(offset int) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs AuditLogEntryQuerySet) One(ret *AuditLogEntry) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByActorUserID is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) OrderAscByActorUserID() AuditLogEntryQuerySet {
+	return qs.w(qs.db.Order("actor_user_id ASC"))
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) OrderAscByCreatedAt() AuditLogEntryQuerySet {
+	return qs.w(qs.db.Order("created_at ASC"))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) OrderAscByID() AuditLogEntryQuerySet {
+	return qs.w(qs.db.Order("id ASC"))
+}
+
+// OrderAscByTargetID is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) OrderAscByTargetID() AuditLogEntryQuerySet {
+	return qs.w(qs.db.Order("target_id ASC"))
+}
+
+// OrderDescByActorUserID is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) OrderDescByActorUserID() AuditLogEntryQuerySet {
+	return qs.w(qs.db.Order("actor_user_id DESC"))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) OrderDescByCreatedAt() AuditLogEntryQuerySet {
+	return qs.w(qs.db.Order("created_at DESC"))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) OrderDescByID() AuditLogEntryQuerySet {
+	return qs.w(qs.db.Order("id DESC"))
+}
+
+// OrderDescByTargetID is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) OrderDescByTargetID() AuditLogEntryQuerySet {
+	return qs.w(qs.db.Order("target_id DESC"))
+}
+
+// OrgNameEq is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) OrgNameEq(orgName string) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("org_name = ?", orgName))
+}
+
+// OrgNameIn is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) OrgNameIn(orgName ...string) AuditLogEntryQuerySet {
+	if len(orgName) == 0 {
+		qs.db.AddError(errors.New("must at least pass one orgName in OrgNameIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("org_name IN (?)", orgName))
+}
+
+// OrgNameNe is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) OrgNameNe(orgName string) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("org_name != ?", orgName))
+}
+
+// OrgNameNotIn is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) OrgNameNotIn(orgName ...string) AuditLogEntryQuerySet {
+	if len(orgName) == 0 {
+		qs.db.AddError(errors.New("must at least pass one orgName in OrgNameNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("org_name NOT IN (?)", orgName))
+}
+
+// ProviderEq is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) ProviderEq(provider string) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("provider = ?", provider))
+}
+
+// ProviderIn is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) ProviderIn(provider ...string) AuditLogEntryQuerySet {
+	if len(provider) == 0 {
+		qs.db.AddError(errors.New("must at least pass one provider in ProviderIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("provider IN (?)", provider))
+}
+
+// ProviderNe is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) ProviderNe(provider string) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("provider != ?", provider))
+}
+
+// ProviderNotIn is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) ProviderNotIn(provider ...string) AuditLogEntryQuerySet {
+	if len(provider) == 0 {
+		qs.db.AddError(errors.New("must at least pass one provider in ProviderNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("provider NOT IN (?)", provider))
+}
+
+// RequestIDEq is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) RequestIDEq(requestID string) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("request_id = ?", requestID))
+}
+
+// RequestIDIn is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) RequestIDIn(requestID ...string) AuditLogEntryQuerySet {
+	if len(requestID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one requestID in RequestIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("request_id IN (?)", requestID))
+}
+
+// RequestIDNe is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) RequestIDNe(requestID string) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("request_id != ?", requestID))
+}
+
+// RequestIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) RequestIDNotIn(requestID ...string) AuditLogEntryQuerySet {
+	if len(requestID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one requestID in RequestIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("request_id NOT IN (?)", requestID))
+}
+
+// SetAction is an autogenerated method
+// nolint: dupl
+func (u AuditLogEntryUpdater) SetAction(action AuditLogAction) AuditLogEntryUpdater {
+	u.fields[string(AuditLogEntryDBSchema.Action)] = action
+	return u
+}
+
+// SetActorUserID is an autogenerated method
+// nolint: dupl
+func (u AuditLogEntryUpdater) SetActorUserID(actorUserID uint) AuditLogEntryUpdater {
+	u.fields[string(AuditLogEntryDBSchema.ActorUserID)] = actorUserID
+	return u
+}
+
+// SetAfter is an autogenerated method
+// nolint: dupl
+func (u AuditLogEntryUpdater) SetAfter(after json.RawMessage) AuditLogEntryUpdater {
+	u.fields[string(AuditLogEntryDBSchema.After)] = after
+	return u
+}
+
+// SetBefore is an autogenerated method
+// nolint: dupl
+func (u AuditLogEntryUpdater) SetBefore(before json.RawMessage) AuditLogEntryUpdater {
+	u.fields[string(AuditLogEntryDBSchema.Before)] = before
+	return u
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u AuditLogEntryUpdater) SetCreatedAt(createdAt time.Time) AuditLogEntryUpdater {
+	u.fields[string(AuditLogEntryDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u AuditLogEntryUpdater) SetID(ID uint) AuditLogEntryUpdater {
+	u.fields[string(AuditLogEntryDBSchema.ID)] = ID
+	return u
+}
+
+// SetOrgName is an autogenerated method
+// nolint: dupl
+func (u AuditLogEntryUpdater) SetOrgName(orgName string) AuditLogEntryUpdater {
+	u.fields[string(AuditLogEntryDBSchema.OrgName)] = orgName
+	return u
+}
+
+// SetProvider is an autogenerated method
+// nolint: dupl
+func (u AuditLogEntryUpdater) SetProvider(provider string) AuditLogEntryUpdater {
+	u.fields[string(AuditLogEntryDBSchema.Provider)] = provider
+	return u
+}
+
+// SetRequestID is an autogenerated method
+// nolint: dupl
+func (u AuditLogEntryUpdater) SetRequestID(requestID string) AuditLogEntryUpdater {
+	u.fields[string(AuditLogEntryDBSchema.RequestID)] = requestID
+	return u
+}
+
+// SetTargetID is an autogenerated method
+// nolint: dupl
+func (u AuditLogEntryUpdater) SetTargetID(targetID uint) AuditLogEntryUpdater {
+	u.fields[string(AuditLogEntryDBSchema.TargetID)] = targetID
+	return u
+}
+
+// TargetIDEq is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) TargetIDEq(targetID uint) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("target_id = ?", targetID))
+}
+
+// TargetIDGt is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) TargetIDGt(targetID uint) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("target_id > ?", targetID))
+}
+
+// TargetIDGte is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) TargetIDGte(targetID uint) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("target_id >= ?", targetID))
+}
+
+// TargetIDIn is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) TargetIDIn(targetID ...uint) AuditLogEntryQuerySet {
+	if len(targetID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one targetID in TargetIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("target_id IN (?)", targetID))
+}
+
+// TargetIDLt is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) TargetIDLt(targetID uint) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("target_id < ?", targetID))
+}
+
+// TargetIDLte is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) TargetIDLte(targetID uint) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("target_id <= ?", targetID))
+}
+
+// TargetIDNe is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) TargetIDNe(targetID uint) AuditLogEntryQuerySet {
+	return qs.w(qs.db.Where("target_id != ?", targetID))
+}
+
+// TargetIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs AuditLogEntryQuerySet) TargetIDNotIn(targetID ...uint) AuditLogEntryQuerySet {
+	if len(targetID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one targetID in TargetIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("target_id NOT IN (?)", targetID))
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u AuditLogEntryUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u AuditLogEntryUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// ===== END of query set AuditLogEntryQuerySet
+
+// ===== BEGIN of AuditLogEntry modifiers
+
+// AuditLogEntryDBSchemaField describes database schema field. It requires for method 'Update'
+type AuditLogEntryDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f AuditLogEntryDBSchemaField) String() string {
+	return string(f)
+}
+
+// AuditLogEntryDBSchema stores db field names of AuditLogEntry
+var AuditLogEntryDBSchema = struct {
+	ID          AuditLogEntryDBSchemaField
+	CreatedAt   AuditLogEntryDBSchemaField
+	Provider    AuditLogEntryDBSchemaField
+	OrgName     AuditLogEntryDBSchemaField
+	ActorUserID AuditLogEntryDBSchemaField
+	Action      AuditLogEntryDBSchemaField
+	TargetID    AuditLogEntryDBSchemaField
+	Before      AuditLogEntryDBSchemaField
+	After       AuditLogEntryDBSchemaField
+	RequestID   AuditLogEntryDBSchemaField
+}{
+
+	ID:          AuditLogEntryDBSchemaField("id"),
+	CreatedAt:   AuditLogEntryDBSchemaField("created_at"),
+	Provider:    AuditLogEntryDBSchemaField("provider"),
+	OrgName:     AuditLogEntryDBSchemaField("org_name"),
+	ActorUserID: AuditLogEntryDBSchemaField("actor_user_id"),
+	Action:      AuditLogEntryDBSchemaField("action"),
+	TargetID:    AuditLogEntryDBSchemaField("target_id"),
+	Before:      AuditLogEntryDBSchemaField("before"),
+	After:       AuditLogEntryDBSchemaField("after"),
+	RequestID:   AuditLogEntryDBSchemaField("request_id"),
+}
+
+// Update updates AuditLogEntry fields by primary key
+// nolint: dupl
+func (o *AuditLogEntry) Update(db *gorm.DB, fields ...AuditLogEntryDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":            o.ID,
+		"created_at":    o.CreatedAt,
+		"provider":      o.Provider,
+		"org_name":      o.OrgName,
+		"actor_user_id": o.ActorUserID,
+		"action":        o.Action,
+		"target_id":     o.TargetID,
+		"before":        o.Before,
+		"after":         o.After,
+		"request_id":    o.RequestID,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update AuditLogEntry %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// AuditLogEntryUpdater is an AuditLogEntry updates manager
+type AuditLogEntryUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewAuditLogEntryUpdater creates new AuditLogEntry updater
+// nolint: dupl
+func NewAuditLogEntryUpdater(db *gorm.DB) AuditLogEntryUpdater {
+	return AuditLogEntryUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&AuditLogEntry{}),
+	}
+}
+
+// ===== END of AuditLogEntry modifiers
+
+// ===== END of all query sets
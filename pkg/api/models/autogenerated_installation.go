@@ -0,0 +1,677 @@
+// Code generated by go-queryset. DO NOT EDIT.
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// ===== BEGIN of all query sets
+
+// ===== BEGIN of query set InstallationQuerySet
+
+// InstallationQuerySet is an queryset type for Installation
+type InstallationQuerySet struct {
+	db *gorm.DB
+}
+
+// NewInstallationQuerySet constructs new InstallationQuerySet
+func NewInstallationQuerySet(db *gorm.DB) InstallationQuerySet {
+	return InstallationQuerySet{
+		db: db.Model(&Installation{}),
+	}
+}
+
+func (qs InstallationQuerySet) w(db *gorm.DB) InstallationQuerySet {
+	return NewInstallationQuerySet(db)
+}
+
+// AccountNameEq is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) AccountNameEq(accountName string) InstallationQuerySet {
+	return qs.w(qs.db.Where("account_name = ?", accountName))
+}
+
+// AccountNameIn is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) AccountNameIn(accountName ...string) InstallationQuerySet {
+	if len(accountName) == 0 {
+		qs.db.AddError(errors.New("must at least pass one accountName in AccountNameIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("account_name IN (?)", accountName))
+}
+
+// AccountNameNe is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) AccountNameNe(accountName string) InstallationQuerySet {
+	return qs.w(qs.db.Where("account_name != ?", accountName))
+}
+
+// AccountNameNotIn is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) AccountNameNotIn(accountName ...string) InstallationQuerySet {
+	if len(accountName) == 0 {
+		qs.db.AddError(errors.New("must at least pass one accountName in AccountNameNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("account_name NOT IN (?)", accountName))
+}
+
+// AccountProviderIDEq is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) AccountProviderIDEq(accountProviderID int) InstallationQuerySet {
+	return qs.w(qs.db.Where("account_provider_id = ?", accountProviderID))
+}
+
+// AccountProviderIDGt is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) AccountProviderIDGt(accountProviderID int) InstallationQuerySet {
+	return qs.w(qs.db.Where("account_provider_id > ?", accountProviderID))
+}
+
+// AccountProviderIDGte is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) AccountProviderIDGte(accountProviderID int) InstallationQuerySet {
+	return qs.w(qs.db.Where("account_provider_id >= ?", accountProviderID))
+}
+
+// AccountProviderIDIn is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) AccountProviderIDIn(accountProviderID ...int) InstallationQuerySet {
+	if len(accountProviderID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one accountProviderID in AccountProviderIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("account_provider_id IN (?)", accountProviderID))
+}
+
+// AccountProviderIDLt is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) AccountProviderIDLt(accountProviderID int) InstallationQuerySet {
+	return qs.w(qs.db.Where("account_provider_id < ?", accountProviderID))
+}
+
+// AccountProviderIDLte is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) AccountProviderIDLte(accountProviderID int) InstallationQuerySet {
+	return qs.w(qs.db.Where("account_provider_id <= ?", accountProviderID))
+}
+
+// AccountProviderIDNe is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) AccountProviderIDNe(accountProviderID int) InstallationQuerySet {
+	return qs.w(qs.db.Where("account_provider_id != ?", accountProviderID))
+}
+
+// AccountProviderIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) AccountProviderIDNotIn(accountProviderID ...int) InstallationQuerySet {
+	if len(accountProviderID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one accountProviderID in AccountProviderIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("account_provider_id NOT IN (?)", accountProviderID))
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) All(ret *[]Installation) error {
+	return qs.db.Find(ret).Error
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) Count() (int, error) {
+	var count int
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *Installation) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) CreatedAtEq(createdAt time.Time) InstallationQuerySet {
+	return qs.w(qs.db.Where("created_at = ?", createdAt))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) CreatedAtGt(createdAt time.Time) InstallationQuerySet {
+	return qs.w(qs.db.Where("created_at > ?", createdAt))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) CreatedAtGte(createdAt time.Time) InstallationQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", createdAt))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) CreatedAtLt(createdAt time.Time) InstallationQuerySet {
+	return qs.w(qs.db.Where("created_at < ?", createdAt))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) CreatedAtLte(createdAt time.Time) InstallationQuerySet {
+	return qs.w(qs.db.Where("created_at <= ?", createdAt))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) CreatedAtNe(createdAt time.Time) InstallationQuerySet {
+	return qs.w(qs.db.Where("created_at != ?", createdAt))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *Installation) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) Delete() error {
+	return qs.db.Delete(Installation{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(Installation{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(Installation{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) DeletedAtEq(deletedAt time.Time) InstallationQuerySet {
+	return qs.w(qs.db.Where("deleted_at = ?", deletedAt))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) DeletedAtGt(deletedAt time.Time) InstallationQuerySet {
+	return qs.w(qs.db.Where("deleted_at > ?", deletedAt))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) DeletedAtGte(deletedAt time.Time) InstallationQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ?", deletedAt))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) DeletedAtIsNotNull() InstallationQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) DeletedAtIsNull() InstallationQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NULL"))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) DeletedAtLt(deletedAt time.Time) InstallationQuerySet {
+	return qs.w(qs.db.Where("deleted_at < ?", deletedAt))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) DeletedAtLte(deletedAt time.Time) InstallationQuerySet {
+	return qs.w(qs.db.Where("deleted_at <= ?", deletedAt))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) DeletedAtNe(deletedAt time.Time) InstallationQuerySet {
+	return qs.w(qs.db.Where("deleted_at != ?", deletedAt))
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) GetUpdater() InstallationUpdater {
+	return NewInstallationUpdater(qs.db)
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) IDEq(ID uint) InstallationQuerySet {
+	return qs.w(qs.db.Where("id = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) IDGt(ID uint) InstallationQuerySet {
+	return qs.w(qs.db.Where("id > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) IDGte(ID uint) InstallationQuerySet {
+	return qs.w(qs.db.Where("id >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) IDIn(ID ...uint) InstallationQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id IN (?)", ID))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) IDLt(ID uint) InstallationQuerySet {
+	return qs.w(qs.db.Where("id < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) IDLte(ID uint) InstallationQuerySet {
+	return qs.w(qs.db.Where("id <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) IDNe(ID uint) InstallationQuerySet {
+	return qs.w(qs.db.Where("id != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) IDNotIn(ID ...uint) InstallationQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) Limit(limit int) InstallationQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) Offset(offset int) InstallationQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs InstallationQuerySet) One(ret *Installation) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByAccountProviderID is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) OrderAscByAccountProviderID() InstallationQuerySet {
+	return qs.w(qs.db.Order("account_provider_id ASC"))
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) OrderAscByCreatedAt() InstallationQuerySet {
+	return qs.w(qs.db.Order("created_at ASC"))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) OrderAscByDeletedAt() InstallationQuerySet {
+	return qs.w(qs.db.Order("deleted_at ASC"))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) OrderAscByID() InstallationQuerySet {
+	return qs.w(qs.db.Order("id ASC"))
+}
+
+// OrderAscByProviderID is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) OrderAscByProviderID() InstallationQuerySet {
+	return qs.w(qs.db.Order("provider_id ASC"))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) OrderAscByUpdatedAt() InstallationQuerySet {
+	return qs.w(qs.db.Order("updated_at ASC"))
+}
+
+// OrderDescByAccountProviderID is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) OrderDescByAccountProviderID() InstallationQuerySet {
+	return qs.w(qs.db.Order("account_provider_id DESC"))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) OrderDescByCreatedAt() InstallationQuerySet {
+	return qs.w(qs.db.Order("created_at DESC"))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) OrderDescByDeletedAt() InstallationQuerySet {
+	return qs.w(qs.db.Order("deleted_at DESC"))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) OrderDescByID() InstallationQuerySet {
+	return qs.w(qs.db.Order("id DESC"))
+}
+
+// OrderDescByProviderID is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) OrderDescByProviderID() InstallationQuerySet {
+	return qs.w(qs.db.Order("provider_id DESC"))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) OrderDescByUpdatedAt() InstallationQuerySet {
+	return qs.w(qs.db.Order("updated_at DESC"))
+}
+
+// ProviderEq is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) ProviderEq(provider string) InstallationQuerySet {
+	return qs.w(qs.db.Where("provider = ?", provider))
+}
+
+// ProviderIDEq is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) ProviderIDEq(providerID int) InstallationQuerySet {
+	return qs.w(qs.db.Where("provider_id = ?", providerID))
+}
+
+// ProviderIDGt is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) ProviderIDGt(providerID int) InstallationQuerySet {
+	return qs.w(qs.db.Where("provider_id > ?", providerID))
+}
+
+// ProviderIDGte is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) ProviderIDGte(providerID int) InstallationQuerySet {
+	return qs.w(qs.db.Where("provider_id >= ?", providerID))
+}
+
+// ProviderIDIn is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) ProviderIDIn(providerID ...int) InstallationQuerySet {
+	if len(providerID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one providerID in ProviderIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("provider_id IN (?)", providerID))
+}
+
+// ProviderIDLt is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) ProviderIDLt(providerID int) InstallationQuerySet {
+	return qs.w(qs.db.Where("provider_id < ?", providerID))
+}
+
+// ProviderIDLte is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) ProviderIDLte(providerID int) InstallationQuerySet {
+	return qs.w(qs.db.Where("provider_id <= ?", providerID))
+}
+
+// ProviderIDNe is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) ProviderIDNe(providerID int) InstallationQuerySet {
+	return qs.w(qs.db.Where("provider_id != ?", providerID))
+}
+
+// ProviderIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) ProviderIDNotIn(providerID ...int) InstallationQuerySet {
+	if len(providerID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one providerID in ProviderIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("provider_id NOT IN (?)", providerID))
+}
+
+// ProviderIn is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) ProviderIn(provider ...string) InstallationQuerySet {
+	if len(provider) == 0 {
+		qs.db.AddError(errors.New("must at least pass one provider in ProviderIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("provider IN (?)", provider))
+}
+
+// ProviderNe is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) ProviderNe(provider string) InstallationQuerySet {
+	return qs.w(qs.db.Where("provider != ?", provider))
+}
+
+// ProviderNotIn is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) ProviderNotIn(provider ...string) InstallationQuerySet {
+	if len(provider) == 0 {
+		qs.db.AddError(errors.New("must at least pass one provider in ProviderNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("provider NOT IN (?)", provider))
+}
+
+// SetAccountName is an autogenerated method
+// nolint: dupl
+func (u InstallationUpdater) SetAccountName(accountName string) InstallationUpdater {
+	u.fields[string(InstallationDBSchema.AccountName)] = accountName
+	return u
+}
+
+// SetAccountProviderID is an autogenerated method
+// nolint: dupl
+func (u InstallationUpdater) SetAccountProviderID(accountProviderID int) InstallationUpdater {
+	u.fields[string(InstallationDBSchema.AccountProviderID)] = accountProviderID
+	return u
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u InstallationUpdater) SetCreatedAt(createdAt time.Time) InstallationUpdater {
+	u.fields[string(InstallationDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u InstallationUpdater) SetDeletedAt(deletedAt *time.Time) InstallationUpdater {
+	u.fields[string(InstallationDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u InstallationUpdater) SetID(ID uint) InstallationUpdater {
+	u.fields[string(InstallationDBSchema.ID)] = ID
+	return u
+}
+
+// SetProvider is an autogenerated method
+// nolint: dupl
+func (u InstallationUpdater) SetProvider(provider string) InstallationUpdater {
+	u.fields[string(InstallationDBSchema.Provider)] = provider
+	return u
+}
+
+// SetProviderID is an autogenerated method
+// nolint: dupl
+func (u InstallationUpdater) SetProviderID(providerID int) InstallationUpdater {
+	u.fields[string(InstallationDBSchema.ProviderID)] = providerID
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u InstallationUpdater) SetUpdatedAt(updatedAt time.Time) InstallationUpdater {
+	u.fields[string(InstallationDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u InstallationUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u InstallationUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) UpdatedAtEq(updatedAt time.Time) InstallationQuerySet {
+	return qs.w(qs.db.Where("updated_at = ?", updatedAt))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) UpdatedAtGt(updatedAt time.Time) InstallationQuerySet {
+	return qs.w(qs.db.Where("updated_at > ?", updatedAt))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) UpdatedAtGte(updatedAt time.Time) InstallationQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", updatedAt))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) UpdatedAtLt(updatedAt time.Time) InstallationQuerySet {
+	return qs.w(qs.db.Where("updated_at < ?", updatedAt))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) UpdatedAtLte(updatedAt time.Time) InstallationQuerySet {
+	return qs.w(qs.db.Where("updated_at <= ?", updatedAt))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs InstallationQuerySet) UpdatedAtNe(updatedAt time.Time) InstallationQuerySet {
+	return qs.w(qs.db.Where("updated_at != ?", updatedAt))
+}
+
+// ===== END of query set InstallationQuerySet
+
+// ===== BEGIN of Installation modifiers
+
+// InstallationDBSchemaField describes database schema field. It requires for method 'Update'
+type InstallationDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f InstallationDBSchemaField) String() string {
+	return string(f)
+}
+
+// InstallationDBSchema stores db field names of Installation
+var InstallationDBSchema = struct {
+	ID                InstallationDBSchemaField
+	CreatedAt         InstallationDBSchemaField
+	UpdatedAt         InstallationDBSchemaField
+	DeletedAt         InstallationDBSchemaField
+	Provider          InstallationDBSchemaField
+	ProviderID        InstallationDBSchemaField
+	AccountName       InstallationDBSchemaField
+	AccountProviderID InstallationDBSchemaField
+}{
+
+	ID:                InstallationDBSchemaField("id"),
+	CreatedAt:         InstallationDBSchemaField("created_at"),
+	UpdatedAt:         InstallationDBSchemaField("updated_at"),
+	DeletedAt:         InstallationDBSchemaField("deleted_at"),
+	Provider:          InstallationDBSchemaField("provider"),
+	ProviderID:        InstallationDBSchemaField("provider_id"),
+	AccountName:       InstallationDBSchemaField("account_name"),
+	AccountProviderID: InstallationDBSchemaField("account_provider_id"),
+}
+
+// Update updates Installation fields by primary key
+// nolint: dupl
+func (o *Installation) Update(db *gorm.DB, fields ...InstallationDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":                  o.ID,
+		"created_at":          o.CreatedAt,
+		"updated_at":          o.UpdatedAt,
+		"deleted_at":          o.DeletedAt,
+		"provider":            o.Provider,
+		"provider_id":         o.ProviderID,
+		"account_name":        o.AccountName,
+		"account_provider_id": o.AccountProviderID,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update Installation %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// InstallationUpdater is an Installation updates manager
+type InstallationUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewInstallationUpdater creates new Installation updater
+// nolint: dupl
+func NewInstallationUpdater(db *gorm.DB) InstallationUpdater {
+	return InstallationUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&Installation{}),
+	}
+}
+
+// ===== END of Installation modifiers
+
+// ===== END of all query sets
@@ -0,0 +1,958 @@
+// Code generated by go-queryset. DO NOT EDIT.
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// ===== BEGIN of all query sets
+
+// ===== BEGIN of query set IssueQuerySet
+
+// IssueQuerySet is an queryset type for Issue
+type IssueQuerySet struct {
+	db *gorm.DB
+}
+
+// NewIssueQuerySet constructs new IssueQuerySet
+func NewIssueQuerySet(db *gorm.DB) IssueQuerySet {
+	return IssueQuerySet{
+		db: db.Model(&Issue{}),
+	}
+}
+
+func (qs IssueQuerySet) w(db *gorm.DB) IssueQuerySet {
+	return NewIssueQuerySet(db)
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) All(ret *[]Issue) error {
+	return qs.db.Find(ret).Error
+}
+
+// AnalysisIDEq is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) AnalysisIDEq(analysisID uint) IssueQuerySet {
+	return qs.w(qs.db.Where("analysis_id = ?", analysisID))
+}
+
+// AnalysisIDGt is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) AnalysisIDGt(analysisID uint) IssueQuerySet {
+	return qs.w(qs.db.Where("analysis_id > ?", analysisID))
+}
+
+// AnalysisIDGte is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) AnalysisIDGte(analysisID uint) IssueQuerySet {
+	return qs.w(qs.db.Where("analysis_id >= ?", analysisID))
+}
+
+// AnalysisIDIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) AnalysisIDIn(analysisID ...uint) IssueQuerySet {
+	if len(analysisID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one analysisID in AnalysisIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("analysis_id IN (?)", analysisID))
+}
+
+// AnalysisIDLt is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) AnalysisIDLt(analysisID uint) IssueQuerySet {
+	return qs.w(qs.db.Where("analysis_id < ?", analysisID))
+}
+
+// AnalysisIDLte is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) AnalysisIDLte(analysisID uint) IssueQuerySet {
+	return qs.w(qs.db.Where("analysis_id <= ?", analysisID))
+}
+
+// AnalysisIDNe is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) AnalysisIDNe(analysisID uint) IssueQuerySet {
+	return qs.w(qs.db.Where("analysis_id != ?", analysisID))
+}
+
+// AnalysisIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) AnalysisIDNotIn(analysisID ...uint) IssueQuerySet {
+	if len(analysisID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one analysisID in AnalysisIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("analysis_id NOT IN (?)", analysisID))
+}
+
+// AnalysisTypeEq is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) AnalysisTypeEq(analysisType IssueAnalysisType) IssueQuerySet {
+	return qs.w(qs.db.Where("analysis_type = ?", analysisType))
+}
+
+// AnalysisTypeIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) AnalysisTypeIn(analysisType ...IssueAnalysisType) IssueQuerySet {
+	if len(analysisType) == 0 {
+		qs.db.AddError(errors.New("must at least pass one analysisType in AnalysisTypeIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("analysis_type IN (?)", analysisType))
+}
+
+// AnalysisTypeNe is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) AnalysisTypeNe(analysisType IssueAnalysisType) IssueQuerySet {
+	return qs.w(qs.db.Where("analysis_type != ?", analysisType))
+}
+
+// AnalysisTypeNotIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) AnalysisTypeNotIn(analysisType ...IssueAnalysisType) IssueQuerySet {
+	if len(analysisType) == 0 {
+		qs.db.AddError(errors.New("must at least pass one analysisType in AnalysisTypeNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("analysis_type NOT IN (?)", analysisType))
+}
+
+// ColumnNumberEq is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) ColumnNumberEq(columnNumber int) IssueQuerySet {
+	return qs.w(qs.db.Where("column_number = ?", columnNumber))
+}
+
+// ColumnNumberGt is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) ColumnNumberGt(columnNumber int) IssueQuerySet {
+	return qs.w(qs.db.Where("column_number > ?", columnNumber))
+}
+
+// ColumnNumberGte is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) ColumnNumberGte(columnNumber int) IssueQuerySet {
+	return qs.w(qs.db.Where("column_number >= ?", columnNumber))
+}
+
+// ColumnNumberIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) ColumnNumberIn(columnNumber ...int) IssueQuerySet {
+	if len(columnNumber) == 0 {
+		qs.db.AddError(errors.New("must at least pass one columnNumber in ColumnNumberIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("column_number IN (?)", columnNumber))
+}
+
+// ColumnNumberLt is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) ColumnNumberLt(columnNumber int) IssueQuerySet {
+	return qs.w(qs.db.Where("column_number < ?", columnNumber))
+}
+
+// ColumnNumberLte is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) ColumnNumberLte(columnNumber int) IssueQuerySet {
+	return qs.w(qs.db.Where("column_number <= ?", columnNumber))
+}
+
+// ColumnNumberNe is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) ColumnNumberNe(columnNumber int) IssueQuerySet {
+	return qs.w(qs.db.Where("column_number != ?", columnNumber))
+}
+
+// ColumnNumberNotIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) ColumnNumberNotIn(columnNumber ...int) IssueQuerySet {
+	if len(columnNumber) == 0 {
+		qs.db.AddError(errors.New("must at least pass one columnNumber in ColumnNumberNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("column_number NOT IN (?)", columnNumber))
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) Count() (int, error) {
+	var count int
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *Issue) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) CreatedAtEq(createdAt time.Time) IssueQuerySet {
+	return qs.w(qs.db.Where("created_at = ?", createdAt))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) CreatedAtGt(createdAt time.Time) IssueQuerySet {
+	return qs.w(qs.db.Where("created_at > ?", createdAt))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) CreatedAtGte(createdAt time.Time) IssueQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", createdAt))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) CreatedAtLt(createdAt time.Time) IssueQuerySet {
+	return qs.w(qs.db.Where("created_at < ?", createdAt))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) CreatedAtLte(createdAt time.Time) IssueQuerySet {
+	return qs.w(qs.db.Where("created_at <= ?", createdAt))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) CreatedAtNe(createdAt time.Time) IssueQuerySet {
+	return qs.w(qs.db.Where("created_at != ?", createdAt))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *Issue) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) Delete() error {
+	return qs.db.Delete(Issue{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(Issue{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(Issue{})
+	return db.RowsAffected, db.Error
+}
+
+// FileEq is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) FileEq(file string) IssueQuerySet {
+	return qs.w(qs.db.Where("file = ?", file))
+}
+
+// FileIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) FileIn(file ...string) IssueQuerySet {
+	if len(file) == 0 {
+		qs.db.AddError(errors.New("must at least pass one file in FileIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("file IN (?)", file))
+}
+
+// FileNe is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) FileNe(file string) IssueQuerySet {
+	return qs.w(qs.db.Where("file != ?", file))
+}
+
+// FileNotIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) FileNotIn(file ...string) IssueQuerySet {
+	if len(file) == 0 {
+		qs.db.AddError(errors.New("must at least pass one file in FileNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("file NOT IN (?)", file))
+}
+
+// FingerprintEq is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) FingerprintEq(fingerprint string) IssueQuerySet {
+	return qs.w(qs.db.Where("fingerprint = ?", fingerprint))
+}
+
+// FingerprintIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) FingerprintIn(fingerprint ...string) IssueQuerySet {
+	if len(fingerprint) == 0 {
+		qs.db.AddError(errors.New("must at least pass one fingerprint in FingerprintIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("fingerprint IN (?)", fingerprint))
+}
+
+// FingerprintNe is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) FingerprintNe(fingerprint string) IssueQuerySet {
+	return qs.w(qs.db.Where("fingerprint != ?", fingerprint))
+}
+
+// FingerprintNotIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) FingerprintNotIn(fingerprint ...string) IssueQuerySet {
+	if len(fingerprint) == 0 {
+		qs.db.AddError(errors.New("must at least pass one fingerprint in FingerprintNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("fingerprint NOT IN (?)", fingerprint))
+}
+
+// FromLinterEq is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) FromLinterEq(fromLinter string) IssueQuerySet {
+	return qs.w(qs.db.Where("from_linter = ?", fromLinter))
+}
+
+// FromLinterIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) FromLinterIn(fromLinter ...string) IssueQuerySet {
+	if len(fromLinter) == 0 {
+		qs.db.AddError(errors.New("must at least pass one fromLinter in FromLinterIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("from_linter IN (?)", fromLinter))
+}
+
+// FromLinterNe is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) FromLinterNe(fromLinter string) IssueQuerySet {
+	return qs.w(qs.db.Where("from_linter != ?", fromLinter))
+}
+
+// FromLinterNotIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) FromLinterNotIn(fromLinter ...string) IssueQuerySet {
+	if len(fromLinter) == 0 {
+		qs.db.AddError(errors.New("must at least pass one fromLinter in FromLinterNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("from_linter NOT IN (?)", fromLinter))
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) GetUpdater() IssueUpdater {
+	return NewIssueUpdater(qs.db)
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) IDEq(ID uint) IssueQuerySet {
+	return qs.w(qs.db.Where("id = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) IDGt(ID uint) IssueQuerySet {
+	return qs.w(qs.db.Where("id > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) IDGte(ID uint) IssueQuerySet {
+	return qs.w(qs.db.Where("id >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) IDIn(ID ...uint) IssueQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id IN (?)", ID))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) IDLt(ID uint) IssueQuerySet {
+	return qs.w(qs.db.Where("id < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) IDLte(ID uint) IssueQuerySet {
+	return qs.w(qs.db.Where("id <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) IDNe(ID uint) IssueQuerySet {
+	return qs.w(qs.db.Where("id != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) IDNotIn(ID ...uint) IssueQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+}
+
+// IsNewEq is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) IsNewEq(isNew bool) IssueQuerySet {
+	return qs.w(qs.db.Where("is_new = ?", isNew))
+}
+
+// IsNewIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) IsNewIn(isNew ...bool) IssueQuerySet {
+	if len(isNew) == 0 {
+		qs.db.AddError(errors.New("must at least pass one isNew in IsNewIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("is_new IN (?)", isNew))
+}
+
+// IsNewNe is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) IsNewNe(isNew bool) IssueQuerySet {
+	return qs.w(qs.db.Where("is_new != ?", isNew))
+}
+
+// IsNewNotIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) IsNewNotIn(isNew ...bool) IssueQuerySet {
+	if len(isNew) == 0 {
+		qs.db.AddError(errors.New("must at least pass one isNew in IsNewNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("is_new NOT IN (?)", isNew))
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) Limit(limit int) IssueQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// LineFromEq is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) LineFromEq(lineFrom int) IssueQuerySet {
+	return qs.w(qs.db.Where("line_from = ?", lineFrom))
+}
+
+// LineFromGt is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) LineFromGt(lineFrom int) IssueQuerySet {
+	return qs.w(qs.db.Where("line_from > ?", lineFrom))
+}
+
+// LineFromGte is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) LineFromGte(lineFrom int) IssueQuerySet {
+	return qs.w(qs.db.Where("line_from >= ?", lineFrom))
+}
+
+// LineFromIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) LineFromIn(lineFrom ...int) IssueQuerySet {
+	if len(lineFrom) == 0 {
+		qs.db.AddError(errors.New("must at least pass one lineFrom in LineFromIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("line_from IN (?)", lineFrom))
+}
+
+// LineFromLt is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) LineFromLt(lineFrom int) IssueQuerySet {
+	return qs.w(qs.db.Where("line_from < ?", lineFrom))
+}
+
+// LineFromLte is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) LineFromLte(lineFrom int) IssueQuerySet {
+	return qs.w(qs.db.Where("line_from <= ?", lineFrom))
+}
+
+// LineFromNe is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) LineFromNe(lineFrom int) IssueQuerySet {
+	return qs.w(qs.db.Where("line_from != ?", lineFrom))
+}
+
+// LineFromNotIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) LineFromNotIn(lineFrom ...int) IssueQuerySet {
+	if len(lineFrom) == 0 {
+		qs.db.AddError(errors.New("must at least pass one lineFrom in LineFromNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("line_from NOT IN (?)", lineFrom))
+}
+
+// LineToEq is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) LineToEq(lineTo int) IssueQuerySet {
+	return qs.w(qs.db.Where("line_to = ?", lineTo))
+}
+
+// LineToGt is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) LineToGt(lineTo int) IssueQuerySet {
+	return qs.w(qs.db.Where("line_to > ?", lineTo))
+}
+
+// LineToGte is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) LineToGte(lineTo int) IssueQuerySet {
+	return qs.w(qs.db.Where("line_to >= ?", lineTo))
+}
+
+// LineToIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) LineToIn(lineTo ...int) IssueQuerySet {
+	if len(lineTo) == 0 {
+		qs.db.AddError(errors.New("must at least pass one lineTo in LineToIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("line_to IN (?)", lineTo))
+}
+
+// LineToLt is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) LineToLt(lineTo int) IssueQuerySet {
+	return qs.w(qs.db.Where("line_to < ?", lineTo))
+}
+
+// LineToLte is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) LineToLte(lineTo int) IssueQuerySet {
+	return qs.w(qs.db.Where("line_to <= ?", lineTo))
+}
+
+// LineToNe is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) LineToNe(lineTo int) IssueQuerySet {
+	return qs.w(qs.db.Where("line_to != ?", lineTo))
+}
+
+// LineToNotIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) LineToNotIn(lineTo ...int) IssueQuerySet {
+	if len(lineTo) == 0 {
+		qs.db.AddError(errors.New("must at least pass one lineTo in LineToNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("line_to NOT IN (?)", lineTo))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) Offset(offset int) IssueQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs IssueQuerySet) One(ret *Issue) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByAnalysisID is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) OrderAscByAnalysisID() IssueQuerySet {
+	return qs.w(qs.db.Order("analysis_id ASC"))
+}
+
+// OrderAscByColumnNumber is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) OrderAscByColumnNumber() IssueQuerySet {
+	return qs.w(qs.db.Order("column_number ASC"))
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) OrderAscByCreatedAt() IssueQuerySet {
+	return qs.w(qs.db.Order("created_at ASC"))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) OrderAscByID() IssueQuerySet {
+	return qs.w(qs.db.Order("id ASC"))
+}
+
+// OrderAscByLineFrom is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) OrderAscByLineFrom() IssueQuerySet {
+	return qs.w(qs.db.Order("line_from ASC"))
+}
+
+// OrderAscByLineTo is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) OrderAscByLineTo() IssueQuerySet {
+	return qs.w(qs.db.Order("line_to ASC"))
+}
+
+// OrderDescByAnalysisID is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) OrderDescByAnalysisID() IssueQuerySet {
+	return qs.w(qs.db.Order("analysis_id DESC"))
+}
+
+// OrderDescByColumnNumber is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) OrderDescByColumnNumber() IssueQuerySet {
+	return qs.w(qs.db.Order("column_number DESC"))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) OrderDescByCreatedAt() IssueQuerySet {
+	return qs.w(qs.db.Order("created_at DESC"))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) OrderDescByID() IssueQuerySet {
+	return qs.w(qs.db.Order("id DESC"))
+}
+
+// OrderDescByLineFrom is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) OrderDescByLineFrom() IssueQuerySet {
+	return qs.w(qs.db.Order("line_from DESC"))
+}
+
+// OrderDescByLineTo is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) OrderDescByLineTo() IssueQuerySet {
+	return qs.w(qs.db.Order("line_to DESC"))
+}
+
+// ReplacementEq is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) ReplacementEq(replacement json.RawMessage) IssueQuerySet {
+	return qs.w(qs.db.Where("replacement = ?", replacement))
+}
+
+// ReplacementIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) ReplacementIn(replacement ...json.RawMessage) IssueQuerySet {
+	if len(replacement) == 0 {
+		qs.db.AddError(errors.New("must at least pass one replacement in ReplacementIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("replacement IN (?)", replacement))
+}
+
+// ReplacementNe is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) ReplacementNe(replacement json.RawMessage) IssueQuerySet {
+	return qs.w(qs.db.Where("replacement != ?", replacement))
+}
+
+// ReplacementNotIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) ReplacementNotIn(replacement ...json.RawMessage) IssueQuerySet {
+	if len(replacement) == 0 {
+		qs.db.AddError(errors.New("must at least pass one replacement in ReplacementNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("replacement NOT IN (?)", replacement))
+}
+
+// SetAnalysisID is an autogenerated method
+// nolint: dupl
+func (u IssueUpdater) SetAnalysisID(analysisID uint) IssueUpdater {
+	u.fields[string(IssueDBSchema.AnalysisID)] = analysisID
+	return u
+}
+
+// SetAnalysisType is an autogenerated method
+// nolint: dupl
+func (u IssueUpdater) SetAnalysisType(analysisType IssueAnalysisType) IssueUpdater {
+	u.fields[string(IssueDBSchema.AnalysisType)] = analysisType
+	return u
+}
+
+// SetColumnNumber is an autogenerated method
+// nolint: dupl
+func (u IssueUpdater) SetColumnNumber(columnNumber int) IssueUpdater {
+	u.fields[string(IssueDBSchema.ColumnNumber)] = columnNumber
+	return u
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u IssueUpdater) SetCreatedAt(createdAt time.Time) IssueUpdater {
+	u.fields[string(IssueDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetFile is an autogenerated method
+// nolint: dupl
+func (u IssueUpdater) SetFile(file string) IssueUpdater {
+	u.fields[string(IssueDBSchema.File)] = file
+	return u
+}
+
+// SetFingerprint is an autogenerated method
+// nolint: dupl
+func (u IssueUpdater) SetFingerprint(fingerprint string) IssueUpdater {
+	u.fields[string(IssueDBSchema.Fingerprint)] = fingerprint
+	return u
+}
+
+// SetFromLinter is an autogenerated method
+// nolint: dupl
+func (u IssueUpdater) SetFromLinter(fromLinter string) IssueUpdater {
+	u.fields[string(IssueDBSchema.FromLinter)] = fromLinter
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u IssueUpdater) SetID(ID uint) IssueUpdater {
+	u.fields[string(IssueDBSchema.ID)] = ID
+	return u
+}
+
+// SetIsNew is an autogenerated method
+// nolint: dupl
+func (u IssueUpdater) SetIsNew(isNew bool) IssueUpdater {
+	u.fields[string(IssueDBSchema.IsNew)] = isNew
+	return u
+}
+
+// SetLineFrom is an autogenerated method
+// nolint: dupl
+func (u IssueUpdater) SetLineFrom(lineFrom int) IssueUpdater {
+	u.fields[string(IssueDBSchema.LineFrom)] = lineFrom
+	return u
+}
+
+// SetLineTo is an autogenerated method
+// nolint: dupl
+func (u IssueUpdater) SetLineTo(lineTo int) IssueUpdater {
+	u.fields[string(IssueDBSchema.LineTo)] = lineTo
+	return u
+}
+
+// SetReplacement is an autogenerated method
+// nolint: dupl
+func (u IssueUpdater) SetReplacement(replacement json.RawMessage) IssueUpdater {
+	u.fields[string(IssueDBSchema.Replacement)] = replacement
+	return u
+}
+
+// SetSeverity is an autogenerated method
+// nolint: dupl
+func (u IssueUpdater) SetSeverity(severity string) IssueUpdater {
+	u.fields[string(IssueDBSchema.Severity)] = severity
+	return u
+}
+
+// SetText is an autogenerated method
+// nolint: dupl
+func (u IssueUpdater) SetText(text string) IssueUpdater {
+	u.fields[string(IssueDBSchema.Text)] = text
+	return u
+}
+
+// SeverityEq is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) SeverityEq(severity string) IssueQuerySet {
+	return qs.w(qs.db.Where("severity = ?", severity))
+}
+
+// SeverityIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) SeverityIn(severity ...string) IssueQuerySet {
+	if len(severity) == 0 {
+		qs.db.AddError(errors.New("must at least pass one severity in SeverityIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("severity IN (?)", severity))
+}
+
+// SeverityNe is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) SeverityNe(severity string) IssueQuerySet {
+	return qs.w(qs.db.Where("severity != ?", severity))
+}
+
+// SeverityNotIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) SeverityNotIn(severity ...string) IssueQuerySet {
+	if len(severity) == 0 {
+		qs.db.AddError(errors.New("must at least pass one severity in SeverityNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("severity NOT IN (?)", severity))
+}
+
+// TextEq is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) TextEq(text string) IssueQuerySet {
+	return qs.w(qs.db.Where("text = ?", text))
+}
+
+// TextIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) TextIn(text ...string) IssueQuerySet {
+	if len(text) == 0 {
+		qs.db.AddError(errors.New("must at least pass one text in TextIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("text IN (?)", text))
+}
+
+// TextNe is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) TextNe(text string) IssueQuerySet {
+	return qs.w(qs.db.Where("text != ?", text))
+}
+
+// TextNotIn is an autogenerated method
+// nolint: dupl
+func (qs IssueQuerySet) TextNotIn(text ...string) IssueQuerySet {
+	if len(text) == 0 {
+		qs.db.AddError(errors.New("must at least pass one text in TextNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("text NOT IN (?)", text))
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u IssueUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u IssueUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// ===== END of query set IssueQuerySet
+
+// ===== BEGIN of Issue modifiers
+
+// IssueDBSchemaField describes database schema field. It requires for method 'Update'
+type IssueDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f IssueDBSchemaField) String() string {
+	return string(f)
+}
+
+// IssueDBSchema stores db field names of Issue
+var IssueDBSchema = struct {
+	ID           IssueDBSchemaField
+	CreatedAt    IssueDBSchemaField
+	AnalysisType IssueDBSchemaField
+	AnalysisID   IssueDBSchemaField
+	FromLinter   IssueDBSchemaField
+	File         IssueDBSchemaField
+	LineFrom     IssueDBSchemaField
+	LineTo       IssueDBSchemaField
+	ColumnNumber IssueDBSchemaField
+	Text         IssueDBSchemaField
+	Fingerprint  IssueDBSchemaField
+	Severity     IssueDBSchemaField
+	Replacement  IssueDBSchemaField
+	IsNew        IssueDBSchemaField
+}{
+
+	ID:           IssueDBSchemaField("id"),
+	CreatedAt:    IssueDBSchemaField("created_at"),
+	AnalysisType: IssueDBSchemaField("analysis_type"),
+	AnalysisID:   IssueDBSchemaField("analysis_id"),
+	FromLinter:   IssueDBSchemaField("from_linter"),
+	File:         IssueDBSchemaField("file"),
+	LineFrom:     IssueDBSchemaField("line_from"),
+	LineTo:       IssueDBSchemaField("line_to"),
+	ColumnNumber: IssueDBSchemaField("column_number"),
+	Text:         IssueDBSchemaField("text"),
+	Fingerprint:  IssueDBSchemaField("fingerprint"),
+	Severity:     IssueDBSchemaField("severity"),
+	Replacement:  IssueDBSchemaField("replacement"),
+	IsNew:        IssueDBSchemaField("is_new"),
+}
+
+// Update updates Issue fields by primary key
+// nolint: dupl
+func (o *Issue) Update(db *gorm.DB, fields ...IssueDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":            o.ID,
+		"created_at":    o.CreatedAt,
+		"analysis_type": o.AnalysisType,
+		"analysis_id":   o.AnalysisID,
+		"from_linter":   o.FromLinter,
+		"file":          o.File,
+		"line_from":     o.LineFrom,
+		"line_to":       o.LineTo,
+		"column_number": o.ColumnNumber,
+		"text":          o.Text,
+		"fingerprint":   o.Fingerprint,
+		"severity":      o.Severity,
+		"replacement":   o.Replacement,
+		"is_new":        o.IsNew,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update Issue %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// IssueUpdater is an Issue updates manager
+type IssueUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewIssueUpdater creates new Issue updater
+// nolint: dupl
+func NewIssueUpdater(db *gorm.DB) IssueUpdater {
+	return IssueUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&Issue{}),
+	}
+}
+
+// ===== END of Issue modifiers
+
+// ===== END of all query sets
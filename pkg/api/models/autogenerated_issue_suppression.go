@@ -0,0 +1,719 @@
+// Code generated by go-queryset. DO NOT EDIT.
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// ===== BEGIN of all query sets
+
+// ===== BEGIN of query set IssueSuppressionQuerySet
+
+// IssueSuppressionQuerySet is an queryset type for IssueSuppression
+type IssueSuppressionQuerySet struct {
+	db *gorm.DB
+}
+
+// NewIssueSuppressionQuerySet constructs new IssueSuppressionQuerySet
+func NewIssueSuppressionQuerySet(db *gorm.DB) IssueSuppressionQuerySet {
+	return IssueSuppressionQuerySet{
+		db: db.Model(&IssueSuppression{}),
+	}
+}
+
+func (qs IssueSuppressionQuerySet) w(db *gorm.DB) IssueSuppressionQuerySet {
+	return NewIssueSuppressionQuerySet(db)
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) All(ret *[]IssueSuppression) error {
+	return qs.db.Find(ret).Error
+}
+
+// AuthorUserIDEq is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) AuthorUserIDEq(authorUserID uint) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("author_user_id = ?", authorUserID))
+}
+
+// AuthorUserIDGt is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) AuthorUserIDGt(authorUserID uint) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("author_user_id > ?", authorUserID))
+}
+
+// AuthorUserIDGte is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) AuthorUserIDGte(authorUserID uint) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("author_user_id >= ?", authorUserID))
+}
+
+// AuthorUserIDIn is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) AuthorUserIDIn(authorUserID ...uint) IssueSuppressionQuerySet {
+	if len(authorUserID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one authorUserID in AuthorUserIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("author_user_id IN (?)", authorUserID))
+}
+
+// AuthorUserIDLt is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) AuthorUserIDLt(authorUserID uint) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("author_user_id < ?", authorUserID))
+}
+
+// AuthorUserIDLte is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) AuthorUserIDLte(authorUserID uint) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("author_user_id <= ?", authorUserID))
+}
+
+// AuthorUserIDNe is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) AuthorUserIDNe(authorUserID uint) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("author_user_id != ?", authorUserID))
+}
+
+// AuthorUserIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) AuthorUserIDNotIn(authorUserID ...uint) IssueSuppressionQuerySet {
+	if len(authorUserID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one authorUserID in AuthorUserIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("author_user_id NOT IN (?)", authorUserID))
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) Count() (int, error) {
+	var count int
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *IssueSuppression) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) CreatedAtEq(createdAt time.Time) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("created_at = ?", createdAt))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) CreatedAtGt(createdAt time.Time) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("created_at > ?", createdAt))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) CreatedAtGte(createdAt time.Time) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", createdAt))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) CreatedAtLt(createdAt time.Time) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("created_at < ?", createdAt))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) CreatedAtLte(createdAt time.Time) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("created_at <= ?", createdAt))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) CreatedAtNe(createdAt time.Time) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("created_at != ?", createdAt))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *IssueSuppression) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) Delete() error {
+	return qs.db.Delete(IssueSuppression{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(IssueSuppression{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(IssueSuppression{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) DeletedAtEq(deletedAt time.Time) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("deleted_at = ?", deletedAt))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) DeletedAtGt(deletedAt time.Time) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("deleted_at > ?", deletedAt))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) DeletedAtGte(deletedAt time.Time) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ?", deletedAt))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) DeletedAtIsNotNull() IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) DeletedAtIsNull() IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NULL"))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) DeletedAtLt(deletedAt time.Time) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("deleted_at < ?", deletedAt))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) DeletedAtLte(deletedAt time.Time) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("deleted_at <= ?", deletedAt))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) DeletedAtNe(deletedAt time.Time) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("deleted_at != ?", deletedAt))
+}
+
+// FingerprintEq is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) FingerprintEq(fingerprint string) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("fingerprint = ?", fingerprint))
+}
+
+// FingerprintIn is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) FingerprintIn(fingerprint ...string) IssueSuppressionQuerySet {
+	if len(fingerprint) == 0 {
+		qs.db.AddError(errors.New("must at least pass one fingerprint in FingerprintIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("fingerprint IN (?)", fingerprint))
+}
+
+// FingerprintNe is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) FingerprintNe(fingerprint string) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("fingerprint != ?", fingerprint))
+}
+
+// FingerprintNotIn is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) FingerprintNotIn(fingerprint ...string) IssueSuppressionQuerySet {
+	if len(fingerprint) == 0 {
+		qs.db.AddError(errors.New("must at least pass one fingerprint in FingerprintNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("fingerprint NOT IN (?)", fingerprint))
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) GetUpdater() IssueSuppressionUpdater {
+	return NewIssueSuppressionUpdater(qs.db)
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) IDEq(ID uint) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("id = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) IDGt(ID uint) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("id > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) IDGte(ID uint) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("id >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) IDIn(ID ...uint) IssueSuppressionQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id IN (?)", ID))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) IDLt(ID uint) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("id < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) IDLte(ID uint) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("id <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) IDNe(ID uint) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("id != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) IDNotIn(ID ...uint) IssueSuppressionQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) Limit(limit int) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) Offset(offset int) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs IssueSuppressionQuerySet) One(ret *IssueSuppression) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByAuthorUserID is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) OrderAscByAuthorUserID() IssueSuppressionQuerySet {
+	return qs.w(qs.db.Order("author_user_id ASC"))
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) OrderAscByCreatedAt() IssueSuppressionQuerySet {
+	return qs.w(qs.db.Order("created_at ASC"))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) OrderAscByDeletedAt() IssueSuppressionQuerySet {
+	return qs.w(qs.db.Order("deleted_at ASC"))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) OrderAscByID() IssueSuppressionQuerySet {
+	return qs.w(qs.db.Order("id ASC"))
+}
+
+// OrderAscByRepoID is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) OrderAscByRepoID() IssueSuppressionQuerySet {
+	return qs.w(qs.db.Order("repo_id ASC"))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) OrderAscByUpdatedAt() IssueSuppressionQuerySet {
+	return qs.w(qs.db.Order("updated_at ASC"))
+}
+
+// OrderDescByAuthorUserID is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) OrderDescByAuthorUserID() IssueSuppressionQuerySet {
+	return qs.w(qs.db.Order("author_user_id DESC"))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) OrderDescByCreatedAt() IssueSuppressionQuerySet {
+	return qs.w(qs.db.Order("created_at DESC"))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) OrderDescByDeletedAt() IssueSuppressionQuerySet {
+	return qs.w(qs.db.Order("deleted_at DESC"))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) OrderDescByID() IssueSuppressionQuerySet {
+	return qs.w(qs.db.Order("id DESC"))
+}
+
+// OrderDescByRepoID is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) OrderDescByRepoID() IssueSuppressionQuerySet {
+	return qs.w(qs.db.Order("repo_id DESC"))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) OrderDescByUpdatedAt() IssueSuppressionQuerySet {
+	return qs.w(qs.db.Order("updated_at DESC"))
+}
+
+// ReasonEq is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) ReasonEq(reason string) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("reason = ?", reason))
+}
+
+// ReasonIn is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) ReasonIn(reason ...string) IssueSuppressionQuerySet {
+	if len(reason) == 0 {
+		qs.db.AddError(errors.New("must at least pass one reason in ReasonIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("reason IN (?)", reason))
+}
+
+// ReasonNe is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) ReasonNe(reason string) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("reason != ?", reason))
+}
+
+// ReasonNotIn is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) ReasonNotIn(reason ...string) IssueSuppressionQuerySet {
+	if len(reason) == 0 {
+		qs.db.AddError(errors.New("must at least pass one reason in ReasonNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("reason NOT IN (?)", reason))
+}
+
+// RepoIDEq is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) RepoIDEq(repoID uint) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("repo_id = ?", repoID))
+}
+
+// RepoIDGt is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) RepoIDGt(repoID uint) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("repo_id > ?", repoID))
+}
+
+// RepoIDGte is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) RepoIDGte(repoID uint) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("repo_id >= ?", repoID))
+}
+
+// RepoIDIn is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) RepoIDIn(repoID ...uint) IssueSuppressionQuerySet {
+	if len(repoID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one repoID in RepoIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("repo_id IN (?)", repoID))
+}
+
+// RepoIDLt is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) RepoIDLt(repoID uint) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("repo_id < ?", repoID))
+}
+
+// RepoIDLte is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) RepoIDLte(repoID uint) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("repo_id <= ?", repoID))
+}
+
+// RepoIDNe is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) RepoIDNe(repoID uint) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("repo_id != ?", repoID))
+}
+
+// RepoIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) RepoIDNotIn(repoID ...uint) IssueSuppressionQuerySet {
+	if len(repoID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one repoID in RepoIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("repo_id NOT IN (?)", repoID))
+}
+
+// SetAuthorUserID is an autogenerated method
+// nolint: dupl
+func (u IssueSuppressionUpdater) SetAuthorUserID(authorUserID uint) IssueSuppressionUpdater {
+	u.fields[string(IssueSuppressionDBSchema.AuthorUserID)] = authorUserID
+	return u
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u IssueSuppressionUpdater) SetCreatedAt(createdAt time.Time) IssueSuppressionUpdater {
+	u.fields[string(IssueSuppressionDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u IssueSuppressionUpdater) SetDeletedAt(deletedAt *time.Time) IssueSuppressionUpdater {
+	u.fields[string(IssueSuppressionDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetFingerprint is an autogenerated method
+// nolint: dupl
+func (u IssueSuppressionUpdater) SetFingerprint(fingerprint string) IssueSuppressionUpdater {
+	u.fields[string(IssueSuppressionDBSchema.Fingerprint)] = fingerprint
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u IssueSuppressionUpdater) SetID(ID uint) IssueSuppressionUpdater {
+	u.fields[string(IssueSuppressionDBSchema.ID)] = ID
+	return u
+}
+
+// SetReason is an autogenerated method
+// nolint: dupl
+func (u IssueSuppressionUpdater) SetReason(reason string) IssueSuppressionUpdater {
+	u.fields[string(IssueSuppressionDBSchema.Reason)] = reason
+	return u
+}
+
+// SetRepoID is an autogenerated method
+// nolint: dupl
+func (u IssueSuppressionUpdater) SetRepoID(repoID uint) IssueSuppressionUpdater {
+	u.fields[string(IssueSuppressionDBSchema.RepoID)] = repoID
+	return u
+}
+
+// SetSource is an autogenerated method
+// nolint: dupl
+func (u IssueSuppressionUpdater) SetSource(source IssueSuppressionSource) IssueSuppressionUpdater {
+	u.fields[string(IssueSuppressionDBSchema.Source)] = source
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u IssueSuppressionUpdater) SetUpdatedAt(updatedAt time.Time) IssueSuppressionUpdater {
+	u.fields[string(IssueSuppressionDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// SourceEq is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) SourceEq(source IssueSuppressionSource) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("source = ?", source))
+}
+
+// SourceIn is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) SourceIn(source ...IssueSuppressionSource) IssueSuppressionQuerySet {
+	if len(source) == 0 {
+		qs.db.AddError(errors.New("must at least pass one source in SourceIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("source IN (?)", source))
+}
+
+// SourceNe is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) SourceNe(source IssueSuppressionSource) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("source != ?", source))
+}
+
+// SourceNotIn is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) SourceNotIn(source ...IssueSuppressionSource) IssueSuppressionQuerySet {
+	if len(source) == 0 {
+		qs.db.AddError(errors.New("must at least pass one source in SourceNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("source NOT IN (?)", source))
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u IssueSuppressionUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u IssueSuppressionUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) UpdatedAtEq(updatedAt time.Time) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("updated_at = ?", updatedAt))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) UpdatedAtGt(updatedAt time.Time) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("updated_at > ?", updatedAt))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) UpdatedAtGte(updatedAt time.Time) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", updatedAt))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) UpdatedAtLt(updatedAt time.Time) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("updated_at < ?", updatedAt))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) UpdatedAtLte(updatedAt time.Time) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("updated_at <= ?", updatedAt))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs IssueSuppressionQuerySet) UpdatedAtNe(updatedAt time.Time) IssueSuppressionQuerySet {
+	return qs.w(qs.db.Where("updated_at != ?", updatedAt))
+}
+
+// ===== END of query set IssueSuppressionQuerySet
+
+// ===== BEGIN of IssueSuppression modifiers
+
+// IssueSuppressionDBSchemaField describes database schema field. It requires for method 'Update'
+type IssueSuppressionDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f IssueSuppressionDBSchemaField) String() string {
+	return string(f)
+}
+
+// IssueSuppressionDBSchema stores db field names of IssueSuppression
+var IssueSuppressionDBSchema = struct {
+	ID           IssueSuppressionDBSchemaField
+	CreatedAt    IssueSuppressionDBSchemaField
+	UpdatedAt    IssueSuppressionDBSchemaField
+	DeletedAt    IssueSuppressionDBSchemaField
+	RepoID       IssueSuppressionDBSchemaField
+	Fingerprint  IssueSuppressionDBSchemaField
+	Source       IssueSuppressionDBSchemaField
+	Reason       IssueSuppressionDBSchemaField
+	AuthorUserID IssueSuppressionDBSchemaField
+}{
+
+	ID:           IssueSuppressionDBSchemaField("id"),
+	CreatedAt:    IssueSuppressionDBSchemaField("created_at"),
+	UpdatedAt:    IssueSuppressionDBSchemaField("updated_at"),
+	DeletedAt:    IssueSuppressionDBSchemaField("deleted_at"),
+	RepoID:       IssueSuppressionDBSchemaField("repo_id"),
+	Fingerprint:  IssueSuppressionDBSchemaField("fingerprint"),
+	Source:       IssueSuppressionDBSchemaField("source"),
+	Reason:       IssueSuppressionDBSchemaField("reason"),
+	AuthorUserID: IssueSuppressionDBSchemaField("author_user_id"),
+}
+
+// Update updates IssueSuppression fields by primary key
+// nolint: dupl
+func (o *IssueSuppression) Update(db *gorm.DB, fields ...IssueSuppressionDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":             o.ID,
+		"created_at":     o.CreatedAt,
+		"updated_at":     o.UpdatedAt,
+		"deleted_at":     o.DeletedAt,
+		"repo_id":        o.RepoID,
+		"fingerprint":    o.Fingerprint,
+		"source":         o.Source,
+		"reason":         o.Reason,
+		"author_user_id": o.AuthorUserID,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update IssueSuppression %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// IssueSuppressionUpdater is an IssueSuppression updates manager
+type IssueSuppressionUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewIssueSuppressionUpdater creates new IssueSuppression updater
+// nolint: dupl
+func NewIssueSuppressionUpdater(db *gorm.DB) IssueSuppressionUpdater {
+	return IssueSuppressionUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&IssueSuppression{}),
+	}
+}
+
+// ===== END of IssueSuppression modifiers
+
+// ===== END of all query sets
@@ -0,0 +1,599 @@
+// Code generated by go-queryset. DO NOT EDIT.
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// ===== BEGIN of all query sets
+
+// ===== BEGIN of query set NotificationPreferenceQuerySet
+
+// NotificationPreferenceQuerySet is an queryset type for NotificationPreference
+type NotificationPreferenceQuerySet struct {
+	db *gorm.DB
+}
+
+// NewNotificationPreferenceQuerySet constructs new NotificationPreferenceQuerySet
+func NewNotificationPreferenceQuerySet(db *gorm.DB) NotificationPreferenceQuerySet {
+	return NotificationPreferenceQuerySet{
+		db: db.Model(&NotificationPreference{}),
+	}
+}
+
+func (qs NotificationPreferenceQuerySet) w(db *gorm.DB) NotificationPreferenceQuerySet {
+	return NewNotificationPreferenceQuerySet(db)
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) All(ret *[]NotificationPreference) error {
+	return qs.db.Find(ret).Error
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) Count() (int, error) {
+	var count int
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *NotificationPreference) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) CreatedAtEq(createdAt time.Time) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("created_at = ?", createdAt))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) CreatedAtGt(createdAt time.Time) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("created_at > ?", createdAt))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) CreatedAtGte(createdAt time.Time) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", createdAt))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) CreatedAtLt(createdAt time.Time) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("created_at < ?", createdAt))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) CreatedAtLte(createdAt time.Time) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("created_at <= ?", createdAt))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) CreatedAtNe(createdAt time.Time) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("created_at != ?", createdAt))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *NotificationPreference) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) Delete() error {
+	return qs.db.Delete(NotificationPreference{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(NotificationPreference{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(NotificationPreference{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) DeletedAtEq(deletedAt time.Time) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("deleted_at = ?", deletedAt))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) DeletedAtGt(deletedAt time.Time) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("deleted_at > ?", deletedAt))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) DeletedAtGte(deletedAt time.Time) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ?", deletedAt))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) DeletedAtIsNotNull() NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) DeletedAtIsNull() NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NULL"))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) DeletedAtLt(deletedAt time.Time) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("deleted_at < ?", deletedAt))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) DeletedAtLte(deletedAt time.Time) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("deleted_at <= ?", deletedAt))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) DeletedAtNe(deletedAt time.Time) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("deleted_at != ?", deletedAt))
+}
+
+// EnabledEq is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) EnabledEq(enabled bool) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("enabled = ?", enabled))
+}
+
+// EnabledIn is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) EnabledIn(enabled ...bool) NotificationPreferenceQuerySet {
+	if len(enabled) == 0 {
+		qs.db.AddError(errors.New("must at least pass one enabled in EnabledIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("enabled IN (?)", enabled))
+}
+
+// EnabledNe is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) EnabledNe(enabled bool) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("enabled != ?", enabled))
+}
+
+// EnabledNotIn is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) EnabledNotIn(enabled ...bool) NotificationPreferenceQuerySet {
+	if len(enabled) == 0 {
+		qs.db.AddError(errors.New("must at least pass one enabled in EnabledNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("enabled NOT IN (?)", enabled))
+}
+
+// EventEq is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) EventEq(event NotificationEvent) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("event = ?", event))
+}
+
+// EventIn is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) EventIn(event ...NotificationEvent) NotificationPreferenceQuerySet {
+	if len(event) == 0 {
+		qs.db.AddError(errors.New("must at least pass one event in EventIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("event IN (?)", event))
+}
+
+// EventNe is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) EventNe(event NotificationEvent) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("event != ?", event))
+}
+
+// EventNotIn is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) EventNotIn(event ...NotificationEvent) NotificationPreferenceQuerySet {
+	if len(event) == 0 {
+		qs.db.AddError(errors.New("must at least pass one event in EventNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("event NOT IN (?)", event))
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) GetUpdater() NotificationPreferenceUpdater {
+	return NewNotificationPreferenceUpdater(qs.db)
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) IDEq(ID uint) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("id = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) IDGt(ID uint) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("id > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) IDGte(ID uint) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("id >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) IDIn(ID ...uint) NotificationPreferenceQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id IN (?)", ID))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) IDLt(ID uint) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("id < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) IDLte(ID uint) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("id <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) IDNe(ID uint) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("id != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) IDNotIn(ID ...uint) NotificationPreferenceQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) Limit(limit int) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) Offset(offset int) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs NotificationPreferenceQuerySet) One(ret *NotificationPreference) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) OrderAscByCreatedAt() NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Order("created_at ASC"))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) OrderAscByDeletedAt() NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Order("deleted_at ASC"))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) OrderAscByID() NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Order("id ASC"))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) OrderAscByUpdatedAt() NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Order("updated_at ASC"))
+}
+
+// OrderAscByUserID is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) OrderAscByUserID() NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Order("user_id ASC"))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) OrderDescByCreatedAt() NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Order("created_at DESC"))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) OrderDescByDeletedAt() NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Order("deleted_at DESC"))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) OrderDescByID() NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Order("id DESC"))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) OrderDescByUpdatedAt() NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Order("updated_at DESC"))
+}
+
+// OrderDescByUserID is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) OrderDescByUserID() NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Order("user_id DESC"))
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u NotificationPreferenceUpdater) SetCreatedAt(createdAt time.Time) NotificationPreferenceUpdater {
+	u.fields[string(NotificationPreferenceDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u NotificationPreferenceUpdater) SetDeletedAt(deletedAt *time.Time) NotificationPreferenceUpdater {
+	u.fields[string(NotificationPreferenceDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetEnabled is an autogenerated method
+// nolint: dupl
+func (u NotificationPreferenceUpdater) SetEnabled(enabled bool) NotificationPreferenceUpdater {
+	u.fields[string(NotificationPreferenceDBSchema.Enabled)] = enabled
+	return u
+}
+
+// SetEvent is an autogenerated method
+// nolint: dupl
+func (u NotificationPreferenceUpdater) SetEvent(event NotificationEvent) NotificationPreferenceUpdater {
+	u.fields[string(NotificationPreferenceDBSchema.Event)] = event
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u NotificationPreferenceUpdater) SetID(ID uint) NotificationPreferenceUpdater {
+	u.fields[string(NotificationPreferenceDBSchema.ID)] = ID
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u NotificationPreferenceUpdater) SetUpdatedAt(updatedAt time.Time) NotificationPreferenceUpdater {
+	u.fields[string(NotificationPreferenceDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// SetUserID is an autogenerated method
+// nolint: dupl
+func (u NotificationPreferenceUpdater) SetUserID(userID uint) NotificationPreferenceUpdater {
+	u.fields[string(NotificationPreferenceDBSchema.UserID)] = userID
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u NotificationPreferenceUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u NotificationPreferenceUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) UpdatedAtEq(updatedAt time.Time) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("updated_at = ?", updatedAt))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) UpdatedAtGt(updatedAt time.Time) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("updated_at > ?", updatedAt))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) UpdatedAtGte(updatedAt time.Time) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", updatedAt))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) UpdatedAtLt(updatedAt time.Time) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("updated_at < ?", updatedAt))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) UpdatedAtLte(updatedAt time.Time) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("updated_at <= ?", updatedAt))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) UpdatedAtNe(updatedAt time.Time) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("updated_at != ?", updatedAt))
+}
+
+// UserIDEq is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) UserIDEq(userID uint) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("user_id = ?", userID))
+}
+
+// UserIDGt is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) UserIDGt(userID uint) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("user_id > ?", userID))
+}
+
+// UserIDGte is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) UserIDGte(userID uint) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("user_id >= ?", userID))
+}
+
+// UserIDIn is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) UserIDIn(userID ...uint) NotificationPreferenceQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_id IN (?)", userID))
+}
+
+// UserIDLt is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) UserIDLt(userID uint) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("user_id < ?", userID))
+}
+
+// UserIDLte is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) UserIDLte(userID uint) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("user_id <= ?", userID))
+}
+
+// UserIDNe is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) UserIDNe(userID uint) NotificationPreferenceQuerySet {
+	return qs.w(qs.db.Where("user_id != ?", userID))
+}
+
+// UserIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs NotificationPreferenceQuerySet) UserIDNotIn(userID ...uint) NotificationPreferenceQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_id NOT IN (?)", userID))
+}
+
+// ===== END of query set NotificationPreferenceQuerySet
+
+// ===== BEGIN of NotificationPreference modifiers
+
+// NotificationPreferenceDBSchemaField describes database schema field. It requires for method 'Update'
+type NotificationPreferenceDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f NotificationPreferenceDBSchemaField) String() string {
+	return string(f)
+}
+
+// NotificationPreferenceDBSchema stores db field names of NotificationPreference
+var NotificationPreferenceDBSchema = struct {
+	ID        NotificationPreferenceDBSchemaField
+	CreatedAt NotificationPreferenceDBSchemaField
+	UpdatedAt NotificationPreferenceDBSchemaField
+	DeletedAt NotificationPreferenceDBSchemaField
+	UserID    NotificationPreferenceDBSchemaField
+	Event     NotificationPreferenceDBSchemaField
+	Enabled   NotificationPreferenceDBSchemaField
+}{
+
+	ID:        NotificationPreferenceDBSchemaField("id"),
+	CreatedAt: NotificationPreferenceDBSchemaField("created_at"),
+	UpdatedAt: NotificationPreferenceDBSchemaField("updated_at"),
+	DeletedAt: NotificationPreferenceDBSchemaField("deleted_at"),
+	UserID:    NotificationPreferenceDBSchemaField("user_id"),
+	Event:     NotificationPreferenceDBSchemaField("event"),
+	Enabled:   NotificationPreferenceDBSchemaField("enabled"),
+}
+
+// Update updates NotificationPreference fields by primary key
+// nolint: dupl
+func (o *NotificationPreference) Update(db *gorm.DB, fields ...NotificationPreferenceDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":         o.ID,
+		"created_at": o.CreatedAt,
+		"updated_at": o.UpdatedAt,
+		"deleted_at": o.DeletedAt,
+		"user_id":    o.UserID,
+		"event":      o.Event,
+		"enabled":    o.Enabled,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update NotificationPreference %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// NotificationPreferenceUpdater is an NotificationPreference updates manager
+type NotificationPreferenceUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewNotificationPreferenceUpdater creates new NotificationPreference updater
+// nolint: dupl
+func NewNotificationPreferenceUpdater(db *gorm.DB) NotificationPreferenceUpdater {
+	return NotificationPreferenceUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&NotificationPreference{}),
+	}
+}
+
+// ===== END of NotificationPreference modifiers
+
+// ===== END of all query sets
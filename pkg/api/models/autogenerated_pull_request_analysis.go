@@ -285,6 +285,38 @@ func (qs PullRequestAnalysisQuerySet) IDNotIn(ID ...uint) PullRequestAnalysisQue
 	return qs.w(qs.db.Where("id NOT IN (?)", ID))
 }
 
+// IssuesStoredEq is an autogenerated method
+// nolint: dupl
+func (qs PullRequestAnalysisQuerySet) IssuesStoredEq(issuesStored bool) PullRequestAnalysisQuerySet {
+	return qs.w(qs.db.Where("issues_stored = ?", issuesStored))
+}
+
+// IssuesStoredIn is an autogenerated method
+// nolint: dupl
+func (qs PullRequestAnalysisQuerySet) IssuesStoredIn(issuesStored ...bool) PullRequestAnalysisQuerySet {
+	if len(issuesStored) == 0 {
+		qs.db.AddError(errors.New("must at least pass one issuesStored in IssuesStoredIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("issues_stored IN (?)", issuesStored))
+}
+
+// IssuesStoredNe is an autogenerated method
+// nolint: dupl
+func (qs PullRequestAnalysisQuerySet) IssuesStoredNe(issuesStored bool) PullRequestAnalysisQuerySet {
+	return qs.w(qs.db.Where("issues_stored != ?", issuesStored))
+}
+
+// IssuesStoredNotIn is an autogenerated method
+// nolint: dupl
+func (qs PullRequestAnalysisQuerySet) IssuesStoredNotIn(issuesStored ...bool) PullRequestAnalysisQuerySet {
+	if len(issuesStored) == 0 {
+		qs.db.AddError(errors.New("must at least pass one issuesStored in IssuesStoredNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("issues_stored NOT IN (?)", issuesStored))
+}
+
 // Limit is an autogenerated method
 // nolint: dupl
 func (qs PullRequestAnalysisQuerySet) Limit(limit int) PullRequestAnalysisQuerySet {
@@ -555,6 +587,38 @@ func (qs PullRequestAnalysisQuerySet) ReportedIssuesCountNotIn(reportedIssuesCou
 	return qs.w(qs.db.Where("reported_issues_count NOT IN (?)", reportedIssuesCount))
 }
 
+// ResultArchiveKeyEq is an autogenerated method
+// nolint: dupl
+func (qs PullRequestAnalysisQuerySet) ResultArchiveKeyEq(resultArchiveKey string) PullRequestAnalysisQuerySet {
+	return qs.w(qs.db.Where("result_archive_key = ?", resultArchiveKey))
+}
+
+// ResultArchiveKeyIn is an autogenerated method
+// nolint: dupl
+func (qs PullRequestAnalysisQuerySet) ResultArchiveKeyIn(resultArchiveKey ...string) PullRequestAnalysisQuerySet {
+	if len(resultArchiveKey) == 0 {
+		qs.db.AddError(errors.New("must at least pass one resultArchiveKey in ResultArchiveKeyIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("result_archive_key IN (?)", resultArchiveKey))
+}
+
+// ResultArchiveKeyNe is an autogenerated method
+// nolint: dupl
+func (qs PullRequestAnalysisQuerySet) ResultArchiveKeyNe(resultArchiveKey string) PullRequestAnalysisQuerySet {
+	return qs.w(qs.db.Where("result_archive_key != ?", resultArchiveKey))
+}
+
+// ResultArchiveKeyNotIn is an autogenerated method
+// nolint: dupl
+func (qs PullRequestAnalysisQuerySet) ResultArchiveKeyNotIn(resultArchiveKey ...string) PullRequestAnalysisQuerySet {
+	if len(resultArchiveKey) == 0 {
+		qs.db.AddError(errors.New("must at least pass one resultArchiveKey in ResultArchiveKeyNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("result_archive_key NOT IN (?)", resultArchiveKey))
+}
+
 // ResultJSONEq is an autogenerated method
 // nolint: dupl
 func (qs PullRequestAnalysisQuerySet) ResultJSONEq(resultJSON []byte) PullRequestAnalysisQuerySet {
@@ -622,6 +686,13 @@ func (u PullRequestAnalysisUpdater) SetID(ID uint) PullRequestAnalysisUpdater {
 	return u
 }
 
+// SetIssuesStored is an autogenerated method
+// nolint: dupl
+func (u PullRequestAnalysisUpdater) SetIssuesStored(issuesStored bool) PullRequestAnalysisUpdater {
+	u.fields[string(PullRequestAnalysisDBSchema.IssuesStored)] = issuesStored
+	return u
+}
+
 // SetPullRequestNumber is an autogenerated method
 // nolint: dupl
 func (u PullRequestAnalysisUpdater) SetPullRequestNumber(pullRequestNumber int) PullRequestAnalysisUpdater {
@@ -643,6 +714,13 @@ func (u PullRequestAnalysisUpdater) SetReportedIssuesCount(reportedIssuesCount i
 	return u
 }
 
+// SetResultArchiveKey is an autogenerated method
+// nolint: dupl
+func (u PullRequestAnalysisUpdater) SetResultArchiveKey(resultArchiveKey string) PullRequestAnalysisUpdater {
+	u.fields[string(PullRequestAnalysisDBSchema.ResultArchiveKey)] = resultArchiveKey
+	return u
+}
+
 // SetResultJSON is an autogenerated method
 // nolint: dupl
 func (u PullRequestAnalysisUpdater) SetResultJSON(resultJSON []byte) PullRequestAnalysisUpdater {
@@ -657,6 +735,13 @@ func (u PullRequestAnalysisUpdater) SetStatus(status string) PullRequestAnalysis
 	return u
 }
 
+// SetTimingsJSON is an autogenerated method
+// nolint: dupl
+func (u PullRequestAnalysisUpdater) SetTimingsJSON(timingsJSON []byte) PullRequestAnalysisUpdater {
+	u.fields[string(PullRequestAnalysisDBSchema.TimingsJSON)] = timingsJSON
+	return u
+}
+
 // SetUpdatedAt is an autogenerated method
 // nolint: dupl
 func (u PullRequestAnalysisUpdater) SetUpdatedAt(updatedAt time.Time) PullRequestAnalysisUpdater {
@@ -696,6 +781,38 @@ func (qs PullRequestAnalysisQuerySet) StatusNotIn(status ...string) PullRequestA
 	return qs.w(qs.db.Where("status NOT IN (?)", status))
 }
 
+// TimingsJSONEq is an autogenerated method
+// nolint: dupl
+func (qs PullRequestAnalysisQuerySet) TimingsJSONEq(timingsJSON []byte) PullRequestAnalysisQuerySet {
+	return qs.w(qs.db.Where("timings_json = ?", timingsJSON))
+}
+
+// TimingsJSONIn is an autogenerated method
+// nolint: dupl
+func (qs PullRequestAnalysisQuerySet) TimingsJSONIn(timingsJSON ...[]byte) PullRequestAnalysisQuerySet {
+	if len(timingsJSON) == 0 {
+		qs.db.AddError(errors.New("must at least pass one timingsJSON in TimingsJSONIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("timings_json IN (?)", timingsJSON))
+}
+
+// TimingsJSONNe is an autogenerated method
+// nolint: dupl
+func (qs PullRequestAnalysisQuerySet) TimingsJSONNe(timingsJSON []byte) PullRequestAnalysisQuerySet {
+	return qs.w(qs.db.Where("timings_json != ?", timingsJSON))
+}
+
+// TimingsJSONNotIn is an autogenerated method
+// nolint: dupl
+func (qs PullRequestAnalysisQuerySet) TimingsJSONNotIn(timingsJSON ...[]byte) PullRequestAnalysisQuerySet {
+	if len(timingsJSON) == 0 {
+		qs.db.AddError(errors.New("must at least pass one timingsJSON in TimingsJSONNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("timings_json NOT IN (?)", timingsJSON))
+}
+
 // Update is an autogenerated method
 // nolint: dupl
 func (u PullRequestAnalysisUpdater) Update() error {
@@ -771,6 +888,9 @@ var PullRequestAnalysisDBSchema = struct {
 	Status              PullRequestAnalysisDBSchemaField
 	ReportedIssuesCount PullRequestAnalysisDBSchemaField
 	ResultJSON          PullRequestAnalysisDBSchemaField
+	TimingsJSON         PullRequestAnalysisDBSchemaField
+	IssuesStored        PullRequestAnalysisDBSchemaField
+	ResultArchiveKey    PullRequestAnalysisDBSchemaField
 }{
 
 	ID:                  PullRequestAnalysisDBSchemaField("id"),
@@ -784,6 +904,9 @@ var PullRequestAnalysisDBSchema = struct {
 	Status:              PullRequestAnalysisDBSchemaField("status"),
 	ReportedIssuesCount: PullRequestAnalysisDBSchemaField("reported_issues_count"),
 	ResultJSON:          PullRequestAnalysisDBSchemaField("result_json"),
+	TimingsJSON:         PullRequestAnalysisDBSchemaField("timings_json"),
+	IssuesStored:        PullRequestAnalysisDBSchemaField("issues_stored"),
+	ResultArchiveKey:    PullRequestAnalysisDBSchemaField("result_archive_key"),
 }
 
 // Update updates PullRequestAnalysis fields by primary key
@@ -801,6 +924,9 @@ func (o *PullRequestAnalysis) Update(db *gorm.DB, fields ...PullRequestAnalysisD
 		"status":                o.Status,
 		"reported_issues_count": o.ReportedIssuesCount,
 		"result_json":           o.ResultJSON,
+		"timings_json":          o.TimingsJSON,
+		"issues_stored":         o.IssuesStored,
+		"result_archive_key":    o.ResultArchiveKey,
 	}
 	u := map[string]interface{}{}
 	for _, f := range fields {
@@ -461,6 +461,12 @@ func (qs RepoQuerySet) OrderAscByProviderID() RepoQuerySet {
 	return qs.w(qs.db.Order("provider_id ASC"))
 }
 
+// OrderAscByProviderInstallationID is an autogenerated method
+// nolint: dupl
+func (qs RepoQuerySet) OrderAscByProviderInstallationID() RepoQuerySet {
+	return qs.w(qs.db.Order("provider_installation_id ASC"))
+}
+
 // OrderAscByStargazersCount is an autogenerated method
 // nolint: dupl
 func (qs RepoQuerySet) OrderAscByStargazersCount() RepoQuerySet {
@@ -509,6 +515,12 @@ func (qs RepoQuerySet) OrderDescByProviderID() RepoQuerySet {
 	return qs.w(qs.db.Order("provider_id DESC"))
 }
 
+// OrderDescByProviderInstallationID is an autogenerated method
+// nolint: dupl
+func (qs RepoQuerySet) OrderDescByProviderInstallationID() RepoQuerySet {
+	return qs.w(qs.db.Order("provider_installation_id DESC"))
+}
+
 // OrderDescByStargazersCount is an autogenerated method
 // nolint: dupl
 func (qs RepoQuerySet) OrderDescByStargazersCount() RepoQuerySet {
@@ -655,6 +667,62 @@ func (qs RepoQuerySet) ProviderIn(provider ...string) RepoQuerySet {
 	return qs.w(qs.db.Where("provider IN (?)", provider))
 }
 
+// ProviderInstallationIDEq is an autogenerated method
+// nolint: dupl
+func (qs RepoQuerySet) ProviderInstallationIDEq(providerInstallationID int) RepoQuerySet {
+	return qs.w(qs.db.Where("provider_installation_id = ?", providerInstallationID))
+}
+
+// ProviderInstallationIDGt is an autogenerated method
+// nolint: dupl
+func (qs RepoQuerySet) ProviderInstallationIDGt(providerInstallationID int) RepoQuerySet {
+	return qs.w(qs.db.Where("provider_installation_id > ?", providerInstallationID))
+}
+
+// ProviderInstallationIDGte is an autogenerated method
+// nolint: dupl
+func (qs RepoQuerySet) ProviderInstallationIDGte(providerInstallationID int) RepoQuerySet {
+	return qs.w(qs.db.Where("provider_installation_id >= ?", providerInstallationID))
+}
+
+// ProviderInstallationIDIn is an autogenerated method
+// nolint: dupl
+func (qs RepoQuerySet) ProviderInstallationIDIn(providerInstallationID ...int) RepoQuerySet {
+	if len(providerInstallationID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one providerInstallationID in ProviderInstallationIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("provider_installation_id IN (?)", providerInstallationID))
+}
+
+// ProviderInstallationIDLt is an autogenerated method
+// nolint: dupl
+func (qs RepoQuerySet) ProviderInstallationIDLt(providerInstallationID int) RepoQuerySet {
+	return qs.w(qs.db.Where("provider_installation_id < ?", providerInstallationID))
+}
+
+// ProviderInstallationIDLte is an autogenerated method
+// nolint: dupl
+func (qs RepoQuerySet) ProviderInstallationIDLte(providerInstallationID int) RepoQuerySet {
+	return qs.w(qs.db.Where("provider_installation_id <= ?", providerInstallationID))
+}
+
+// ProviderInstallationIDNe is an autogenerated method
+// nolint: dupl
+func (qs RepoQuerySet) ProviderInstallationIDNe(providerInstallationID int) RepoQuerySet {
+	return qs.w(qs.db.Where("provider_installation_id != ?", providerInstallationID))
+}
+
+// ProviderInstallationIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs RepoQuerySet) ProviderInstallationIDNotIn(providerInstallationID ...int) RepoQuerySet {
+	if len(providerInstallationID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one providerInstallationID in ProviderInstallationIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("provider_installation_id NOT IN (?)", providerInstallationID))
+}
+
 // ProviderNe is an autogenerated method
 // nolint: dupl
 func (qs RepoQuerySet) ProviderNe(provider string) RepoQuerySet {
@@ -755,6 +823,13 @@ func (u RepoUpdater) SetProviderID(providerID int) RepoUpdater {
 	return u
 }
 
+// SetProviderInstallationID is an autogenerated method
+// nolint: dupl
+func (u RepoUpdater) SetProviderInstallationID(providerInstallationID int) RepoUpdater {
+	u.fields[string(RepoDBSchema.ProviderInstallationID)] = providerInstallationID
+	return u
+}
+
 // SetStargazersCount is an autogenerated method
 // nolint: dupl
 func (u RepoUpdater) SetStargazersCount(stargazersCount int) RepoUpdater {
@@ -952,59 +1027,62 @@ func (f RepoDBSchemaField) String() string {
 
 // RepoDBSchema stores db field names of Repo
 var RepoDBSchema = struct {
-	ID               RepoDBSchemaField
-	CreatedAt        RepoDBSchemaField
-	UpdatedAt        RepoDBSchemaField
-	DeletedAt        RepoDBSchemaField
-	UserID           RepoDBSchemaField
-	FullName         RepoDBSchemaField
-	DisplayFullName  RepoDBSchemaField
-	HookID           RepoDBSchemaField
-	Provider         RepoDBSchemaField
-	ProviderHookID   RepoDBSchemaField
-	ProviderID       RepoDBSchemaField
-	CommitState      RepoDBSchemaField
-	StargazersCount  RepoDBSchemaField
-	IsPrivate        RepoDBSchemaField
-	CreateFailReason RepoDBSchemaField
+	ID                     RepoDBSchemaField
+	CreatedAt              RepoDBSchemaField
+	UpdatedAt              RepoDBSchemaField
+	DeletedAt              RepoDBSchemaField
+	UserID                 RepoDBSchemaField
+	FullName               RepoDBSchemaField
+	DisplayFullName        RepoDBSchemaField
+	HookID                 RepoDBSchemaField
+	Provider               RepoDBSchemaField
+	ProviderHookID         RepoDBSchemaField
+	ProviderID             RepoDBSchemaField
+	ProviderInstallationID RepoDBSchemaField
+	CommitState            RepoDBSchemaField
+	StargazersCount        RepoDBSchemaField
+	IsPrivate              RepoDBSchemaField
+	CreateFailReason       RepoDBSchemaField
 }{
 
-	ID:               RepoDBSchemaField("id"),
-	CreatedAt:        RepoDBSchemaField("created_at"),
-	UpdatedAt:        RepoDBSchemaField("updated_at"),
-	DeletedAt:        RepoDBSchemaField("deleted_at"),
-	UserID:           RepoDBSchemaField("user_id"),
-	FullName:         RepoDBSchemaField("name"),
-	DisplayFullName:  RepoDBSchemaField("display_name"),
-	HookID:           RepoDBSchemaField("hook_id"),
-	Provider:         RepoDBSchemaField("provider"),
-	ProviderHookID:   RepoDBSchemaField("provider_hook_id"),
-	ProviderID:       RepoDBSchemaField("provider_id"),
-	CommitState:      RepoDBSchemaField("commit_state"),
-	StargazersCount:  RepoDBSchemaField("stargazers_count"),
-	IsPrivate:        RepoDBSchemaField("is_private"),
-	CreateFailReason: RepoDBSchemaField("create_fail_reason"),
+	ID:                     RepoDBSchemaField("id"),
+	CreatedAt:              RepoDBSchemaField("created_at"),
+	UpdatedAt:              RepoDBSchemaField("updated_at"),
+	DeletedAt:              RepoDBSchemaField("deleted_at"),
+	UserID:                 RepoDBSchemaField("user_id"),
+	FullName:               RepoDBSchemaField("name"),
+	DisplayFullName:        RepoDBSchemaField("display_name"),
+	HookID:                 RepoDBSchemaField("hook_id"),
+	Provider:               RepoDBSchemaField("provider"),
+	ProviderHookID:         RepoDBSchemaField("provider_hook_id"),
+	ProviderID:             RepoDBSchemaField("provider_id"),
+	ProviderInstallationID: RepoDBSchemaField("provider_installation_id"),
+	CommitState:            RepoDBSchemaField("commit_state"),
+	StargazersCount:        RepoDBSchemaField("stargazers_count"),
+	IsPrivate:              RepoDBSchemaField("is_private"),
+	CreateFailReason:       RepoDBSchemaField("create_fail_reason"),
 }
 
 // Update updates Repo fields by primary key
 // nolint: dupl
 func (o *Repo) Update(db *gorm.DB, fields ...RepoDBSchemaField) error {
 	dbNameToFieldName := map[string]interface{}{
-		"id":                 o.ID,
-		"created_at":         o.CreatedAt,
-		"updated_at":         o.UpdatedAt,
-		"deleted_at":         o.DeletedAt,
-		"user_id":            o.UserID,
-		"name":               o.FullName,
-		"display_name":       o.DisplayFullName,
-		"hook_id":            o.HookID,
-		"provider":           o.Provider,
-		"provider_hook_id":   o.ProviderHookID,
-		"provider_id":        o.ProviderID,
-		"commit_state":       o.CommitState,
-		"stargazers_count":   o.StargazersCount,
-		"is_private":         o.IsPrivate,
-		"create_fail_reason": o.CreateFailReason,
+		"id":                       o.ID,
+		"created_at":               o.CreatedAt,
+		"updated_at":               o.UpdatedAt,
+		"deleted_at":               o.DeletedAt,
+		"user_id":                  o.UserID,
+		"name":                     o.FullName,
+		"display_name":             o.DisplayFullName,
+		"hook_id":                  o.HookID,
+		"provider":                 o.Provider,
+		"provider_hook_id":         o.ProviderHookID,
+		"provider_id":              o.ProviderID,
+		"provider_installation_id": o.ProviderInstallationID,
+		"commit_state":             o.CommitState,
+		"stargazers_count":         o.StargazersCount,
+		"is_private":               o.IsPrivate,
+		"create_fail_reason":       o.CreateFailReason,
 	}
 	u := map[string]interface{}{}
 	for _, f := range fields {
@@ -208,14 +208,14 @@ func (qs RepoAnalysisQuerySet) CreatedAtNe(createdAt time.Time) RepoAnalysisQuer
 
 // Delete is an autogenerated method
 // nolint: dupl
-func (qs RepoAnalysisQuerySet) Delete() error {
-	return qs.db.Delete(RepoAnalysis{}).Error
+func (o *RepoAnalysis) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
 }
 
 // Delete is an autogenerated method
 // nolint: dupl
-func (o *RepoAnalysis) Delete(db *gorm.DB) error {
-	return db.Delete(o).Error
+func (qs RepoAnalysisQuerySet) Delete() error {
+	return qs.db.Delete(RepoAnalysis{}).Error
 }
 
 // DeleteNum is an autogenerated method
@@ -280,6 +280,62 @@ func (qs RepoAnalysisQuerySet) DeletedAtNe(deletedAt time.Time) RepoAnalysisQuer
 	return qs.w(qs.db.Where("deleted_at != ?", deletedAt))
 }
 
+// FixedIssuesCountEq is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) FixedIssuesCountEq(fixedIssuesCount int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("fixed_issues_count = ?", fixedIssuesCount))
+}
+
+// FixedIssuesCountGt is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) FixedIssuesCountGt(fixedIssuesCount int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("fixed_issues_count > ?", fixedIssuesCount))
+}
+
+// FixedIssuesCountGte is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) FixedIssuesCountGte(fixedIssuesCount int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("fixed_issues_count >= ?", fixedIssuesCount))
+}
+
+// FixedIssuesCountIn is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) FixedIssuesCountIn(fixedIssuesCount ...int) RepoAnalysisQuerySet {
+	if len(fixedIssuesCount) == 0 {
+		qs.db.AddError(errors.New("must at least pass one fixedIssuesCount in FixedIssuesCountIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("fixed_issues_count IN (?)", fixedIssuesCount))
+}
+
+// FixedIssuesCountLt is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) FixedIssuesCountLt(fixedIssuesCount int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("fixed_issues_count < ?", fixedIssuesCount))
+}
+
+// FixedIssuesCountLte is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) FixedIssuesCountLte(fixedIssuesCount int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("fixed_issues_count <= ?", fixedIssuesCount))
+}
+
+// FixedIssuesCountNe is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) FixedIssuesCountNe(fixedIssuesCount int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("fixed_issues_count != ?", fixedIssuesCount))
+}
+
+// FixedIssuesCountNotIn is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) FixedIssuesCountNotIn(fixedIssuesCount ...int) RepoAnalysisQuerySet {
+	if len(fixedIssuesCount) == 0 {
+		qs.db.AddError(errors.New("must at least pass one fixedIssuesCount in FixedIssuesCountNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("fixed_issues_count NOT IN (?)", fixedIssuesCount))
+}
+
 // GetUpdater is an autogenerated method
 // nolint: dupl
 func (qs RepoAnalysisQuerySet) GetUpdater() RepoAnalysisUpdater {
@@ -342,6 +398,38 @@ func (qs RepoAnalysisQuerySet) IDNotIn(ID ...uint) RepoAnalysisQuerySet {
 	return qs.w(qs.db.Where("id NOT IN (?)", ID))
 }
 
+// IssuesStoredEq is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) IssuesStoredEq(issuesStored bool) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("issues_stored = ?", issuesStored))
+}
+
+// IssuesStoredIn is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) IssuesStoredIn(issuesStored ...bool) RepoAnalysisQuerySet {
+	if len(issuesStored) == 0 {
+		qs.db.AddError(errors.New("must at least pass one issuesStored in IssuesStoredIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("issues_stored IN (?)", issuesStored))
+}
+
+// IssuesStoredNe is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) IssuesStoredNe(issuesStored bool) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("issues_stored != ?", issuesStored))
+}
+
+// IssuesStoredNotIn is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) IssuesStoredNotIn(issuesStored ...bool) RepoAnalysisQuerySet {
+	if len(issuesStored) == 0 {
+		qs.db.AddError(errors.New("must at least pass one issuesStored in IssuesStoredNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("issues_stored NOT IN (?)", issuesStored))
+}
+
 // Limit is an autogenerated method
 // nolint: dupl
 func (qs RepoAnalysisQuerySet) Limit(limit int) RepoAnalysisQuerySet {
@@ -380,6 +468,118 @@ func (qs RepoAnalysisQuerySet) LintersVersionNotIn(lintersVersion ...string) Rep
 	return qs.w(qs.db.Where("linters_version NOT IN (?)", lintersVersion))
 }
 
+// MaxScoreEq is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) MaxScoreEq(maxScore int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("max_score = ?", maxScore))
+}
+
+// MaxScoreGt is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) MaxScoreGt(maxScore int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("max_score > ?", maxScore))
+}
+
+// MaxScoreGte is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) MaxScoreGte(maxScore int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("max_score >= ?", maxScore))
+}
+
+// MaxScoreIn is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) MaxScoreIn(maxScore ...int) RepoAnalysisQuerySet {
+	if len(maxScore) == 0 {
+		qs.db.AddError(errors.New("must at least pass one maxScore in MaxScoreIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("max_score IN (?)", maxScore))
+}
+
+// MaxScoreLt is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) MaxScoreLt(maxScore int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("max_score < ?", maxScore))
+}
+
+// MaxScoreLte is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) MaxScoreLte(maxScore int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("max_score <= ?", maxScore))
+}
+
+// MaxScoreNe is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) MaxScoreNe(maxScore int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("max_score != ?", maxScore))
+}
+
+// MaxScoreNotIn is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) MaxScoreNotIn(maxScore ...int) RepoAnalysisQuerySet {
+	if len(maxScore) == 0 {
+		qs.db.AddError(errors.New("must at least pass one maxScore in MaxScoreNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("max_score NOT IN (?)", maxScore))
+}
+
+// NewIssuesCountEq is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) NewIssuesCountEq(newIssuesCount int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("new_issues_count = ?", newIssuesCount))
+}
+
+// NewIssuesCountGt is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) NewIssuesCountGt(newIssuesCount int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("new_issues_count > ?", newIssuesCount))
+}
+
+// NewIssuesCountGte is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) NewIssuesCountGte(newIssuesCount int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("new_issues_count >= ?", newIssuesCount))
+}
+
+// NewIssuesCountIn is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) NewIssuesCountIn(newIssuesCount ...int) RepoAnalysisQuerySet {
+	if len(newIssuesCount) == 0 {
+		qs.db.AddError(errors.New("must at least pass one newIssuesCount in NewIssuesCountIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("new_issues_count IN (?)", newIssuesCount))
+}
+
+// NewIssuesCountLt is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) NewIssuesCountLt(newIssuesCount int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("new_issues_count < ?", newIssuesCount))
+}
+
+// NewIssuesCountLte is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) NewIssuesCountLte(newIssuesCount int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("new_issues_count <= ?", newIssuesCount))
+}
+
+// NewIssuesCountNe is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) NewIssuesCountNe(newIssuesCount int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("new_issues_count != ?", newIssuesCount))
+}
+
+// NewIssuesCountNotIn is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) NewIssuesCountNotIn(newIssuesCount ...int) RepoAnalysisQuerySet {
+	if len(newIssuesCount) == 0 {
+		qs.db.AddError(errors.New("must at least pass one newIssuesCount in NewIssuesCountNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("new_issues_count NOT IN (?)", newIssuesCount))
+}
+
 // Offset is an autogenerated method
 // nolint: dupl
 func (qs RepoAnalysisQuerySet) Offset(offset int) RepoAnalysisQuerySet {
@@ -410,18 +610,54 @@ func (qs RepoAnalysisQuerySet) OrderAscByDeletedAt() RepoAnalysisQuerySet {
 	return qs.w(qs.db.Order("deleted_at ASC"))
 }
 
+// OrderAscByFixedIssuesCount is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) OrderAscByFixedIssuesCount() RepoAnalysisQuerySet {
+	return qs.w(qs.db.Order("fixed_issues_count ASC"))
+}
+
 // OrderAscByID is an autogenerated method
 // nolint: dupl
 func (qs RepoAnalysisQuerySet) OrderAscByID() RepoAnalysisQuerySet {
 	return qs.w(qs.db.Order("id ASC"))
 }
 
+// OrderAscByMaxScore is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) OrderAscByMaxScore() RepoAnalysisQuerySet {
+	return qs.w(qs.db.Order("max_score ASC"))
+}
+
+// OrderAscByNewIssuesCount is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) OrderAscByNewIssuesCount() RepoAnalysisQuerySet {
+	return qs.w(qs.db.Order("new_issues_count ASC"))
+}
+
+// OrderAscByPreviousAnalysisID is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) OrderAscByPreviousAnalysisID() RepoAnalysisQuerySet {
+	return qs.w(qs.db.Order("previous_analysis_id ASC"))
+}
+
 // OrderAscByRepoAnalysisStatusID is an autogenerated method
 // nolint: dupl
 func (qs RepoAnalysisQuerySet) OrderAscByRepoAnalysisStatusID() RepoAnalysisQuerySet {
 	return qs.w(qs.db.Order("repo_analysis_status_id ASC"))
 }
 
+// OrderAscByScore is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) OrderAscByScore() RepoAnalysisQuerySet {
+	return qs.w(qs.db.Order("score ASC"))
+}
+
+// OrderAscByUnchangedIssuesCount is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) OrderAscByUnchangedIssuesCount() RepoAnalysisQuerySet {
+	return qs.w(qs.db.Order("unchanged_issues_count ASC"))
+}
+
 // OrderAscByUpdatedAt is an autogenerated method
 // nolint: dupl
 func (qs RepoAnalysisQuerySet) OrderAscByUpdatedAt() RepoAnalysisQuerySet {
@@ -446,18 +682,54 @@ func (qs RepoAnalysisQuerySet) OrderDescByDeletedAt() RepoAnalysisQuerySet {
 	return qs.w(qs.db.Order("deleted_at DESC"))
 }
 
+// OrderDescByFixedIssuesCount is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) OrderDescByFixedIssuesCount() RepoAnalysisQuerySet {
+	return qs.w(qs.db.Order("fixed_issues_count DESC"))
+}
+
 // OrderDescByID is an autogenerated method
 // nolint: dupl
 func (qs RepoAnalysisQuerySet) OrderDescByID() RepoAnalysisQuerySet {
 	return qs.w(qs.db.Order("id DESC"))
 }
 
+// OrderDescByMaxScore is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) OrderDescByMaxScore() RepoAnalysisQuerySet {
+	return qs.w(qs.db.Order("max_score DESC"))
+}
+
+// OrderDescByNewIssuesCount is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) OrderDescByNewIssuesCount() RepoAnalysisQuerySet {
+	return qs.w(qs.db.Order("new_issues_count DESC"))
+}
+
+// OrderDescByPreviousAnalysisID is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) OrderDescByPreviousAnalysisID() RepoAnalysisQuerySet {
+	return qs.w(qs.db.Order("previous_analysis_id DESC"))
+}
+
 // OrderDescByRepoAnalysisStatusID is an autogenerated method
 // nolint: dupl
 func (qs RepoAnalysisQuerySet) OrderDescByRepoAnalysisStatusID() RepoAnalysisQuerySet {
 	return qs.w(qs.db.Order("repo_analysis_status_id DESC"))
 }
 
+// OrderDescByScore is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) OrderDescByScore() RepoAnalysisQuerySet {
+	return qs.w(qs.db.Order("score DESC"))
+}
+
+// OrderDescByUnchangedIssuesCount is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) OrderDescByUnchangedIssuesCount() RepoAnalysisQuerySet {
+	return qs.w(qs.db.Order("unchanged_issues_count DESC"))
+}
+
 // OrderDescByUpdatedAt is an autogenerated method
 // nolint: dupl
 func (qs RepoAnalysisQuerySet) OrderDescByUpdatedAt() RepoAnalysisQuerySet {
@@ -470,6 +742,62 @@ func (qs RepoAnalysisQuerySet) PreloadRepoAnalysisStatus() RepoAnalysisQuerySet
 	return qs.w(qs.db.Preload("RepoAnalysisStatus"))
 }
 
+// PreviousAnalysisIDEq is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) PreviousAnalysisIDEq(previousAnalysisID uint) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("previous_analysis_id = ?", previousAnalysisID))
+}
+
+// PreviousAnalysisIDGt is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) PreviousAnalysisIDGt(previousAnalysisID uint) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("previous_analysis_id > ?", previousAnalysisID))
+}
+
+// PreviousAnalysisIDGte is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) PreviousAnalysisIDGte(previousAnalysisID uint) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("previous_analysis_id >= ?", previousAnalysisID))
+}
+
+// PreviousAnalysisIDIn is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) PreviousAnalysisIDIn(previousAnalysisID ...uint) RepoAnalysisQuerySet {
+	if len(previousAnalysisID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one previousAnalysisID in PreviousAnalysisIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("previous_analysis_id IN (?)", previousAnalysisID))
+}
+
+// PreviousAnalysisIDLt is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) PreviousAnalysisIDLt(previousAnalysisID uint) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("previous_analysis_id < ?", previousAnalysisID))
+}
+
+// PreviousAnalysisIDLte is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) PreviousAnalysisIDLte(previousAnalysisID uint) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("previous_analysis_id <= ?", previousAnalysisID))
+}
+
+// PreviousAnalysisIDNe is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) PreviousAnalysisIDNe(previousAnalysisID uint) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("previous_analysis_id != ?", previousAnalysisID))
+}
+
+// PreviousAnalysisIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) PreviousAnalysisIDNotIn(previousAnalysisID ...uint) RepoAnalysisQuerySet {
+	if len(previousAnalysisID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one previousAnalysisID in PreviousAnalysisIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("previous_analysis_id NOT IN (?)", previousAnalysisID))
+}
+
 // RepoAnalysisStatusIDEq is an autogenerated method
 // nolint: dupl
 func (qs RepoAnalysisQuerySet) RepoAnalysisStatusIDEq(repoAnalysisStatusID uint) RepoAnalysisQuerySet {
@@ -526,6 +854,38 @@ func (qs RepoAnalysisQuerySet) RepoAnalysisStatusIDNotIn(repoAnalysisStatusID ..
 	return qs.w(qs.db.Where("repo_analysis_status_id NOT IN (?)", repoAnalysisStatusID))
 }
 
+// ResultArchiveKeyEq is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) ResultArchiveKeyEq(resultArchiveKey string) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("result_archive_key = ?", resultArchiveKey))
+}
+
+// ResultArchiveKeyIn is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) ResultArchiveKeyIn(resultArchiveKey ...string) RepoAnalysisQuerySet {
+	if len(resultArchiveKey) == 0 {
+		qs.db.AddError(errors.New("must at least pass one resultArchiveKey in ResultArchiveKeyIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("result_archive_key IN (?)", resultArchiveKey))
+}
+
+// ResultArchiveKeyNe is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) ResultArchiveKeyNe(resultArchiveKey string) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("result_archive_key != ?", resultArchiveKey))
+}
+
+// ResultArchiveKeyNotIn is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) ResultArchiveKeyNotIn(resultArchiveKey ...string) RepoAnalysisQuerySet {
+	if len(resultArchiveKey) == 0 {
+		qs.db.AddError(errors.New("must at least pass one resultArchiveKey in ResultArchiveKeyNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("result_archive_key NOT IN (?)", resultArchiveKey))
+}
+
 // ResultJSONEq is an autogenerated method
 // nolint: dupl
 func (qs RepoAnalysisQuerySet) ResultJSONEq(resultJSON json.RawMessage) RepoAnalysisQuerySet {
@@ -558,6 +918,94 @@ func (qs RepoAnalysisQuerySet) ResultJSONNotIn(resultJSON ...json.RawMessage) Re
 	return qs.w(qs.db.Where("result_json NOT IN (?)", resultJSON))
 }
 
+// ScoreEq is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) ScoreEq(score int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("score = ?", score))
+}
+
+// ScoreGt is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) ScoreGt(score int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("score > ?", score))
+}
+
+// ScoreGte is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) ScoreGte(score int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("score >= ?", score))
+}
+
+// ScoreIn is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) ScoreIn(score ...int) RepoAnalysisQuerySet {
+	if len(score) == 0 {
+		qs.db.AddError(errors.New("must at least pass one score in ScoreIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("score IN (?)", score))
+}
+
+// ScoreLt is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) ScoreLt(score int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("score < ?", score))
+}
+
+// ScoreLte is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) ScoreLte(score int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("score <= ?", score))
+}
+
+// ScoreNe is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) ScoreNe(score int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("score != ?", score))
+}
+
+// ScoreNotIn is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) ScoreNotIn(score ...int) RepoAnalysisQuerySet {
+	if len(score) == 0 {
+		qs.db.AddError(errors.New("must at least pass one score in ScoreNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("score NOT IN (?)", score))
+}
+
+// ScoreRecommendationsEq is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) ScoreRecommendationsEq(scoreRecommendations json.RawMessage) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("score_recommendations = ?", scoreRecommendations))
+}
+
+// ScoreRecommendationsIn is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) ScoreRecommendationsIn(scoreRecommendations ...json.RawMessage) RepoAnalysisQuerySet {
+	if len(scoreRecommendations) == 0 {
+		qs.db.AddError(errors.New("must at least pass one scoreRecommendations in ScoreRecommendationsIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("score_recommendations IN (?)", scoreRecommendations))
+}
+
+// ScoreRecommendationsNe is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) ScoreRecommendationsNe(scoreRecommendations json.RawMessage) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("score_recommendations != ?", scoreRecommendations))
+}
+
+// ScoreRecommendationsNotIn is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) ScoreRecommendationsNotIn(scoreRecommendations ...json.RawMessage) RepoAnalysisQuerySet {
+	if len(scoreRecommendations) == 0 {
+		qs.db.AddError(errors.New("must at least pass one scoreRecommendations in ScoreRecommendationsNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("score_recommendations NOT IN (?)", scoreRecommendations))
+}
+
 // SetAnalysisGUID is an autogenerated method
 // nolint: dupl
 func (u RepoAnalysisUpdater) SetAnalysisGUID(analysisGUID string) RepoAnalysisUpdater {
@@ -593,6 +1041,13 @@ func (u RepoAnalysisUpdater) SetDeletedAt(deletedAt *time.Time) RepoAnalysisUpda
 	return u
 }
 
+// SetFixedIssuesCount is an autogenerated method
+// nolint: dupl
+func (u RepoAnalysisUpdater) SetFixedIssuesCount(fixedIssuesCount int) RepoAnalysisUpdater {
+	u.fields[string(RepoAnalysisDBSchema.FixedIssuesCount)] = fixedIssuesCount
+	return u
+}
+
 // SetID is an autogenerated method
 // nolint: dupl
 func (u RepoAnalysisUpdater) SetID(ID uint) RepoAnalysisUpdater {
@@ -600,6 +1055,13 @@ func (u RepoAnalysisUpdater) SetID(ID uint) RepoAnalysisUpdater {
 	return u
 }
 
+// SetIssuesStored is an autogenerated method
+// nolint: dupl
+func (u RepoAnalysisUpdater) SetIssuesStored(issuesStored bool) RepoAnalysisUpdater {
+	u.fields[string(RepoAnalysisDBSchema.IssuesStored)] = issuesStored
+	return u
+}
+
 // SetLintersVersion is an autogenerated method
 // nolint: dupl
 func (u RepoAnalysisUpdater) SetLintersVersion(lintersVersion string) RepoAnalysisUpdater {
@@ -607,6 +1069,27 @@ func (u RepoAnalysisUpdater) SetLintersVersion(lintersVersion string) RepoAnalys
 	return u
 }
 
+// SetMaxScore is an autogenerated method
+// nolint: dupl
+func (u RepoAnalysisUpdater) SetMaxScore(maxScore int) RepoAnalysisUpdater {
+	u.fields[string(RepoAnalysisDBSchema.MaxScore)] = maxScore
+	return u
+}
+
+// SetNewIssuesCount is an autogenerated method
+// nolint: dupl
+func (u RepoAnalysisUpdater) SetNewIssuesCount(newIssuesCount int) RepoAnalysisUpdater {
+	u.fields[string(RepoAnalysisDBSchema.NewIssuesCount)] = newIssuesCount
+	return u
+}
+
+// SetPreviousAnalysisID is an autogenerated method
+// nolint: dupl
+func (u RepoAnalysisUpdater) SetPreviousAnalysisID(previousAnalysisID uint) RepoAnalysisUpdater {
+	u.fields[string(RepoAnalysisDBSchema.PreviousAnalysisID)] = previousAnalysisID
+	return u
+}
+
 // SetRepoAnalysisStatus is an autogenerated method
 // nolint: dupl
 func (u RepoAnalysisUpdater) SetRepoAnalysisStatus(repoAnalysisStatus RepoAnalysisStatus) RepoAnalysisUpdater {
@@ -621,6 +1104,13 @@ func (u RepoAnalysisUpdater) SetRepoAnalysisStatusID(repoAnalysisStatusID uint)
 	return u
 }
 
+// SetResultArchiveKey is an autogenerated method
+// nolint: dupl
+func (u RepoAnalysisUpdater) SetResultArchiveKey(resultArchiveKey string) RepoAnalysisUpdater {
+	u.fields[string(RepoAnalysisDBSchema.ResultArchiveKey)] = resultArchiveKey
+	return u
+}
+
 // SetResultJSON is an autogenerated method
 // nolint: dupl
 func (u RepoAnalysisUpdater) SetResultJSON(resultJSON json.RawMessage) RepoAnalysisUpdater {
@@ -628,6 +1118,20 @@ func (u RepoAnalysisUpdater) SetResultJSON(resultJSON json.RawMessage) RepoAnaly
 	return u
 }
 
+// SetScore is an autogenerated method
+// nolint: dupl
+func (u RepoAnalysisUpdater) SetScore(score int) RepoAnalysisUpdater {
+	u.fields[string(RepoAnalysisDBSchema.Score)] = score
+	return u
+}
+
+// SetScoreRecommendations is an autogenerated method
+// nolint: dupl
+func (u RepoAnalysisUpdater) SetScoreRecommendations(scoreRecommendations json.RawMessage) RepoAnalysisUpdater {
+	u.fields[string(RepoAnalysisDBSchema.ScoreRecommendations)] = scoreRecommendations
+	return u
+}
+
 // SetStatus is an autogenerated method
 // nolint: dupl
 func (u RepoAnalysisUpdater) SetStatus(status string) RepoAnalysisUpdater {
@@ -635,6 +1139,13 @@ func (u RepoAnalysisUpdater) SetStatus(status string) RepoAnalysisUpdater {
 	return u
 }
 
+// SetUnchangedIssuesCount is an autogenerated method
+// nolint: dupl
+func (u RepoAnalysisUpdater) SetUnchangedIssuesCount(unchangedIssuesCount int) RepoAnalysisUpdater {
+	u.fields[string(RepoAnalysisDBSchema.UnchangedIssuesCount)] = unchangedIssuesCount
+	return u
+}
+
 // SetUpdatedAt is an autogenerated method
 // nolint: dupl
 func (u RepoAnalysisUpdater) SetUpdatedAt(updatedAt time.Time) RepoAnalysisUpdater {
@@ -674,6 +1185,62 @@ func (qs RepoAnalysisQuerySet) StatusNotIn(status ...string) RepoAnalysisQuerySe
 	return qs.w(qs.db.Where("status NOT IN (?)", status))
 }
 
+// UnchangedIssuesCountEq is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) UnchangedIssuesCountEq(unchangedIssuesCount int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("unchanged_issues_count = ?", unchangedIssuesCount))
+}
+
+// UnchangedIssuesCountGt is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) UnchangedIssuesCountGt(unchangedIssuesCount int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("unchanged_issues_count > ?", unchangedIssuesCount))
+}
+
+// UnchangedIssuesCountGte is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) UnchangedIssuesCountGte(unchangedIssuesCount int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("unchanged_issues_count >= ?", unchangedIssuesCount))
+}
+
+// UnchangedIssuesCountIn is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) UnchangedIssuesCountIn(unchangedIssuesCount ...int) RepoAnalysisQuerySet {
+	if len(unchangedIssuesCount) == 0 {
+		qs.db.AddError(errors.New("must at least pass one unchangedIssuesCount in UnchangedIssuesCountIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("unchanged_issues_count IN (?)", unchangedIssuesCount))
+}
+
+// UnchangedIssuesCountLt is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) UnchangedIssuesCountLt(unchangedIssuesCount int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("unchanged_issues_count < ?", unchangedIssuesCount))
+}
+
+// UnchangedIssuesCountLte is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) UnchangedIssuesCountLte(unchangedIssuesCount int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("unchanged_issues_count <= ?", unchangedIssuesCount))
+}
+
+// UnchangedIssuesCountNe is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) UnchangedIssuesCountNe(unchangedIssuesCount int) RepoAnalysisQuerySet {
+	return qs.w(qs.db.Where("unchanged_issues_count != ?", unchangedIssuesCount))
+}
+
+// UnchangedIssuesCountNotIn is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisQuerySet) UnchangedIssuesCountNotIn(unchangedIssuesCount ...int) RepoAnalysisQuerySet {
+	if len(unchangedIssuesCount) == 0 {
+		qs.db.AddError(errors.New("must at least pass one unchangedIssuesCount in UnchangedIssuesCountNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("unchanged_issues_count NOT IN (?)", unchangedIssuesCount))
+}
+
 // Update is an autogenerated method
 // nolint: dupl
 func (u RepoAnalysisUpdater) Update() error {
@@ -750,6 +1317,15 @@ var RepoAnalysisDBSchema = struct {
 	ResultJSON           RepoAnalysisDBSchemaField
 	AttemptNumber        RepoAnalysisDBSchemaField
 	LintersVersion       RepoAnalysisDBSchemaField
+	IssuesStored         RepoAnalysisDBSchemaField
+	PreviousAnalysisID   RepoAnalysisDBSchemaField
+	NewIssuesCount       RepoAnalysisDBSchemaField
+	FixedIssuesCount     RepoAnalysisDBSchemaField
+	UnchangedIssuesCount RepoAnalysisDBSchemaField
+	Score                RepoAnalysisDBSchemaField
+	MaxScore             RepoAnalysisDBSchemaField
+	ScoreRecommendations RepoAnalysisDBSchemaField
+	ResultArchiveKey     RepoAnalysisDBSchemaField
 }{
 
 	ID:                   RepoAnalysisDBSchemaField("id"),
@@ -764,6 +1340,15 @@ var RepoAnalysisDBSchema = struct {
 	ResultJSON:           RepoAnalysisDBSchemaField("result_json"),
 	AttemptNumber:        RepoAnalysisDBSchemaField("attempt_number"),
 	LintersVersion:       RepoAnalysisDBSchemaField("linters_version"),
+	IssuesStored:         RepoAnalysisDBSchemaField("issues_stored"),
+	PreviousAnalysisID:   RepoAnalysisDBSchemaField("previous_analysis_id"),
+	NewIssuesCount:       RepoAnalysisDBSchemaField("new_issues_count"),
+	FixedIssuesCount:     RepoAnalysisDBSchemaField("fixed_issues_count"),
+	UnchangedIssuesCount: RepoAnalysisDBSchemaField("unchanged_issues_count"),
+	Score:                RepoAnalysisDBSchemaField("score"),
+	MaxScore:             RepoAnalysisDBSchemaField("max_score"),
+	ScoreRecommendations: RepoAnalysisDBSchemaField("score_recommendations"),
+	ResultArchiveKey:     RepoAnalysisDBSchemaField("result_archive_key"),
 }
 
 // Update updates RepoAnalysis fields by primary key
@@ -782,6 +1367,15 @@ func (o *RepoAnalysis) Update(db *gorm.DB, fields ...RepoAnalysisDBSchemaField)
 		"result_json":             o.ResultJSON,
 		"attempt_number":          o.AttemptNumber,
 		"linters_version":         o.LintersVersion,
+		"issues_stored":           o.IssuesStored,
+		"previous_analysis_id":    o.PreviousAnalysisID,
+		"new_issues_count":        o.NewIssuesCount,
+		"fixed_issues_count":      o.FixedIssuesCount,
+		"unchanged_issues_count":  o.UnchangedIssuesCount,
+		"score":                   o.Score,
+		"max_score":               o.MaxScore,
+		"score_recommendations":   o.ScoreRecommendations,
+		"result_archive_key":      o.ResultArchiveKey,
 	}
 	u := map[string]interface{}{}
 	for _, f := range fields {
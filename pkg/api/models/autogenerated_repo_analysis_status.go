@@ -417,6 +417,38 @@ func (qs RepoAnalysisStatusQuerySet) LastAnalyzedLintersVersionNotIn(lastAnalyze
 	return qs.w(qs.db.Where("last_analyzed_linters_version NOT IN (?)", lastAnalyzedLintersVersion))
 }
 
+// LastAnalyzedLintersVersionRangeEq is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisStatusQuerySet) LastAnalyzedLintersVersionRangeEq(lastAnalyzedLintersVersionRange string) RepoAnalysisStatusQuerySet {
+	return qs.w(qs.db.Where("last_analyzed_linters_version_range = ?", lastAnalyzedLintersVersionRange))
+}
+
+// LastAnalyzedLintersVersionRangeIn is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisStatusQuerySet) LastAnalyzedLintersVersionRangeIn(lastAnalyzedLintersVersionRange ...string) RepoAnalysisStatusQuerySet {
+	if len(lastAnalyzedLintersVersionRange) == 0 {
+		qs.db.AddError(errors.New("must at least pass one lastAnalyzedLintersVersionRange in LastAnalyzedLintersVersionRangeIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("last_analyzed_linters_version_range IN (?)", lastAnalyzedLintersVersionRange))
+}
+
+// LastAnalyzedLintersVersionRangeNe is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisStatusQuerySet) LastAnalyzedLintersVersionRangeNe(lastAnalyzedLintersVersionRange string) RepoAnalysisStatusQuerySet {
+	return qs.w(qs.db.Where("last_analyzed_linters_version_range != ?", lastAnalyzedLintersVersionRange))
+}
+
+// LastAnalyzedLintersVersionRangeNotIn is an autogenerated method
+// nolint: dupl
+func (qs RepoAnalysisStatusQuerySet) LastAnalyzedLintersVersionRangeNotIn(lastAnalyzedLintersVersionRange ...string) RepoAnalysisStatusQuerySet {
+	if len(lastAnalyzedLintersVersionRange) == 0 {
+		qs.db.AddError(errors.New("must at least pass one lastAnalyzedLintersVersionRange in LastAnalyzedLintersVersionRangeNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("last_analyzed_linters_version_range NOT IN (?)", lastAnalyzedLintersVersionRange))
+}
+
 // Limit is an autogenerated method
 // nolint: dupl
 func (qs RepoAnalysisStatusQuerySet) Limit(limit int) RepoAnalysisStatusQuerySet {
@@ -670,6 +702,13 @@ func (u RepoAnalysisStatusUpdater) SetLastAnalyzedLintersVersion(lastAnalyzedLin
 	return u
 }
 
+// SetLastAnalyzedLintersVersionRange is an autogenerated method
+// nolint: dupl
+func (u RepoAnalysisStatusUpdater) SetLastAnalyzedLintersVersionRange(lastAnalyzedLintersVersionRange string) RepoAnalysisStatusUpdater {
+	u.fields[string(RepoAnalysisStatusDBSchema.LastAnalyzedLintersVersionRange)] = lastAnalyzedLintersVersionRange
+	return u
+}
+
 // SetPendingCommitSHA is an autogenerated method
 // nolint: dupl
 func (u RepoAnalysisStatusUpdater) SetPendingCommitSHA(pendingCommitSHA string) RepoAnalysisStatusUpdater {
@@ -818,53 +857,56 @@ func (f RepoAnalysisStatusDBSchemaField) String() string {
 
 // RepoAnalysisStatusDBSchema stores db field names of RepoAnalysisStatus
 var RepoAnalysisStatusDBSchema = struct {
-	ID                         RepoAnalysisStatusDBSchemaField
-	CreatedAt                  RepoAnalysisStatusDBSchemaField
-	UpdatedAt                  RepoAnalysisStatusDBSchemaField
-	DeletedAt                  RepoAnalysisStatusDBSchemaField
-	RepoID                     RepoAnalysisStatusDBSchemaField
-	LastAnalyzedAt             RepoAnalysisStatusDBSchemaField
-	LastAnalyzedLintersVersion RepoAnalysisStatusDBSchemaField
-	HasPendingChanges          RepoAnalysisStatusDBSchemaField
-	PendingCommitSHA           RepoAnalysisStatusDBSchemaField
-	Version                    RepoAnalysisStatusDBSchemaField
-	DefaultBranch              RepoAnalysisStatusDBSchemaField
-	IsEmpty                    RepoAnalysisStatusDBSchemaField
-	Active                     RepoAnalysisStatusDBSchemaField
+	ID                              RepoAnalysisStatusDBSchemaField
+	CreatedAt                       RepoAnalysisStatusDBSchemaField
+	UpdatedAt                       RepoAnalysisStatusDBSchemaField
+	DeletedAt                       RepoAnalysisStatusDBSchemaField
+	RepoID                          RepoAnalysisStatusDBSchemaField
+	LastAnalyzedAt                  RepoAnalysisStatusDBSchemaField
+	LastAnalyzedLintersVersion      RepoAnalysisStatusDBSchemaField
+	LastAnalyzedLintersVersionRange RepoAnalysisStatusDBSchemaField
+	HasPendingChanges               RepoAnalysisStatusDBSchemaField
+	PendingCommitSHA                RepoAnalysisStatusDBSchemaField
+	Version                         RepoAnalysisStatusDBSchemaField
+	DefaultBranch                   RepoAnalysisStatusDBSchemaField
+	IsEmpty                         RepoAnalysisStatusDBSchemaField
+	Active                          RepoAnalysisStatusDBSchemaField
 }{
 
-	ID:                         RepoAnalysisStatusDBSchemaField("id"),
-	CreatedAt:                  RepoAnalysisStatusDBSchemaField("created_at"),
-	UpdatedAt:                  RepoAnalysisStatusDBSchemaField("updated_at"),
-	DeletedAt:                  RepoAnalysisStatusDBSchemaField("deleted_at"),
-	RepoID:                     RepoAnalysisStatusDBSchemaField("repo_id"),
-	LastAnalyzedAt:             RepoAnalysisStatusDBSchemaField("last_analyzed_at"),
-	LastAnalyzedLintersVersion: RepoAnalysisStatusDBSchemaField("last_analyzed_linters_version"),
-	HasPendingChanges:          RepoAnalysisStatusDBSchemaField("has_pending_changes"),
-	PendingCommitSHA:           RepoAnalysisStatusDBSchemaField("pending_commit_sha"),
-	Version:                    RepoAnalysisStatusDBSchemaField("version"),
-	DefaultBranch:              RepoAnalysisStatusDBSchemaField("default_branch"),
-	IsEmpty:                    RepoAnalysisStatusDBSchemaField("is_empty"),
-	Active:                     RepoAnalysisStatusDBSchemaField("active"),
+	ID:                              RepoAnalysisStatusDBSchemaField("id"),
+	CreatedAt:                       RepoAnalysisStatusDBSchemaField("created_at"),
+	UpdatedAt:                       RepoAnalysisStatusDBSchemaField("updated_at"),
+	DeletedAt:                       RepoAnalysisStatusDBSchemaField("deleted_at"),
+	RepoID:                          RepoAnalysisStatusDBSchemaField("repo_id"),
+	LastAnalyzedAt:                  RepoAnalysisStatusDBSchemaField("last_analyzed_at"),
+	LastAnalyzedLintersVersion:      RepoAnalysisStatusDBSchemaField("last_analyzed_linters_version"),
+	LastAnalyzedLintersVersionRange: RepoAnalysisStatusDBSchemaField("last_analyzed_linters_version_range"),
+	HasPendingChanges:               RepoAnalysisStatusDBSchemaField("has_pending_changes"),
+	PendingCommitSHA:                RepoAnalysisStatusDBSchemaField("pending_commit_sha"),
+	Version:                         RepoAnalysisStatusDBSchemaField("version"),
+	DefaultBranch:                   RepoAnalysisStatusDBSchemaField("default_branch"),
+	IsEmpty:                         RepoAnalysisStatusDBSchemaField("is_empty"),
+	Active:                          RepoAnalysisStatusDBSchemaField("active"),
 }
 
 // Update updates RepoAnalysisStatus fields by primary key
 // nolint: dupl
 func (o *RepoAnalysisStatus) Update(db *gorm.DB, fields ...RepoAnalysisStatusDBSchemaField) error {
 	dbNameToFieldName := map[string]interface{}{
-		"id":                            o.ID,
-		"created_at":                    o.CreatedAt,
-		"updated_at":                    o.UpdatedAt,
-		"deleted_at":                    o.DeletedAt,
-		"repo_id":                       o.RepoID,
-		"last_analyzed_at":              o.LastAnalyzedAt,
-		"last_analyzed_linters_version": o.LastAnalyzedLintersVersion,
-		"has_pending_changes":           o.HasPendingChanges,
-		"pending_commit_sha":            o.PendingCommitSHA,
-		"version":                       o.Version,
-		"default_branch":                o.DefaultBranch,
-		"is_empty":                      o.IsEmpty,
-		"active":                        o.Active,
+		"id":                                  o.ID,
+		"created_at":                          o.CreatedAt,
+		"updated_at":                          o.UpdatedAt,
+		"deleted_at":                          o.DeletedAt,
+		"repo_id":                             o.RepoID,
+		"last_analyzed_at":                    o.LastAnalyzedAt,
+		"last_analyzed_linters_version":       o.LastAnalyzedLintersVersion,
+		"last_analyzed_linters_version_range": o.LastAnalyzedLintersVersionRange,
+		"has_pending_changes":                 o.HasPendingChanges,
+		"pending_commit_sha":                  o.PendingCommitSHA,
+		"version":                             o.Version,
+		"default_branch":                      o.DefaultBranch,
+		"is_empty":                            o.IsEmpty,
+		"active":                              o.Active,
 	}
 	u := map[string]interface{}{}
 	for _, f := range fields {
@@ -0,0 +1,713 @@
+// Code generated by go-queryset. DO NOT EDIT.
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+// ===== BEGIN of all query sets
+
+// ===== BEGIN of query set RepoOwnerTransferQuerySet
+
+// RepoOwnerTransferQuerySet is an queryset type for RepoOwnerTransfer
+type RepoOwnerTransferQuerySet struct {
+	db *gorm.DB
+}
+
+// NewRepoOwnerTransferQuerySet constructs new RepoOwnerTransferQuerySet
+func NewRepoOwnerTransferQuerySet(db *gorm.DB) RepoOwnerTransferQuerySet {
+	return RepoOwnerTransferQuerySet{
+		db: db.Model(&RepoOwnerTransfer{}),
+	}
+}
+
+func (qs RepoOwnerTransferQuerySet) w(db *gorm.DB) RepoOwnerTransferQuerySet {
+	return NewRepoOwnerTransferQuerySet(db)
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) All(ret *[]RepoOwnerTransfer) error {
+	return qs.db.Find(ret).Error
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) Count() (int, error) {
+	var count int
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *RepoOwnerTransfer) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// CreatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) CreatedAtEq(createdAt time.Time) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("created_at = ?", createdAt))
+}
+
+// CreatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) CreatedAtGt(createdAt time.Time) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("created_at > ?", createdAt))
+}
+
+// CreatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) CreatedAtGte(createdAt time.Time) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", createdAt))
+}
+
+// CreatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) CreatedAtLt(createdAt time.Time) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("created_at < ?", createdAt))
+}
+
+// CreatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) CreatedAtLte(createdAt time.Time) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("created_at <= ?", createdAt))
+}
+
+// CreatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) CreatedAtNe(createdAt time.Time) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("created_at != ?", createdAt))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *RepoOwnerTransfer) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) Delete() error {
+	return qs.db.Delete(RepoOwnerTransfer{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(RepoOwnerTransfer{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(RepoOwnerTransfer{})
+	return db.RowsAffected, db.Error
+}
+
+// DeletedAtEq is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) DeletedAtEq(deletedAt time.Time) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("deleted_at = ?", deletedAt))
+}
+
+// DeletedAtGt is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) DeletedAtGt(deletedAt time.Time) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("deleted_at > ?", deletedAt))
+}
+
+// DeletedAtGte is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) DeletedAtGte(deletedAt time.Time) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("deleted_at >= ?", deletedAt))
+}
+
+// DeletedAtIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) DeletedAtIsNotNull() RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NOT NULL"))
+}
+
+// DeletedAtIsNull is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) DeletedAtIsNull() RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("deleted_at IS NULL"))
+}
+
+// DeletedAtLt is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) DeletedAtLt(deletedAt time.Time) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("deleted_at < ?", deletedAt))
+}
+
+// DeletedAtLte is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) DeletedAtLte(deletedAt time.Time) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("deleted_at <= ?", deletedAt))
+}
+
+// DeletedAtNe is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) DeletedAtNe(deletedAt time.Time) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("deleted_at != ?", deletedAt))
+}
+
+// FromUserIDEq is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) FromUserIDEq(fromUserID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("from_user_id = ?", fromUserID))
+}
+
+// FromUserIDGt is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) FromUserIDGt(fromUserID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("from_user_id > ?", fromUserID))
+}
+
+// FromUserIDGte is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) FromUserIDGte(fromUserID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("from_user_id >= ?", fromUserID))
+}
+
+// FromUserIDIn is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) FromUserIDIn(fromUserID ...uint) RepoOwnerTransferQuerySet {
+	if len(fromUserID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one fromUserID in FromUserIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("from_user_id IN (?)", fromUserID))
+}
+
+// FromUserIDLt is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) FromUserIDLt(fromUserID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("from_user_id < ?", fromUserID))
+}
+
+// FromUserIDLte is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) FromUserIDLte(fromUserID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("from_user_id <= ?", fromUserID))
+}
+
+// FromUserIDNe is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) FromUserIDNe(fromUserID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("from_user_id != ?", fromUserID))
+}
+
+// FromUserIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) FromUserIDNotIn(fromUserID ...uint) RepoOwnerTransferQuerySet {
+	if len(fromUserID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one fromUserID in FromUserIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("from_user_id NOT IN (?)", fromUserID))
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) GetUpdater() RepoOwnerTransferUpdater {
+	return NewRepoOwnerTransferUpdater(qs.db)
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) IDEq(ID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("id = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) IDGt(ID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("id > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) IDGte(ID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("id >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) IDIn(ID ...uint) RepoOwnerTransferQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id IN (?)", ID))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) IDLt(ID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("id < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) IDLte(ID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("id <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) IDNe(ID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("id != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) IDNotIn(ID ...uint) RepoOwnerTransferQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) Limit(limit int) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) Offset(offset int) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs RepoOwnerTransferQuerySet) One(ret *RepoOwnerTransfer) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) OrderAscByCreatedAt() RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Order("created_at ASC"))
+}
+
+// OrderAscByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) OrderAscByDeletedAt() RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Order("deleted_at ASC"))
+}
+
+// OrderAscByFromUserID is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) OrderAscByFromUserID() RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Order("from_user_id ASC"))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) OrderAscByID() RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Order("id ASC"))
+}
+
+// OrderAscByRepoID is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) OrderAscByRepoID() RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Order("repo_id ASC"))
+}
+
+// OrderAscByToUserID is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) OrderAscByToUserID() RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Order("to_user_id ASC"))
+}
+
+// OrderAscByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) OrderAscByUpdatedAt() RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Order("updated_at ASC"))
+}
+
+// OrderDescByCreatedAt is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) OrderDescByCreatedAt() RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Order("created_at DESC"))
+}
+
+// OrderDescByDeletedAt is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) OrderDescByDeletedAt() RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Order("deleted_at DESC"))
+}
+
+// OrderDescByFromUserID is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) OrderDescByFromUserID() RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Order("from_user_id DESC"))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) OrderDescByID() RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Order("id DESC"))
+}
+
+// OrderDescByRepoID is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) OrderDescByRepoID() RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Order("repo_id DESC"))
+}
+
+// OrderDescByToUserID is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) OrderDescByToUserID() RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Order("to_user_id DESC"))
+}
+
+// OrderDescByUpdatedAt is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) OrderDescByUpdatedAt() RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Order("updated_at DESC"))
+}
+
+// ReasonEq is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) ReasonEq(reason string) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("reason = ?", reason))
+}
+
+// ReasonIn is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) ReasonIn(reason ...string) RepoOwnerTransferQuerySet {
+	if len(reason) == 0 {
+		qs.db.AddError(errors.New("must at least pass one reason in ReasonIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("reason IN (?)", reason))
+}
+
+// ReasonNe is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) ReasonNe(reason string) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("reason != ?", reason))
+}
+
+// ReasonNotIn is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) ReasonNotIn(reason ...string) RepoOwnerTransferQuerySet {
+	if len(reason) == 0 {
+		qs.db.AddError(errors.New("must at least pass one reason in ReasonNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("reason NOT IN (?)", reason))
+}
+
+// RepoIDEq is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) RepoIDEq(repoID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("repo_id = ?", repoID))
+}
+
+// RepoIDGt is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) RepoIDGt(repoID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("repo_id > ?", repoID))
+}
+
+// RepoIDGte is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) RepoIDGte(repoID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("repo_id >= ?", repoID))
+}
+
+// RepoIDIn is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) RepoIDIn(repoID ...uint) RepoOwnerTransferQuerySet {
+	if len(repoID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one repoID in RepoIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("repo_id IN (?)", repoID))
+}
+
+// RepoIDLt is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) RepoIDLt(repoID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("repo_id < ?", repoID))
+}
+
+// RepoIDLte is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) RepoIDLte(repoID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("repo_id <= ?", repoID))
+}
+
+// RepoIDNe is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) RepoIDNe(repoID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("repo_id != ?", repoID))
+}
+
+// RepoIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) RepoIDNotIn(repoID ...uint) RepoOwnerTransferQuerySet {
+	if len(repoID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one repoID in RepoIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("repo_id NOT IN (?)", repoID))
+}
+
+// SetCreatedAt is an autogenerated method
+// nolint: dupl
+func (u RepoOwnerTransferUpdater) SetCreatedAt(createdAt time.Time) RepoOwnerTransferUpdater {
+	u.fields[string(RepoOwnerTransferDBSchema.CreatedAt)] = createdAt
+	return u
+}
+
+// SetDeletedAt is an autogenerated method
+// nolint: dupl
+func (u RepoOwnerTransferUpdater) SetDeletedAt(deletedAt *time.Time) RepoOwnerTransferUpdater {
+	u.fields[string(RepoOwnerTransferDBSchema.DeletedAt)] = deletedAt
+	return u
+}
+
+// SetFromUserID is an autogenerated method
+// nolint: dupl
+func (u RepoOwnerTransferUpdater) SetFromUserID(fromUserID uint) RepoOwnerTransferUpdater {
+	u.fields[string(RepoOwnerTransferDBSchema.FromUserID)] = fromUserID
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u RepoOwnerTransferUpdater) SetID(ID uint) RepoOwnerTransferUpdater {
+	u.fields[string(RepoOwnerTransferDBSchema.ID)] = ID
+	return u
+}
+
+// SetReason is an autogenerated method
+// nolint: dupl
+func (u RepoOwnerTransferUpdater) SetReason(reason string) RepoOwnerTransferUpdater {
+	u.fields[string(RepoOwnerTransferDBSchema.Reason)] = reason
+	return u
+}
+
+// SetRepoID is an autogenerated method
+// nolint: dupl
+func (u RepoOwnerTransferUpdater) SetRepoID(repoID uint) RepoOwnerTransferUpdater {
+	u.fields[string(RepoOwnerTransferDBSchema.RepoID)] = repoID
+	return u
+}
+
+// SetToUserID is an autogenerated method
+// nolint: dupl
+func (u RepoOwnerTransferUpdater) SetToUserID(toUserID uint) RepoOwnerTransferUpdater {
+	u.fields[string(RepoOwnerTransferDBSchema.ToUserID)] = toUserID
+	return u
+}
+
+// SetUpdatedAt is an autogenerated method
+// nolint: dupl
+func (u RepoOwnerTransferUpdater) SetUpdatedAt(updatedAt time.Time) RepoOwnerTransferUpdater {
+	u.fields[string(RepoOwnerTransferDBSchema.UpdatedAt)] = updatedAt
+	return u
+}
+
+// ToUserIDEq is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) ToUserIDEq(toUserID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("to_user_id = ?", toUserID))
+}
+
+// ToUserIDGt is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) ToUserIDGt(toUserID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("to_user_id > ?", toUserID))
+}
+
+// ToUserIDGte is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) ToUserIDGte(toUserID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("to_user_id >= ?", toUserID))
+}
+
+// ToUserIDIn is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) ToUserIDIn(toUserID ...uint) RepoOwnerTransferQuerySet {
+	if len(toUserID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one toUserID in ToUserIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("to_user_id IN (?)", toUserID))
+}
+
+// ToUserIDLt is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) ToUserIDLt(toUserID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("to_user_id < ?", toUserID))
+}
+
+// ToUserIDLte is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) ToUserIDLte(toUserID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("to_user_id <= ?", toUserID))
+}
+
+// ToUserIDNe is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) ToUserIDNe(toUserID uint) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("to_user_id != ?", toUserID))
+}
+
+// ToUserIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) ToUserIDNotIn(toUserID ...uint) RepoOwnerTransferQuerySet {
+	if len(toUserID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one toUserID in ToUserIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("to_user_id NOT IN (?)", toUserID))
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u RepoOwnerTransferUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u RepoOwnerTransferUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// UpdatedAtEq is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) UpdatedAtEq(updatedAt time.Time) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("updated_at = ?", updatedAt))
+}
+
+// UpdatedAtGt is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) UpdatedAtGt(updatedAt time.Time) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("updated_at > ?", updatedAt))
+}
+
+// UpdatedAtGte is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) UpdatedAtGte(updatedAt time.Time) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("updated_at >= ?", updatedAt))
+}
+
+// UpdatedAtLt is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) UpdatedAtLt(updatedAt time.Time) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("updated_at < ?", updatedAt))
+}
+
+// UpdatedAtLte is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) UpdatedAtLte(updatedAt time.Time) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("updated_at <= ?", updatedAt))
+}
+
+// UpdatedAtNe is an autogenerated method
+// nolint: dupl
+func (qs RepoOwnerTransferQuerySet) UpdatedAtNe(updatedAt time.Time) RepoOwnerTransferQuerySet {
+	return qs.w(qs.db.Where("updated_at != ?", updatedAt))
+}
+
+// ===== END of query set RepoOwnerTransferQuerySet
+
+// ===== BEGIN of RepoOwnerTransfer modifiers
+
+// RepoOwnerTransferDBSchemaField describes database schema field. It requires for method 'Update'
+type RepoOwnerTransferDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f RepoOwnerTransferDBSchemaField) String() string {
+	return string(f)
+}
+
+// RepoOwnerTransferDBSchema stores db field names of RepoOwnerTransfer
+var RepoOwnerTransferDBSchema = struct {
+	ID         RepoOwnerTransferDBSchemaField
+	CreatedAt  RepoOwnerTransferDBSchemaField
+	UpdatedAt  RepoOwnerTransferDBSchemaField
+	DeletedAt  RepoOwnerTransferDBSchemaField
+	RepoID     RepoOwnerTransferDBSchemaField
+	FromUserID RepoOwnerTransferDBSchemaField
+	ToUserID   RepoOwnerTransferDBSchemaField
+	Reason     RepoOwnerTransferDBSchemaField
+}{
+
+	ID:         RepoOwnerTransferDBSchemaField("id"),
+	CreatedAt:  RepoOwnerTransferDBSchemaField("created_at"),
+	UpdatedAt:  RepoOwnerTransferDBSchemaField("updated_at"),
+	DeletedAt:  RepoOwnerTransferDBSchemaField("deleted_at"),
+	RepoID:     RepoOwnerTransferDBSchemaField("repo_id"),
+	FromUserID: RepoOwnerTransferDBSchemaField("from_user_id"),
+	ToUserID:   RepoOwnerTransferDBSchemaField("to_user_id"),
+	Reason:     RepoOwnerTransferDBSchemaField("reason"),
+}
+
+// Update updates RepoOwnerTransfer fields by primary key
+// nolint: dupl
+func (o *RepoOwnerTransfer) Update(db *gorm.DB, fields ...RepoOwnerTransferDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":           o.ID,
+		"created_at":   o.CreatedAt,
+		"updated_at":   o.UpdatedAt,
+		"deleted_at":   o.DeletedAt,
+		"repo_id":      o.RepoID,
+		"from_user_id": o.FromUserID,
+		"to_user_id":   o.ToUserID,
+		"reason":       o.Reason,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update RepoOwnerTransfer %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// RepoOwnerTransferUpdater is an RepoOwnerTransfer updates manager
+type RepoOwnerTransferUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewRepoOwnerTransferUpdater creates new RepoOwnerTransfer updater
+// nolint: dupl
+func NewRepoOwnerTransferUpdater(db *gorm.DB) RepoOwnerTransferUpdater {
+	return RepoOwnerTransferUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&RepoOwnerTransfer{}),
+	}
+}
+
+// ===== END of RepoOwnerTransfer modifiers
+
+// ===== END of all query sets
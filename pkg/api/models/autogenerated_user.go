@@ -285,6 +285,38 @@ func (qs UserQuerySet) IDNotIn(ID ...uint) UserQuerySet {
 	return qs.w(qs.db.Where("id NOT IN (?)", ID))
 }
 
+// IsAdminEq is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) IsAdminEq(isAdmin bool) UserQuerySet {
+	return qs.w(qs.db.Where("is_admin = ?", isAdmin))
+}
+
+// IsAdminIn is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) IsAdminIn(isAdmin ...bool) UserQuerySet {
+	if len(isAdmin) == 0 {
+		qs.db.AddError(errors.New("must at least pass one isAdmin in IsAdminIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("is_admin IN (?)", isAdmin))
+}
+
+// IsAdminNe is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) IsAdminNe(isAdmin bool) UserQuerySet {
+	return qs.w(qs.db.Where("is_admin != ?", isAdmin))
+}
+
+// IsAdminNotIn is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) IsAdminNotIn(isAdmin ...bool) UserQuerySet {
+	if len(isAdmin) == 0 {
+		qs.db.AddError(errors.New("must at least pass one isAdmin in IsAdminNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("is_admin NOT IN (?)", isAdmin))
+}
+
 // Limit is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) Limit(limit int) UserQuerySet {
@@ -418,6 +450,13 @@ func (u UserUpdater) SetID(ID uint) UserUpdater {
 	return u
 }
 
+// SetIsAdmin is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) SetIsAdmin(isAdmin bool) UserUpdater {
+	u.fields[string(UserDBSchema.IsAdmin)] = isAdmin
+	return u
+}
+
 // SetName is an autogenerated method
 // nolint: dupl
 func (u UserUpdater) SetName(name string) UserUpdater {
@@ -503,6 +542,7 @@ var UserDBSchema = struct {
 	Email     UserDBSchemaField
 	Name      UserDBSchemaField
 	AvatarURL UserDBSchemaField
+	IsAdmin   UserDBSchemaField
 }{
 
 	ID:        UserDBSchemaField("id"),
@@ -512,6 +552,7 @@ var UserDBSchema = struct {
 	Email:     UserDBSchemaField("email"),
 	Name:      UserDBSchemaField("name"),
 	AvatarURL: UserDBSchemaField("avatar_url"),
+	IsAdmin:   UserDBSchemaField("is_admin"),
 }
 
 // Update updates User fields by primary key
@@ -525,6 +566,7 @@ func (o *User) Update(db *gorm.DB, fields ...UserDBSchemaField) error {
 		"email":      o.Email,
 		"name":       o.Name,
 		"avatar_url": o.AvatarURL,
+		"is_admin":   o.IsAdmin,
 	}
 	u := map[string]interface{}{}
 	for _, f := range fields {
@@ -0,0 +1,16 @@
+package models
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+//go:generate goqueryset -in golangci_lint_version.go
+
+// gen:qs
+type GolangciLintVersion struct {
+	gorm.Model
+
+	Version   string // without the "v" prefix, e.g. 1.23.8
+	Checksum  string // sha256 of the linux-amd64 release archive
+	IsDefault bool
+}
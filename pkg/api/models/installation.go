@@ -0,0 +1,27 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/jinzhu/gorm"
+)
+
+//go:generate goqueryset -in installation.go
+
+// Installation is an installation of our provider app (GitHub App) into a user or an organization account.
+// Repos connected through an installation are accessed by the app, not by a user access token.
+// gen:qs
+type Installation struct {
+	gorm.Model
+
+	Provider   string
+	ProviderID int
+
+	AccountName       string // lower-cased login of the user or the organization
+	AccountProviderID int
+}
+
+func (i Installation) GoString() string {
+	return fmt.Sprintf("{ID: %d, ProviderID: %d, AccountName: %s, Provider: %s}",
+		i.ID, i.ProviderID, i.AccountName, i.Provider)
+}
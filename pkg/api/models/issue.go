@@ -0,0 +1,45 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+//go:generate goqueryset -in issue.go
+
+type IssueAnalysisType string
+
+const (
+	IssueAnalysisTypeRepo        IssueAnalysisType = "repo"
+	IssueAnalysisTypePullRequest IssueAnalysisType = "pull_request"
+)
+
+// Issue is a golangci-lint issue of a repo or pull request analysis.
+// Issues are replaced with every update of the analysis result, therefore there is no UpdatedAt and DeletedAt.
+// gen:qs
+type Issue struct {
+	ID        uint      `gorm:"primary_key" json:"id"`
+	CreatedAt time.Time `json:"-"`
+
+	AnalysisType IssueAnalysisType `json:"-"`
+	AnalysisID   uint              `json:"-"`
+
+	FromLinter   string `json:"fromLinter"`
+	File         string `json:"file"`
+	LineFrom     int    `json:"lineFrom"`
+	LineTo       int    `json:"lineTo"`
+	ColumnNumber int    `json:"column,omitempty"`
+	Text         string `json:"text"`
+
+	// Fingerprint doesn't depend on the position in the file: the issue keeps it when code around is changed
+	Fingerprint string `json:"fingerprint"`
+	Severity    string `json:"severity,omitempty"`
+
+	Replacement json.RawMessage `json:"replacement,omitempty"`
+
+	// IsNew is set for issues of repo analyzes which weren't in the previous analysis
+	IsNew bool `json:"isNew,omitempty"`
+
+	// IsSuppressed is calculated by suppressions of the repo on fetching
+	IsSuppressed bool `gorm:"-" json:"isSuppressed,omitempty"`
+}
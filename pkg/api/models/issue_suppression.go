@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+)
+
+//go:generate goqueryset -in issue_suppression.go
+
+type IssueSuppressionSource string
+
+const (
+	IssueSuppressionSourceBaseline IssueSuppressionSource = "baseline"
+	IssueSuppressionSourceManual   IssueSuppressionSource = "manual"
+)
+
+// IssueSuppression hides issues of the repo with the fingerprint in repo and pull request analyzes
+// gen:qs
+type IssueSuppression struct {
+	ID        uint       `gorm:"primary_key" json:"id"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"-"`
+	DeletedAt *time.Time `sql:"index" json:"-"`
+
+	RepoID       uint                   `json:"-"`
+	Fingerprint  string                 `json:"fingerprint"`
+	Source       IssueSuppressionSource `json:"source"`
+	Reason       string                 `json:"reason,omitempty"`
+	AuthorUserID uint                   `json:"authorUserId"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+//go:generate goqueryset -in notification_preference.go
+
+type NotificationEvent string
+
+const (
+	NotificationEventAnalysisFailed   NotificationEvent = "analysis/failed"
+	NotificationEventNewIssues        NotificationEvent = "analysis/new_issues"
+	NotificationEventSubPaymentFailed NotificationEvent = "subscription/payment_failed"
+	NotificationEventRepoDisconnected NotificationEvent = "repo/disconnected"
+	NotificationEventSeatRequested    NotificationEvent = "org/seat_requested"
+)
+
+// NotificationPreference overrides the default of the event for the user:
+// there is no row if the user didn't change it.
+// gen:qs
+type NotificationPreference struct {
+	gorm.Model
+
+	UserID  uint
+	Event   NotificationEvent
+	Enabled bool
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/golangci/golangci-api/internal/api/apierrors"
+	"github.com/golangci/golangci-api/internal/api/score"
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
 )
@@ -51,8 +52,32 @@ type OrgSeat struct {
 	Email string `json:"email"`
 }
 
+// OrgGolangciLintChannelAuto makes org repos use the latest golangci-lint release instead of the default one
+const OrgGolangciLintChannelAuto = "auto"
+
 type OrgSettings struct {
 	Seats []OrgSeat `json:"seats,omitempty"`
+
+	GolangciLintChannel string `json:"golangciLintChannel,omitempty"`
+
+	// ScoreProfile is the name of the active profile from ScoreProfiles, the default profile is used if it's empty
+	ScoreProfiles []score.Profile `json:"scoreProfiles,omitempty"`
+	ScoreProfile  string          `json:"scoreProfile,omitempty"`
+}
+
+// GetScoreProfile returns the active scoring profile of the org or nil for the default one
+func (s OrgSettings) GetScoreProfile() *score.Profile {
+	if s.ScoreProfile == "" {
+		return nil
+	}
+
+	for i := range s.ScoreProfiles {
+		if s.ScoreProfiles[i].Name == s.ScoreProfile {
+			return &s.ScoreProfiles[i]
+		}
+	}
+
+	return nil
 }
 
 func (u OrgUpdater) UpdateRequired() error {
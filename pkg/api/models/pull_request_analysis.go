@@ -21,6 +21,15 @@ type PullRequestAnalysis struct {
 	ReportedIssuesCount int
 
 	ResultJSON []byte
+
+	// TimingsJSON keeps timings of ResultJSON: they are needed even if the result was archived
+	TimingsJSON []byte
+
+	// IssuesStored is false for analyzes saved before issues were stored in the issues table
+	IssuesStored bool
+
+	// ResultArchiveKey is set if ResultJSON was moved to the results archive
+	ResultArchiveKey string
 }
 
 func (PullRequestAnalysis) TableName() string {
@@ -62,6 +62,9 @@ type Repo struct {
 	ProviderHookID int
 	ProviderID     int // provider repo id: use it (not name) as repo identifier because of repo renaming
 
+	// provider app installation id if the repo is connected through the app (no hook and no collaborator)
+	ProviderInstallationID int
+
 	CommitState RepoCommitState // state of creation or deletion
 
 	StargazersCount int
@@ -98,6 +101,12 @@ func (r Repo) FullNameWithProvider() string {
 	return fmt.Sprintf("%s/%s", r.Provider, r.FullName)
 }
 
+// OwnerEq filters repos by the owner part of the lower-cased full name
+func (qs RepoQuerySet) OwnerEq(owner string) RepoQuerySet {
+	escapedOwner := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(strings.ToLower(owner))
+	return qs.w(qs.db.Where("name LIKE ?", escapedOwner+"/%"))
+}
+
 func (u RepoUpdater) UpdateRequired() error {
 	n, err := u.UpdateNum()
 	if err != nil {
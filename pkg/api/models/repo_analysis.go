@@ -21,6 +21,23 @@ type RepoAnalysis struct {
 	ResultJSON     json.RawMessage
 	AttemptNumber  int
 	LintersVersion string
+
+	// IssuesStored is false for analyzes saved before issues were stored in the issues table
+	IssuesStored bool
+
+	// issues diff with the previous processed analysis of the repo, it's 0 if there is no such analysis
+	PreviousAnalysisID   uint
+	NewIssuesCount       int
+	FixedIssuesCount     int
+	UnchangedIssuesCount int
+
+	// score of the code by internal/api/score, MaxScore is 0 if the score wasn't calculated
+	Score                int
+	MaxScore             int
+	ScoreRecommendations json.RawMessage
+
+	// ResultArchiveKey is set if ResultJSON was moved to the results archive
+	ResultArchiveKey string
 }
 
 func (RepoAnalysis) TableName() string {
@@ -17,6 +17,10 @@ type RepoAnalysisStatus struct {
 	LastAnalyzedAt             time.Time
 	LastAnalyzedLintersVersion string
 
+	// LastAnalyzedLintersVersionRange is golangci-lint-version from the repo config,
+	// it's empty if the default version was used
+	LastAnalyzedLintersVersionRange string
+
 	HasPendingChanges bool
 	PendingCommitSHA  string
 	Version           int
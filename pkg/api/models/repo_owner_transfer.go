@@ -0,0 +1,20 @@
+package models
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+//go:generate goqueryset -in repo_owner_transfer.go
+
+// RepoOwnerTransfer records reassignment of the repo to another user
+// because the user who connected it lost access.
+// gen:qs
+type RepoOwnerTransfer struct {
+	gorm.Model
+
+	RepoID     uint
+	FromUserID uint
+	ToUserID   uint // 0 if no replacement was found and org admins were notified
+
+	Reason string
+}
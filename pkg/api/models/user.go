@@ -14,4 +14,6 @@ type User struct {
 
 	Name      string
 	AvatarURL string
+
+	IsAdmin bool // grants access to the admin API
 }
@@ -0,0 +1,127 @@
+package notifications
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+type EventInfo struct {
+	Event          models.NotificationEvent
+	Description    string
+	DefaultEnabled bool
+
+	subject, body *template.Template
+}
+
+func newEventInfo(event models.NotificationEvent, description string, defaultEnabled bool,
+	subject, body string) *EventInfo {
+
+	name := string(event)
+	return &EventInfo{
+		Event:          event,
+		Description:    description,
+		DefaultEnabled: defaultEnabled,
+		subject:        template.Must(template.New(name + "/subject").Option("missingkey=error").Parse(subject)),
+		body:           template.Must(template.New(name + "/body").Option("missingkey=error").Parse(body)),
+	}
+}
+
+// Events are ordered as they are shown to users.
+// Users opt in to noisy events, important for billing and analysis events are enabled by default.
+var Events = []*EventInfo{
+	newEventInfo(models.NotificationEventAnalysisFailed,
+		"Analysis of the default branch of my repo failed", false,
+		`Analysis of {{.Repo}} failed`,
+		`GolangCI failed to analyze the default branch of {{.Repo}}.
+
+See the report for details:
+{{.ReportURL}}
+`),
+	newEventInfo(models.NotificationEventNewIssues,
+		"New issues were found in the default branch of my repo", false,
+		`{{.NewIssues}} new issues in {{.Repo}}`,
+		`GolangCI found new issues in the default branch of {{.Repo}} at commit {{.CommitSHA}}:
+new: {{.NewIssues}}, fixed: {{.FixedIssues}}, unchanged: {{.UnchangedIssues}}.
+
+See the report for details:
+{{.ReportURL}}
+`),
+	newEventInfo(models.NotificationEventSubPaymentFailed,
+		"Subscription payment failed", true,
+		`Payment for {{.Org}} subscription failed`,
+		`We couldn't charge the payment for GolangCI subscription of {{.Org}}.
+The next attempt will be made on {{.NextRetryDate}}.
+
+Please check the payment method:
+{{.UpdateURL}}
+`),
+	newEventInfo(models.NotificationEventRepoDisconnected,
+		"Repo stopped being analyzed because of revoked access", true,
+		`GolangCI stopped analyzing {{.Repo}}`,
+		`Repository {{.Repo}} was connected to GolangCI by {{.UserName}}, but GolangCI lost access to their account.
+We couldn't transfer the repository to another user with admin access to it,
+therefore pull requests aren't analyzed anymore.
+
+To continue analyzing please reconnect the repository:
+{{.ReconnectURL}}
+`),
+	newEventInfo(models.NotificationEventSeatRequested,
+		"Commit author without a seat in my organization subscription", true,
+		`{{.Repo}} pull request #{{.PullRequest}} needs a seat`,
+		`Pull request #{{.PullRequest}} to {{.Repo}} wasn't analyzed: none of its commit authors has a seat
+in GolangCI subscription of {{.Org}}.
+
+Add the author's email to the seats in the organization settings:
+{{.SettingsURL}}
+`),
+}
+
+func GetEventInfo(event models.NotificationEvent) *EventInfo {
+	for _, ei := range Events {
+		if ei.Event == event {
+			return ei
+		}
+	}
+
+	return nil
+}
+
+// Render builds email subject and body for the event
+func (ei EventInfo) Render(data map[string]string) (string, string, error) {
+	var subject, body bytes.Buffer
+	if err := ei.subject.Execute(&subject, data); err != nil {
+		return "", "", errors.Wrapf(err, "failed to render %s subject", ei.Event)
+	}
+	if err := ei.body.Execute(&body, data); err != nil {
+		return "", "", errors.Wrapf(err, "failed to render %s body", ei.Event)
+	}
+
+	// subject must be one line
+	return strings.Join(strings.Fields(subject.String()), " "), body.String(), nil
+}
+
+// IsEnabled returns the preference of the user or the default for the event
+func IsEnabled(db *gorm.DB, userID uint, event models.NotificationEvent) (bool, error) {
+	ei := GetEventInfo(event)
+	if ei == nil {
+		return false, fmt.Errorf("unknown notification event %s", event)
+	}
+
+	var prefs []models.NotificationPreference
+	err := models.NewNotificationPreferenceQuerySet(db).UserIDEq(userID).EventEq(event).All(&prefs)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to fetch notification preferences of user %d", userID)
+	}
+
+	if len(prefs) == 0 {
+		return ei.DefaultEnabled, nil
+	}
+
+	return prefs[0].Enabled, nil
+}
@@ -0,0 +1,32 @@
+package notifications
+
+import (
+	"testing"
+
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	ei := GetEventInfo(models.NotificationEventAnalysisFailed)
+	require.NotNil(t, ei)
+
+	subject, body, err := ei.Render(map[string]string{
+		"Repo":      "golangci/golangci-lint",
+		"ReportURL": "https://golangci.com/r/github.com/golangci/golangci-lint",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Analysis of golangci/golangci-lint failed", subject)
+	assert.Contains(t, body, "https://golangci.com/r/github.com/golangci/golangci-lint")
+
+	_, _, err = ei.Render(map[string]string{"Repo": "golangci/golangci-lint"})
+	assert.Error(t, err)
+}
+
+func TestGetEventInfo(t *testing.T) {
+	for _, ei := range Events {
+		assert.Equal(t, ei, GetEventInfo(ei.Event))
+	}
+	assert.Nil(t, GetEventInfo("unknown"))
+}
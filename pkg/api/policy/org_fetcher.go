@@ -7,6 +7,7 @@ import (
 	"github.com/golangci/golangci-api/internal/shared/cache"
 	"github.com/golangci/golangci-api/internal/shared/config"
 	"github.com/golangci/golangci-api/internal/shared/providers/provider"
+	"github.com/golangci/golangci-api/internal/shared/providers/ratelimit"
 	"github.com/golangci/golangci-api/pkg/api/request"
 	"github.com/pkg/errors"
 )
@@ -81,7 +82,9 @@ func (of orgMembershipFetcher) fetchCached(rc *request.AuthorizedContext, useCac
 }
 
 func (of orgMembershipFetcher) fetchFromProvider(rc *request.AuthorizedContext, p provider.Provider, orgName string) (*provider.OrgMembership, error) {
-	org, err := p.GetOrgMembershipByName(rc.Ctx, orgName)
+	// org membership is cached, low quota shouldn't be spent on refreshing it
+	ctx := ratelimit.ContextWithPriority(rc.Ctx, ratelimit.PriorityLow)
+	org, err := p.GetOrgMembershipByName(ctx, orgName)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to fetch org from provider by name %s", orgName)
 	}
@@ -0,0 +1,277 @@
+package reports
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/golangci/golangci-api/pkg/api/issues"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+type Format string
+
+const (
+	FormatJSON       Format = "json"
+	FormatCSV        Format = "csv"
+	FormatJUnit      Format = "junit"
+	FormatCheckstyle Format = "checkstyle"
+)
+
+const checkstyleVersion = "5.0"
+
+// Report is a rendered file with issues of an analysis
+type Report struct {
+	ContentType string
+	Extension   string
+	Body        []byte
+}
+
+// ParseFormat returns json format for the empty string
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(s); f {
+	case "":
+		return FormatJSON, nil
+	case FormatJSON, FormatCSV, FormatJUnit, FormatCheckstyle:
+		return f, nil
+	}
+
+	return "", fmt.Errorf("unknown report format %q", s)
+}
+
+// Build renders issues in the format
+func Build(format Format, reportIssues []models.Issue) (*Report, error) {
+	switch format {
+	case FormatJSON:
+		return buildJSON(reportIssues)
+	case FormatCSV:
+		return buildCSV(reportIssues)
+	case FormatJUnit:
+		return buildJUnit(reportIssues)
+	case FormatCheckstyle:
+		return buildCheckstyle(reportIssues)
+	}
+
+	return nil, fmt.Errorf("unknown report format %q", format)
+}
+
+// BuildFromResultJSON renders issues of the stored golangci-lint result of an analysis of the repo.
+// Suppressed issues aren't included: CI dashboards shouldn't show accepted issues.
+func BuildFromResultJSON(db *gorm.DB, format Format, resultJSON []byte, repoID uint) (*Report, error) {
+	analysisIssues, err := issues.Parse(resultJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	suppressed, err := issues.SuppressedSet(db, repoID)
+	if err != nil {
+		return nil, err
+	}
+
+	reportIssues := []models.Issue{}
+	for _, issue := range analysisIssues {
+		if !suppressed[issue.Fingerprint] {
+			reportIssues = append(reportIssues, issue)
+		}
+	}
+
+	return Build(format, reportIssues)
+}
+
+func position(issue *models.Issue) string {
+	if issue.ColumnNumber != 0 {
+		return fmt.Sprintf("%s:%d:%d", issue.File, issue.LineFrom, issue.ColumnNumber)
+	}
+
+	return fmt.Sprintf("%s:%d", issue.File, issue.LineFrom)
+}
+
+func buildJSON(reportIssues []models.Issue) (*Report, error) {
+	body, err := json.Marshal(struct {
+		Issues []models.Issue `json:"issues"`
+	}{reportIssues})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal issues")
+	}
+
+	return &Report{
+		ContentType: "application/json; charset=UTF-8",
+		Extension:   "json",
+		Body:        body,
+	}, nil
+}
+
+func buildCSV(reportIssues []models.Issue) (*Report, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	records := [][]string{{"linter", "file", "line_from", "line_to", "column", "severity", "text", "fingerprint"}}
+	for _, issue := range reportIssues {
+		records = append(records, []string{
+			csvCell(issue.FromLinter),
+			csvCell(issue.File),
+			strconv.Itoa(issue.LineFrom),
+			strconv.Itoa(issue.LineTo),
+			strconv.Itoa(issue.ColumnNumber),
+			csvCell(issue.Severity),
+			csvCell(issue.Text),
+			csvCell(issue.Fingerprint),
+		})
+	}
+
+	if err := w.WriteAll(records); err != nil {
+		return nil, errors.Wrap(err, "failed to write csv")
+	}
+
+	return &Report{
+		ContentType: "text/csv; charset=UTF-8",
+		Extension:   "csv",
+		Body:        buf.Bytes(),
+	}, nil
+}
+
+// csvCell prevents interpreting of the text as a formula when the report is opened in a spreadsheet
+func csvCell(s string) string {
+	if s != "" && strings.ContainsAny(s[:1], "=+-@") {
+		return "'" + s
+	}
+
+	return s
+}
+
+func marshalXML(v interface{}) ([]byte, error) {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal xml")
+	}
+
+	return append([]byte(xml.Header), body...), nil
+}
+
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Failures  int             `xml:"failures,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string       `xml:"name,attr"`
+	ClassName string       `xml:"classname,attr"`
+	Failure   junitFailure `xml:"failure"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Content string `xml:",cdata"`
+}
+
+// buildJUnit makes a test suite for every file and a failed test case for every issue,
+// like golangci-lint junit-xml output does
+func buildJUnit(reportIssues []models.Issue) (*Report, error) {
+	suites := junitTestSuites{TestSuites: []junitTestSuite{}}
+	suiteIndexByFile := map[string]int{}
+	for i := range reportIssues {
+		issue := &reportIssues[i]
+
+		idx, ok := suiteIndexByFile[issue.File]
+		if !ok {
+			idx = len(suites.TestSuites)
+			suiteIndexByFile[issue.File] = idx
+			suites.TestSuites = append(suites.TestSuites, junitTestSuite{Name: issue.File})
+		}
+
+		suite := &suites.TestSuites[idx]
+		suite.Tests++
+		suite.Failures++
+		suite.TestCases = append(suite.TestCases, junitTestCase{
+			Name:      issue.FromLinter,
+			ClassName: position(issue),
+			Failure: junitFailure{
+				Message: fmt.Sprintf("%s: %s", position(issue), issue.Text),
+				Type:    issue.Severity,
+				Content: issue.Text,
+			},
+		})
+	}
+
+	body, err := marshalXML(suites)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Report{
+		ContentType: "application/xml; charset=UTF-8",
+		Extension:   "xml",
+		Body:        body,
+	}, nil
+}
+
+type checkstyleOutput struct {
+	XMLName xml.Name          `xml:"checkstyle"`
+	Version string            `xml:"version,attr"`
+	Files   []*checkstyleFile `xml:"file"`
+}
+
+type checkstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []checkstyleError `xml:"error"`
+}
+
+type checkstyleError struct {
+	Column   int    `xml:"column,attr"`
+	Line     int    `xml:"line,attr"`
+	Message  string `xml:"message,attr"`
+	Severity string `xml:"severity,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+func buildCheckstyle(reportIssues []models.Issue) (*Report, error) {
+	out := checkstyleOutput{Version: checkstyleVersion}
+	fileByName := map[string]*checkstyleFile{}
+	for _, issue := range reportIssues {
+		file, ok := fileByName[issue.File]
+		if !ok {
+			file = &checkstyleFile{Name: issue.File}
+			fileByName[issue.File] = file
+			out.Files = append(out.Files, file)
+		}
+
+		severity := issue.Severity
+		if severity == "" {
+			severity = "error"
+		}
+
+		file.Errors = append(file.Errors, checkstyleError{
+			Column:   issue.ColumnNumber,
+			Line:     issue.LineFrom,
+			Message:  issue.Text,
+			Severity: severity,
+			Source:   issue.FromLinter,
+		})
+	}
+
+	body, err := marshalXML(out)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Report{
+		ContentType: "application/xml; charset=UTF-8",
+		Extension:   "xml",
+		Body:        body,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package reports
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testIssues = []models.Issue{
+	{FromLinter: "govet", File: "main.go", LineFrom: 10, LineTo: 10, ColumnNumber: 2, Text: "unreachable code", Fingerprint: "a"},
+	{FromLinter: "golint", File: "main.go", LineFrom: 3, LineTo: 3, Text: `comment on "Run" should be of the form`, Fingerprint: "b"},
+	{FromLinter: "errcheck", File: "a.go", LineFrom: 5, LineTo: 5, Text: "error return value is not checked", Fingerprint: "c", Severity: "warning"},
+}
+
+func TestParseFormat(t *testing.T) {
+	f, err := ParseFormat("")
+	require.NoError(t, err)
+	assert.Equal(t, FormatJSON, f)
+
+	f, err = ParseFormat("junit")
+	require.NoError(t, err)
+	assert.Equal(t, FormatJUnit, f)
+
+	_, err = ParseFormat("html")
+	assert.Error(t, err)
+}
+
+func TestBuildJUnit(t *testing.T) {
+	r, err := Build(FormatJUnit, testIssues)
+	require.NoError(t, err)
+
+	var suites junitTestSuites
+	require.NoError(t, xml.Unmarshal(r.Body, &suites))
+	require.Len(t, suites.TestSuites, 2)
+
+	suite := suites.TestSuites[0]
+	assert.Equal(t, "main.go", suite.Name)
+	assert.Equal(t, 2, suite.Failures)
+	require.Len(t, suite.TestCases, 2)
+	assert.Equal(t, "main.go:10:2", suite.TestCases[0].ClassName)
+	assert.Equal(t, "main.go:10:2: unreachable code", suite.TestCases[0].Failure.Message)
+}
+
+func TestBuildCheckstyle(t *testing.T) {
+	r, err := Build(FormatCheckstyle, testIssues)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(r.Body), xml.Header))
+
+	var out checkstyleOutput
+	require.NoError(t, xml.Unmarshal(r.Body, &out))
+	require.Len(t, out.Files, 2)
+	assert.Equal(t, []checkstyleError{
+		{Column: 2, Line: 10, Message: "unreachable code", Severity: "error", Source: "govet"},
+		{Line: 3, Message: `comment on "Run" should be of the form`, Severity: "error", Source: "golint"},
+	}, out.Files[0].Errors)
+	assert.Equal(t, "warning", out.Files[1].Errors[0].Severity)
+}
+
+func TestBuildCSV(t *testing.T) {
+	r, err := Build(FormatCSV, testIssues)
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(strings.NewReader(string(r.Body))).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, len(testIssues)+1)
+	assert.Equal(t, []string{"govet", "main.go", "10", "10", "2", "", "unreachable code", "a"}, records[1])
+}
+
+func TestBuildCSVEscapesFormulas(t *testing.T) {
+	r, err := Build(FormatCSV, []models.Issue{
+		{FromLinter: "govet", File: "=cmd.go", Text: "+1", Fingerprint: "a"},
+		{FromLinter: "govet", File: "main.go", Text: "-2 @x", Severity: "@warning", Fingerprint: "b"},
+	})
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(strings.NewReader(string(r.Body))).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, []string{"govet", "'=cmd.go", "0", "0", "0", "", "'+1", "a"}, records[1])
+	assert.Equal(t, []string{"govet", "main.go", "0", "0", "0", "'@warning", "'-2 @x", "b"}, records[2])
+}
+
+func TestBuildJSONWithoutIssues(t *testing.T) {
+	r, err := Build(FormatJSON, []models.Issue{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"issues":[]}`, string(r.Body))
+}
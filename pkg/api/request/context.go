@@ -23,6 +23,7 @@ type BaseContext struct {
 	Lctx logutil.Context
 	DB   *gorm.DB
 
+	RequestID string // unique id of the request: it's logged and saved into the audit log
 	StartedAt time.Time
 
 	SessCtx *session.RequestContext
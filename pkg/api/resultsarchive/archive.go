@@ -0,0 +1,133 @@
+package resultsarchive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/golangci/golangci-api/internal/shared/config"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/pkg/errors"
+)
+
+// ArchivedResultJSON is left in the db instead of the result moved to the archive
+var ArchivedResultJSON = []byte("null")
+
+// Archive keeps gzipped results of old analyzes out of the db,
+// they are loaded back only when they are requested.
+type Archive struct {
+	storage Storage
+}
+
+func NewArchive(storage Storage) *Archive {
+	return &Archive{
+		storage: storage,
+	}
+}
+
+// NewFromConfig returns nil archive if archiving of results is disabled.
+func NewFromConfig(cfg config.Config, awsSess *session.Session) (*Archive, error) {
+	var storage Storage
+	switch storageType := cfg.GetString("RESULTS_ARCHIVE_STORAGE"); storageType {
+	case "":
+		return nil, nil
+	case "fs":
+		root := cfg.GetString("RESULTS_ARCHIVE_FS_ROOT")
+		if root == "" {
+			return nil, errors.New("no RESULTS_ARCHIVE_FS_ROOT for fs results archive storage")
+		}
+		storage = NewFS(root)
+	case "s3":
+		bucket := cfg.GetString("RESULTS_ARCHIVE_S3_BUCKET")
+		if bucket == "" {
+			return nil, errors.New("no RESULTS_ARCHIVE_S3_BUCKET for s3 results archive storage")
+		}
+		storage = NewS3(awsSess, cfg.GetString("RESULTS_ARCHIVE_S3_ENDPOINT"), bucket, cfg.GetString("RESULTS_ARCHIVE_S3_PREFIX"))
+	default:
+		return nil, fmt.Errorf("invalid RESULTS_ARCHIVE_STORAGE %q", storageType)
+	}
+
+	return NewArchive(storage), nil
+}
+
+func RepoAnalysisKey(id uint) string {
+	return fmt.Sprintf("repo_analyzes/%d.json.gz", id)
+}
+
+func PullRequestAnalysisKey(id uint) string {
+	return fmt.Sprintf("pull_request_analyzes/%d.json.gz", id)
+}
+
+func (a Archive) Put(ctx context.Context, key string, resultJSON []byte) error {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(resultJSON); err != nil {
+		return errors.Wrap(err, "failed to gzip result")
+	}
+	if err := w.Close(); err != nil {
+		return errors.Wrap(err, "failed to finish gzipping of result")
+	}
+
+	return a.storage.Put(ctx, key, buf.Bytes())
+}
+
+func (a Archive) Load(ctx context.Context, key string) ([]byte, error) {
+	data, err := a.storage.Get(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get archived result %s", key)
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid gzip of archived result %s", key)
+	}
+	defer r.Close()
+
+	resultJSON, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to gunzip archived result %s", key)
+	}
+
+	return resultJSON, nil
+}
+
+func (a *Archive) load(ctx context.Context, key string) ([]byte, error) {
+	if a == nil {
+		return nil, fmt.Errorf("result was archived to %s but the results archive isn't configured", key)
+	}
+
+	return a.Load(ctx, key)
+}
+
+// LoadRepoAnalysisResult fills ResultJSON of the analysis if it was archived, the archive can be nil
+func (a *Archive) LoadRepoAnalysisResult(ctx context.Context, analysis *models.RepoAnalysis) error {
+	if analysis.ResultArchiveKey == "" {
+		return nil
+	}
+
+	resultJSON, err := a.load(ctx, analysis.ResultArchiveKey)
+	if err != nil {
+		return err
+	}
+
+	analysis.ResultJSON = resultJSON
+	return nil
+}
+
+// LoadPullRequestAnalysisResult fills ResultJSON of the analysis if it was archived, the archive can be nil
+func (a *Archive) LoadPullRequestAnalysisResult(ctx context.Context, analysis *models.PullRequestAnalysis) error {
+	if analysis.ResultArchiveKey == "" {
+		return nil
+	}
+
+	resultJSON, err := a.load(ctx, analysis.ResultArchiveKey)
+	if err != nil {
+		return err
+	}
+
+	analysis.ResultJSON = resultJSON
+	return nil
+}
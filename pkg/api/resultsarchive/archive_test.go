@@ -0,0 +1,47 @@
+package resultsarchive
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveWithFS(t *testing.T) {
+	root, err := ioutil.TempDir("", "resultsarchive")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	ctx := context.Background()
+	storage := NewFS(root)
+	a := NewArchive(storage)
+
+	key := RepoAnalysisKey(1)
+	resultJSON := []byte(`{"GolangciLintRes":{"Issues":[]}}`)
+	require.NoError(t, a.Put(ctx, key, resultJSON))
+
+	analysis := models.RepoAnalysis{ResultJSON: ArchivedResultJSON, ResultArchiveKey: key}
+	require.NoError(t, a.LoadRepoAnalysisResult(ctx, &analysis))
+	assert.Equal(t, string(resultJSON), string(analysis.ResultJSON))
+
+	require.NoError(t, storage.Delete(ctx, key))
+	_, err = a.Load(ctx, key)
+	assert.Equal(t, ErrNotFound, errors.Cause(err))
+}
+
+func TestLoadWithoutArchive(t *testing.T) {
+	var a *Archive
+	ctx := context.Background()
+
+	analysis := models.PullRequestAnalysis{ResultJSON: []byte("{}")}
+	require.NoError(t, a.LoadPullRequestAnalysisResult(ctx, &analysis))
+	assert.Equal(t, "{}", string(analysis.ResultJSON))
+
+	analysis.ResultArchiveKey = PullRequestAnalysisKey(1)
+	assert.Error(t, a.LoadPullRequestAnalysisResult(ctx, &analysis))
+}
@@ -0,0 +1,68 @@
+package resultsarchive
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// FS is a local filesystem storage, it's useful for development and single-host installations.
+type FS struct {
+	root string
+}
+
+var _ Storage = &FS{}
+
+func NewFS(root string) *FS {
+	return &FS{
+		root: root,
+	}
+}
+
+func (s FS) path(key string) string {
+	return filepath.Join(s.root, filepath.FromSlash(key))
+}
+
+func (s FS) Put(_ context.Context, key string, data []byte) error {
+	p := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(p), os.ModePerm); err != nil {
+		return errors.Wrapf(err, "failed to make dir for %s", p)
+	}
+
+	// write to the temp file first to not leave partially written blobs
+	tmpPath := p + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0644); err != nil {
+		return errors.Wrapf(err, "failed to write %s", tmpPath)
+	}
+
+	if err := os.Rename(tmpPath, p); err != nil {
+		return errors.Wrapf(err, "failed to rename %s to %s", tmpPath, p)
+	}
+
+	return nil
+}
+
+func (s FS) Get(_ context.Context, key string) ([]byte, error) {
+	p := s.path(key)
+	data, err := ioutil.ReadFile(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Wrapf(err, "failed to read %s", p)
+	}
+
+	return data, nil
+}
+
+func (s FS) Delete(_ context.Context, key string) error {
+	p := s.path(key)
+	if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to remove %s", p)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package resultsarchive
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pkg/errors"
+)
+
+// S3 is a storage working with AWS S3 and S3-compatible services (minio, etc).
+type S3 struct {
+	client *s3.S3
+	bucket string
+	prefix string
+}
+
+var _ Storage = &S3{}
+
+func NewS3(awsSess *session.Session, endpoint, bucket, prefix string) *S3 {
+	awsCfg := aws.NewConfig()
+	if endpoint != "" {
+		awsCfg = awsCfg.WithEndpoint(endpoint).WithS3ForcePathStyle(true)
+	}
+
+	return &S3{
+		client: s3.New(awsSess, awsCfg),
+		bucket: bucket,
+		prefix: strings.Trim(prefix, "/"),
+	}
+}
+
+func (s S3) objectKey(key string) string {
+	return path.Join(s.prefix, key)
+}
+
+func (s S3) Put(ctx context.Context, key string, data []byte) error {
+	objectKey := s.objectKey(key)
+	_, err := s.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(objectKey),
+		Body:   bytes.NewReader(data),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to put object %s", objectKey)
+	}
+
+	return nil
+}
+
+func (s S3) Get(ctx context.Context, key string) ([]byte, error) {
+	objectKey := s.objectKey(key)
+	out, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && (aerr.Code() == "NotFound" || aerr.Code() == s3.ErrCodeNoSuchKey) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Wrapf(err, "failed to get object %s", objectKey)
+	}
+	defer out.Body.Close()
+
+	data, err := ioutil.ReadAll(out.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read object %s", objectKey)
+	}
+
+	return data, nil
+}
+
+func (s S3) Delete(ctx context.Context, key string) error {
+	objectKey := s.objectKey(key)
+	_, err := s.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete object %s", objectKey)
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package resultsarchive
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+// Storage stores archived results of analyzes as blobs
+type Storage interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,232 @@
+package sarif
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/golangci/golangci-api/pkg/api/issues"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+const (
+	Version = "2.1.0"
+	Schema  = "https://json.schemastore.org/sarif-2.1.0.json"
+
+	toolName           = "golangci-lint"
+	toolInformationURI = "https://github.com/golangci/golangci-lint"
+
+	fingerprintKey = "golangciIssueFingerprint/v1"
+)
+
+// Log is a subset of SARIF 2.1.0 log needed to describe golangci-lint issues
+type Log struct {
+	Schema  string `json:"$schema"`
+	Version string `json:"version"`
+	Runs    []Run  `json:"runs"`
+}
+
+type Run struct {
+	Tool    Tool     `json:"tool"`
+	Results []Result `json:"results"`
+}
+
+type Tool struct {
+	Driver Driver `json:"driver"`
+}
+
+type Driver struct {
+	Name           string `json:"name"`
+	Version        string `json:"version,omitempty"`
+	InformationURI string `json:"informationUri"`
+	Rules          []Rule `json:"rules"`
+}
+
+type Rule struct {
+	ID               string  `json:"id"`
+	ShortDescription Message `json:"shortDescription"`
+}
+
+type Message struct {
+	Text string `json:"text"`
+}
+
+type Result struct {
+	RuleID              string            `json:"ruleId"`
+	RuleIndex           int               `json:"ruleIndex"`
+	Level               string            `json:"level"`
+	Message             Message           `json:"message"`
+	Locations           []Location        `json:"locations"`
+	PartialFingerprints map[string]string `json:"partialFingerprints"`
+	Fixes               []Fix             `json:"fixes,omitempty"`
+	Suppressions        []Suppression     `json:"suppressions,omitempty"`
+}
+
+type Location struct {
+	PhysicalLocation PhysicalLocation `json:"physicalLocation"`
+}
+
+type PhysicalLocation struct {
+	ArtifactLocation ArtifactLocation `json:"artifactLocation"`
+	Region           Region           `json:"region"`
+}
+
+type ArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type Region struct {
+	StartLine   int `json:"startLine"`
+	EndLine     int `json:"endLine,omitempty"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+type Fix struct {
+	ArtifactChanges []ArtifactChange `json:"artifactChanges"`
+}
+
+type ArtifactChange struct {
+	ArtifactLocation ArtifactLocation `json:"artifactLocation"`
+	Replacements     []Replacement    `json:"replacements"`
+}
+
+type Replacement struct {
+	DeletedRegion   Region   `json:"deletedRegion"`
+	InsertedContent *Message `json:"insertedContent,omitempty"`
+}
+
+type Suppression struct {
+	Kind string `json:"kind"`
+}
+
+// lintReplacement is a replacement from golangci-lint JSON output
+type lintReplacement struct {
+	NeedOnlyDelete bool
+	NewLines       []string
+}
+
+func severityToLevel(severity string) string {
+	switch strings.ToLower(severity) {
+	case "error":
+		return "error"
+	case "info", "note":
+		return "note"
+	default:
+		return "warning"
+	}
+}
+
+func buildFix(issue *models.Issue) (*Fix, error) {
+	var r lintReplacement
+	if err := json.Unmarshal(issue.Replacement, &r); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal replacement")
+	}
+
+	replacement := Replacement{
+		DeletedRegion: Region{
+			StartLine: issue.LineFrom,
+			EndLine:   issue.LineTo,
+		},
+	}
+	if !r.NeedOnlyDelete {
+		replacement.InsertedContent = &Message{Text: strings.Join(r.NewLines, "\n") + "\n"}
+	}
+
+	return &Fix{
+		ArtifactChanges: []ArtifactChange{
+			{
+				ArtifactLocation: ArtifactLocation{URI: issue.File},
+				Replacements:     []Replacement{replacement},
+			},
+		},
+	}, nil
+}
+
+// Build makes SARIF log with one run of golangci-lint: every linter is a rule.
+// Issues with suppressed fingerprints are marked as externally suppressed.
+func Build(lintIssues []models.Issue, suppressed map[string]bool, toolVersion string) (*Log, error) {
+	run := Run{
+		Tool: Tool{
+			Driver: Driver{
+				Name:           toolName,
+				Version:        toolVersion,
+				InformationURI: toolInformationURI,
+				Rules:          []Rule{},
+			},
+		},
+		Results: []Result{},
+	}
+
+	ruleIndexes := map[string]int{}
+	for i := range lintIssues {
+		issue := &lintIssues[i]
+
+		ruleIndex, ok := ruleIndexes[issue.FromLinter]
+		if !ok {
+			ruleIndex = len(run.Tool.Driver.Rules)
+			ruleIndexes[issue.FromLinter] = ruleIndex
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, Rule{
+				ID:               issue.FromLinter,
+				ShortDescription: Message{Text: issue.FromLinter},
+			})
+		}
+
+		res := Result{
+			RuleID:    issue.FromLinter,
+			RuleIndex: ruleIndex,
+			Level:     severityToLevel(issue.Severity),
+			Message:   Message{Text: issue.Text},
+			Locations: []Location{
+				{
+					PhysicalLocation: PhysicalLocation{
+						ArtifactLocation: ArtifactLocation{URI: issue.File},
+						Region: Region{
+							StartLine:   issue.LineFrom,
+							EndLine:     issue.LineTo,
+							StartColumn: issue.ColumnNumber,
+						},
+					},
+				},
+			},
+			PartialFingerprints: map[string]string{
+				fingerprintKey: issue.Fingerprint,
+			},
+		}
+
+		if len(issue.Replacement) != 0 {
+			fix, err := buildFix(issue)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to build fix for issue %s:%d", issue.File, issue.LineFrom)
+			}
+			res.Fixes = []Fix{*fix}
+		}
+
+		if suppressed[issue.Fingerprint] {
+			res.Suppressions = []Suppression{{Kind: "external"}}
+		}
+
+		run.Results = append(run.Results, res)
+	}
+
+	return &Log{
+		Schema:  Schema,
+		Version: Version,
+		Runs:    []Run{run},
+	}, nil
+}
+
+// BuildFromResultJSON builds SARIF log from the stored golangci-lint result of an analysis of the repo
+func BuildFromResultJSON(db *gorm.DB, resultJSON []byte, repoID uint, toolVersion string) (*Log, error) {
+	analysisIssues, err := issues.Parse(resultJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	suppressed, err := issues.SuppressedSet(db, repoID)
+	if err != nil {
+		return nil, err
+	}
+
+	return Build(analysisIssues, suppressed, toolVersion)
+}
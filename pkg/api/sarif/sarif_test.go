@@ -0,0 +1,45 @@
+package sarif
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuild(t *testing.T) {
+	issues := []models.Issue{
+		{FromLinter: "govet", File: "main.go", LineFrom: 10, LineTo: 10, ColumnNumber: 2, Text: "unreachable code", Fingerprint: "a"},
+		{FromLinter: "gofmt", File: "a.go", LineFrom: 3, LineTo: 4, Text: "File is not gofmt-ed", Fingerprint: "b",
+			Replacement: json.RawMessage(`{"NeedOnlyDelete":false,"NewLines":["x := 1","y := 2"]}`)},
+		{FromLinter: "govet", File: "b.go", LineFrom: 1, LineTo: 1, Text: "unused result", Fingerprint: "c", Severity: "error"},
+	}
+
+	log, err := Build(issues, map[string]bool{"c": true}, "v1.20.0")
+	require.NoError(t, err)
+	assert.Equal(t, Version, log.Version)
+	require.Len(t, log.Runs, 1)
+
+	run := log.Runs[0]
+	assert.Equal(t, "v1.20.0", run.Tool.Driver.Version)
+	assert.Equal(t, []Rule{
+		{ID: "govet", ShortDescription: Message{Text: "govet"}},
+		{ID: "gofmt", ShortDescription: Message{Text: "gofmt"}},
+	}, run.Tool.Driver.Rules)
+
+	require.Len(t, run.Results, 3)
+	assert.Equal(t, 1, run.Results[1].RuleIndex)
+	assert.Equal(t, "warning", run.Results[0].Level)
+	assert.Equal(t, Region{StartLine: 10, EndLine: 10, StartColumn: 2}, run.Results[0].Locations[0].PhysicalLocation.Region)
+
+	require.Len(t, run.Results[1].Fixes, 1)
+	replacement := run.Results[1].Fixes[0].ArtifactChanges[0].Replacements[0]
+	assert.Equal(t, Region{StartLine: 3, EndLine: 4}, replacement.DeletedRegion)
+	assert.Equal(t, "x := 1\ny := 2\n", replacement.InsertedContent.Text)
+
+	assert.Equal(t, "error", run.Results[2].Level)
+	assert.Equal(t, []Suppression{{Kind: "external"}}, run.Results[2].Suppressions)
+	assert.Empty(t, run.Results[0].Suppressions)
+}
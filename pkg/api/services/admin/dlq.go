@@ -0,0 +1,203 @@
+package admin
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awssqs "github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/golangci/golangci-api/internal/api/apierrors"
+	"github.com/golangci/golangci-api/pkg/api/request"
+	"github.com/pkg/errors"
+)
+
+const (
+	maxDeadLetters = 100
+
+	// received messages are hidden from the dead letter consumer while we process them
+	deadLettersVisibilityTimeoutSec = 60
+)
+
+type deadLetter struct {
+	DeadLetterMessage
+	body          string
+	receiptHandle string
+}
+
+func parseDeadLetter(m *awssqs.Message) deadLetter {
+	ret := deadLetter{
+		DeadLetterMessage: DeadLetterMessage{
+			MessageID: aws.StringValue(m.MessageId),
+		},
+		body:          aws.StringValue(m.Body),
+		receiptHandle: aws.StringValue(m.ReceiptHandle),
+	}
+
+	// the message is multiplexed, see consumers.Multiplexer
+	var sm struct {
+		SubqueueID string
+		Message    json.RawMessage
+	}
+	if err := json.Unmarshal([]byte(ret.body), &sm); err == nil {
+		ret.SubqueueID = sm.SubqueueID
+		ret.Message = sm.Message
+	}
+
+	if v := m.Attributes[awssqs.MessageSystemAttributeNameApproximateReceiveCount]; v != nil {
+		ret.ReceiveCount, _ = strconv.Atoi(*v)
+	}
+	if v := m.Attributes[awssqs.MessageSystemAttributeNameSentTimestamp]; v != nil {
+		if ms, err := strconv.ParseInt(*v, 10, 64); err == nil {
+			ret.SentAt = time.Unix(0, ms*int64(time.Millisecond))
+		}
+	}
+
+	return ret
+}
+
+func (s BasicService) receiveDeadLetters() ([]deadLetter, error) {
+	if s.DeadLetterQueue == nil {
+		return nil, errors.New("no dead letter queue")
+	}
+
+	var ret []deadLetter
+	seen := map[string]bool{}
+	for len(ret) < maxDeadLetters {
+		messages, err := s.DeadLetterQueue.ReceiveBatch(10, deadLettersVisibilityTimeoutSec)
+		if err != nil {
+			return ret, err
+		}
+		if len(messages) == 0 {
+			break
+		}
+
+		for _, m := range messages {
+			dl := parseDeadLetter(m)
+			if seen[dl.MessageID] {
+				continue
+			}
+			seen[dl.MessageID] = true
+			ret = append(ret, dl)
+		}
+	}
+
+	return ret, nil
+}
+
+func (s BasicService) releaseDeadLetters(rc *request.AuthorizedContext, letters []deadLetter) {
+	for _, dl := range letters {
+		if err := s.DeadLetterQueue.Release(dl.receiptHandle); err != nil {
+			rc.Log.Warnf("Failed to release dead letter message %s: %s", dl.MessageID, err)
+		}
+	}
+}
+
+func (s BasicService) ListDeadLetters(rc *request.AuthorizedContext, f *DeadLetterFilter) (*DeadLetterMessages, error) {
+	if err := s.checkAdmin(rc); err != nil {
+		return nil, err
+	}
+
+	letters, err := s.receiveDeadLetters()
+	defer s.releaseDeadLetters(rc, letters)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := DeadLetterMessages{Messages: []DeadLetterMessage{}}
+	for _, dl := range letters {
+		if f.SubqueueID == "" || f.SubqueueID == dl.SubqueueID {
+			ret.Messages = append(ret.Messages, dl.DeadLetterMessage)
+		}
+	}
+
+	return &ret, nil
+}
+
+func (sel DeadLetterSelector) matches(dl *deadLetter) bool {
+	if sel.SubqueueID != "" && sel.SubqueueID != dl.SubqueueID {
+		return false
+	}
+
+	if len(sel.MessageIDs) == 0 {
+		return true
+	}
+
+	for _, id := range sel.MessageIDs {
+		if id == dl.MessageID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// processDeadLetters calls process for every selected message and deletes it from the dead letter queue
+func (s BasicService) processDeadLetters(rc *request.AuthorizedContext, sel *DeadLetterSelector,
+	process func(dl *deadLetter) error) (*DeadLetterResult, error) {
+
+	if err := s.checkAdmin(rc); err != nil {
+		return nil, err
+	}
+
+	if sel.SubqueueID == "" && len(sel.MessageIDs) == 0 {
+		return nil, errors.Wrap(apierrors.ErrBadRequest, "subqueue id or message ids must be set")
+	}
+
+	letters, err := s.receiveDeadLetters()
+	if err != nil {
+		s.releaseDeadLetters(rc, letters)
+		return nil, err
+	}
+
+	var notProcessed []deadLetter
+	ret := DeadLetterResult{MessageIDs: []string{}}
+	for i := range letters {
+		dl := &letters[i]
+		if !sel.matches(dl) {
+			notProcessed = append(notProcessed, *dl)
+			continue
+		}
+
+		if err = process(dl); err != nil {
+			rc.Log.Warnf("Failed to process dead letter message %s: %s", dl.MessageID, err)
+			notProcessed = append(notProcessed, *dl)
+			continue
+		}
+
+		if err = s.DeadLetterQueue.Delete(dl.receiptHandle); err != nil {
+			// the message will be processed again, it's ok for the admin API
+			rc.Log.Warnf("Failed to delete dead letter message %s: %s", dl.MessageID, err)
+			continue
+		}
+
+		ret.MessageIDs = append(ret.MessageIDs, dl.MessageID)
+	}
+
+	s.releaseDeadLetters(rc, notProcessed)
+	return &ret, nil
+}
+
+func (s BasicService) ReplayDeadLetters(rc *request.AuthorizedContext, sel *DeadLetterSelector) (*DeadLetterResult, error) {
+	res, err := s.processDeadLetters(rc, sel, func(dl *deadLetter) error {
+		return s.PrimaryQueue.PutRaw(dl.body)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rc.Log.Infof("Admin %d replayed %d dead letter messages: %v", rc.User.ID, len(res.MessageIDs), res.MessageIDs)
+	return res, nil
+}
+
+func (s BasicService) DropDeadLetters(rc *request.AuthorizedContext, sel *DeadLetterSelector) (*DeadLetterResult, error) {
+	res, err := s.processDeadLetters(rc, sel, func(dl *deadLetter) error {
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rc.Log.Infof("Admin %d dropped %d dead letter messages: %v", rc.User.ID, len(res.MessageIDs), res.MessageIDs)
+	return res, nil
+}
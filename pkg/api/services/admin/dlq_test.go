@@ -0,0 +1,200 @@
+package admin
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awssqs "github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/golangci/golangci-api/internal/api/apierrors"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/pkg/api/auth"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/api/request"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDeadLetterQueue struct {
+	messages []*awssqs.Message
+	received bool
+
+	deleted  []string
+	released []string
+}
+
+func (q *fakeDeadLetterQueue) ReceiveBatch(maxCount, _ int) ([]*awssqs.Message, error) {
+	if q.received { // received messages are invisible
+		return nil, nil
+	}
+
+	q.received = true
+	return q.messages, nil
+}
+
+func (q *fakeDeadLetterQueue) Delete(receiptHandle string) error {
+	q.deleted = append(q.deleted, receiptHandle)
+	return nil
+}
+
+func (q *fakeDeadLetterQueue) Release(receiptHandle string) error {
+	q.released = append(q.released, receiptHandle)
+	return nil
+}
+
+type fakeRawQueue struct {
+	bodies []string
+	err    error
+}
+
+func (q *fakeRawQueue) PutRaw(body string) error {
+	if q.err != nil {
+		return q.err
+	}
+
+	q.bodies = append(q.bodies, body)
+	return nil
+}
+
+type fakePauseStorage struct {
+	paused map[string]bool
+}
+
+func (s *fakePauseStorage) IsPaused(queue string) (bool, error) {
+	return s.paused[queue], nil
+}
+
+func (s *fakePauseStorage) SetPaused(queue string, paused bool) error {
+	s.paused[queue] = paused
+	return nil
+}
+
+func makeDeadLetter(id, subqueueID string) *awssqs.Message {
+	return &awssqs.Message{
+		MessageId:     aws.String(id),
+		ReceiptHandle: aws.String("handle-" + id),
+		Body:          aws.String(fmt.Sprintf(`{"SubqueueID":%q,"Message":{"ID":%q}}`, subqueueID, id)),
+		Attributes: map[string]*string{
+			awssqs.MessageSystemAttributeNameApproximateReceiveCount: aws.String("5"),
+		},
+	}
+}
+
+func newTestService() (*BasicService, *fakeDeadLetterQueue, *fakeRawQueue) {
+	dlq := &fakeDeadLetterQueue{
+		messages: []*awssqs.Message{
+			makeDeadLetter("1", "repoanalyzes/launch"),
+			makeDeadLetter("2", "emails/send"),
+			makeDeadLetter("3", "repoanalyzes/launch"),
+		},
+	}
+	primary := &fakeRawQueue{}
+	return &BasicService{
+		PrimaryQueue:    primary,
+		DeadLetterQueue: dlq,
+		PauseStorage:    &fakePauseStorage{paused: map[string]bool{}},
+		Queues:          []string{"primary"},
+	}, dlq, primary
+}
+
+func newTestContext(isAdmin bool) *request.AuthorizedContext {
+	return &request.AuthorizedContext{
+		BaseContext: request.BaseContext{
+			Log: logutil.NewStderrLog("test"),
+		},
+		AuthenticatedUser: auth.AuthenticatedUser{
+			User: &models.User{IsAdmin: isAdmin},
+		},
+	}
+}
+
+func TestNonAdminIsForbidden(t *testing.T) {
+	s, dlq, primary := newTestService()
+	rc := newTestContext(false)
+	sel := &DeadLetterSelector{SubqueueID: "repoanalyzes/launch"}
+	q := &QueueName{Name: "primary"}
+
+	calls := map[string]func() error{
+		"ListPullAnalyses":  func() error { _, err := s.ListPullAnalyses(rc, &AnalysesFilter{}); return err },
+		"ListRepoAnalyses":  func() error { _, err := s.ListRepoAnalyses(rc, &AnalysesFilter{}); return err },
+		"RerunPullAnalysis": func() error { return s.RerunPullAnalysis(rc, &AnalysisID{GUID: "guid"}) },
+		"RerunRepoAnalysis": func() error { return s.RerunRepoAnalysis(rc, &AnalysisID{GUID: "guid"}) },
+		"ListDeadLetters":   func() error { _, err := s.ListDeadLetters(rc, &DeadLetterFilter{}); return err },
+		"ReplayDeadLetters": func() error { _, err := s.ReplayDeadLetters(rc, sel); return err },
+		"DropDeadLetters":   func() error { _, err := s.DropDeadLetters(rc, sel); return err },
+		"ListQueues":        func() error { _, err := s.ListQueues(rc); return err },
+		"PauseQueue":        func() error { return s.PauseQueue(rc, q) },
+		"ResumeQueue":       func() error { return s.ResumeQueue(rc, q) },
+	}
+	for name, call := range calls {
+		assert.Equal(t, errNotAdmin, call(), name)
+	}
+
+	assert.False(t, dlq.received)
+	assert.Empty(t, primary.bodies)
+	paused, err := s.PauseStorage.IsPaused("primary")
+	require.NoError(t, err)
+	assert.False(t, paused)
+}
+
+func TestListDeadLetters(t *testing.T) {
+	s, dlq, _ := newTestService()
+
+	res, err := s.ListDeadLetters(newTestContext(true), &DeadLetterFilter{SubqueueID: "repoanalyzes/launch"})
+	require.NoError(t, err)
+
+	require.Len(t, res.Messages, 2)
+	assert.Equal(t, "1", res.Messages[0].MessageID)
+	assert.Equal(t, "3", res.Messages[1].MessageID)
+	assert.Equal(t, 5, res.Messages[0].ReceiveCount)
+	assert.JSONEq(t, `{"ID":"1"}`, string(res.Messages[0].Message))
+
+	// listing must not consume messages
+	assert.Empty(t, dlq.deleted)
+	assert.ElementsMatch(t, []string{"handle-1", "handle-2", "handle-3"}, dlq.released)
+}
+
+func TestReplayDeadLetters(t *testing.T) {
+	s, dlq, primary := newTestService()
+
+	res, err := s.ReplayDeadLetters(newTestContext(true), &DeadLetterSelector{SubqueueID: "repoanalyzes/launch"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"1", "3"}, res.MessageIDs)
+	assert.Equal(t, []string{aws.StringValue(dlq.messages[0].Body), aws.StringValue(dlq.messages[2].Body)}, primary.bodies)
+	assert.Equal(t, []string{"handle-1", "handle-3"}, dlq.deleted)
+	assert.Equal(t, []string{"handle-2"}, dlq.released)
+}
+
+func TestReplayDeadLettersKeepsFailedMessages(t *testing.T) {
+	s, dlq, primary := newTestService()
+	primary.err = errors.New("sqs is down")
+
+	res, err := s.ReplayDeadLetters(newTestContext(true), &DeadLetterSelector{MessageIDs: []string{"2"}})
+	require.NoError(t, err)
+
+	assert.Empty(t, res.MessageIDs)
+	assert.Empty(t, dlq.deleted)
+	assert.ElementsMatch(t, []string{"handle-1", "handle-2", "handle-3"}, dlq.released)
+}
+
+func TestDropDeadLetters(t *testing.T) {
+	s, dlq, primary := newTestService()
+
+	res, err := s.DropDeadLetters(newTestContext(true), &DeadLetterSelector{MessageIDs: []string{"2", "3"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"2", "3"}, res.MessageIDs)
+	assert.Empty(t, primary.bodies)
+	assert.Equal(t, []string{"handle-2", "handle-3"}, dlq.deleted)
+	assert.Equal(t, []string{"handle-1"}, dlq.released)
+}
+
+func TestDeadLettersSelectorIsRequired(t *testing.T) {
+	s, dlq, _ := newTestService()
+
+	_, err := s.DropDeadLetters(newTestContext(true), &DeadLetterSelector{})
+	assert.Equal(t, apierrors.ErrBadRequest, errors.Cause(err))
+	assert.False(t, dlq.received)
+}
@@ -0,0 +1,409 @@
+package admin
+
+import (
+	"encoding/json"
+	"time"
+
+	awssqs "github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/golangci/golangci-api/internal/api/apierrors"
+	"github.com/golangci/golangci-api/internal/shared/config"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/queue/aws/sqs"
+	"github.com/golangci/golangci-api/internal/shared/queue/pause"
+	"github.com/golangci/golangci-api/pkg/api/crons/pranalyzes"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/api/request"
+	"github.com/golangci/golangci-api/pkg/api/workers/primaryqueue/repoanalyzes"
+	"github.com/golangci/golangci-api/pkg/worker/analyze/analyzesqueue/pullanalyzesqueue"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+)
+
+const maxListedAnalyses = 1000
+
+var inFlightStatuses = []string{"sent_to_queue", "processing"}
+
+type AnalysesFilter struct {
+	StaleOnly bool `request:"stale,urlParam,optional"`
+}
+
+func (f AnalysesFilter) FillLogContext(lctx logutil.Context) {
+	lctx["stale_only"] = f.StaleOnly
+}
+
+type PullAnalysis struct {
+	GUID              string
+	RepoName          string
+	PullRequestNumber int
+	CommitSHA         string
+	Status            string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	IsStale           bool
+}
+
+type PullAnalyses struct {
+	Analyses []PullAnalysis
+}
+
+type RepoAnalysis struct {
+	GUID           string
+	RepoName       string
+	CommitSHA      string
+	Status         string
+	AttemptNumber  int
+	LintersVersion string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	IsStale        bool
+}
+
+type RepoAnalyses struct {
+	Analyses []RepoAnalysis
+}
+
+type AnalysisID struct {
+	GUID string `request:"guid,urlPart,"`
+}
+
+func (id AnalysisID) FillLogContext(lctx logutil.Context) {
+	lctx["analysis_guid"] = id.GUID
+}
+
+type DeadLetterFilter struct {
+	SubqueueID string `request:"subqueue,urlParam,optional"`
+}
+
+func (f DeadLetterFilter) FillLogContext(lctx logutil.Context) {
+	lctx["subqueue_id"] = f.SubqueueID
+}
+
+type DeadLetterMessage struct {
+	MessageID    string
+	SubqueueID   string
+	ReceiveCount int
+	SentAt       time.Time
+	Message      json.RawMessage
+}
+
+type DeadLetterMessages struct {
+	Messages []DeadLetterMessage
+}
+
+// DeadLetterSelector selects dead letter messages by the subqueue and/or message ids
+type DeadLetterSelector struct {
+	SubqueueID string
+	MessageIDs []string
+}
+
+func (s DeadLetterSelector) FillLogContext(lctx logutil.Context) {
+	lctx["subqueue_id"] = s.SubqueueID
+	lctx["message_ids"] = s.MessageIDs
+}
+
+type DeadLetterResult struct {
+	MessageIDs []string
+}
+
+type QueueName struct {
+	Name string `request:"name,urlPart,"`
+}
+
+func (q QueueName) FillLogContext(lctx logutil.Context) {
+	lctx["queue"] = q.Name
+}
+
+type QueueState struct {
+	Name     string
+	IsPaused bool
+}
+
+type QueueStates struct {
+	Queues []QueueState
+}
+
+type Service interface {
+	//url:/v1/admin/analyses/pulls
+	ListPullAnalyses(rc *request.AuthorizedContext, f *AnalysesFilter) (*PullAnalyses, error)
+
+	//url:/v1/admin/analyses/repos
+	ListRepoAnalyses(rc *request.AuthorizedContext, f *AnalysesFilter) (*RepoAnalyses, error)
+
+	//url:/v1/admin/analyses/pulls/{guid}/rerun method:POST
+	RerunPullAnalysis(rc *request.AuthorizedContext, id *AnalysisID) error
+
+	//url:/v1/admin/analyses/repos/{guid}/rerun method:POST
+	RerunRepoAnalysis(rc *request.AuthorizedContext, id *AnalysisID) error
+
+	//url:/v1/admin/dlq
+	ListDeadLetters(rc *request.AuthorizedContext, f *DeadLetterFilter) (*DeadLetterMessages, error)
+
+	//url:/v1/admin/dlq/replay method:POST
+	ReplayDeadLetters(rc *request.AuthorizedContext, s *DeadLetterSelector) (*DeadLetterResult, error)
+
+	//url:/v1/admin/dlq/drop method:POST
+	DropDeadLetters(rc *request.AuthorizedContext, s *DeadLetterSelector) (*DeadLetterResult, error)
+
+	//url:/v1/admin/queues
+	ListQueues(rc *request.AuthorizedContext) (*QueueStates, error)
+
+	//url:/v1/admin/queues/{name}/pause method:PUT
+	PauseQueue(rc *request.AuthorizedContext, q *QueueName) error
+
+	//url:/v1/admin/queues/{name}/resume method:PUT
+	ResumeQueue(rc *request.AuthorizedContext, q *QueueName) error
+}
+
+// RawQueue puts already serialized messages, it's implemented by sqs.Queue
+type RawQueue interface {
+	PutRaw(body string) error
+}
+
+// DeadLetterQueue is implemented by sqs.Queue
+type DeadLetterQueue interface {
+	ReceiveBatch(maxCount, visibilityTimeoutSec int) ([]*awssqs.Message, error)
+	Delete(receiptHandle string) error
+	Release(receiptHandle string) error
+}
+
+var _ RawQueue = &sqs.Queue{}
+var _ DeadLetterQueue = &sqs.Queue{}
+
+type BasicService struct {
+	Cfg                   config.Config
+	PullAnalyzesRunner    *pullanalyzesqueue.Producer
+	AnalysisLauncherQueue *repoanalyzes.LauncherProducer
+
+	PrimaryQueue    RawQueue
+	DeadLetterQueue DeadLetterQueue // dead letter queue of the primary queue
+
+	PauseStorage pause.Storage
+	Queues       []string // names of queues which consuming can be paused
+}
+
+var errNotAdmin = apierrors.NewForbiddenError("NOT_ADMIN")
+
+func (s BasicService) checkAdmin(rc *request.AuthorizedContext) error {
+	if !rc.User.IsAdmin {
+		rc.Log.Warnf("Non-admin user %d tried to access admin API", rc.User.ID)
+		return errNotAdmin
+	}
+
+	return nil
+}
+
+func (s BasicService) staleTimeout() time.Duration {
+	return s.Cfg.GetDuration("ADMIN_STALE_ANALYSIS_TIMEOUT", 30*time.Minute)
+}
+
+func (s BasicService) ListPullAnalyses(rc *request.AuthorizedContext, f *AnalysesFilter) (*PullAnalyses, error) {
+	if err := s.checkAdmin(rc); err != nil {
+		return nil, err
+	}
+
+	staleBefore := time.Now().Add(-s.staleTimeout())
+	qs := models.NewPullRequestAnalysisQuerySet(rc.DB).StatusIn(inFlightStatuses...)
+	if f.StaleOnly {
+		qs = qs.CreatedAtLt(staleBefore)
+	}
+
+	var analyzes []models.PullRequestAnalysis
+	if err := qs.OrderDescByID().Limit(maxListedAnalyses).All(&analyzes); err != nil {
+		return nil, errors.Wrap(err, "failed to fetch pull request analyzes")
+	}
+
+	var repoIDs []uint
+	for _, a := range analyzes {
+		repoIDs = append(repoIDs, a.RepoID)
+	}
+	repoNames, err := fetchRepoNames(rc.DB, repoIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := PullAnalyses{Analyses: []PullAnalysis{}}
+	for _, a := range analyzes {
+		ret.Analyses = append(ret.Analyses, PullAnalysis{
+			GUID:              a.GithubDeliveryGUID,
+			RepoName:          repoNames[a.RepoID],
+			PullRequestNumber: a.PullRequestNumber,
+			CommitSHA:         a.CommitSHA,
+			Status:            a.Status,
+			CreatedAt:         a.CreatedAt,
+			UpdatedAt:         a.UpdatedAt,
+			IsStale:           a.CreatedAt.Before(staleBefore),
+		})
+	}
+
+	return &ret, nil
+}
+
+func (s BasicService) ListRepoAnalyses(rc *request.AuthorizedContext, f *AnalysesFilter) (*RepoAnalyses, error) {
+	if err := s.checkAdmin(rc); err != nil {
+		return nil, err
+	}
+
+	staleBefore := time.Now().Add(-s.staleTimeout())
+	qs := models.NewRepoAnalysisQuerySet(rc.DB).StatusIn(inFlightStatuses...)
+	if f.StaleOnly {
+		qs = qs.CreatedAtLt(staleBefore)
+	}
+
+	var analyzes []models.RepoAnalysis
+	if err := qs.OrderDescByID().Limit(maxListedAnalyses).PreloadRepoAnalysisStatus().All(&analyzes); err != nil {
+		return nil, errors.Wrap(err, "failed to fetch repo analyzes")
+	}
+
+	var repoIDs []uint
+	for _, a := range analyzes {
+		repoIDs = append(repoIDs, a.RepoAnalysisStatus.RepoID)
+	}
+	repoNames, err := fetchRepoNames(rc.DB, repoIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := RepoAnalyses{Analyses: []RepoAnalysis{}}
+	for _, a := range analyzes {
+		ret.Analyses = append(ret.Analyses, RepoAnalysis{
+			GUID:           a.AnalysisGUID,
+			RepoName:       repoNames[a.RepoAnalysisStatus.RepoID],
+			CommitSHA:      a.CommitSHA,
+			Status:         a.Status,
+			AttemptNumber:  a.AttemptNumber,
+			LintersVersion: a.LintersVersion,
+			CreatedAt:      a.CreatedAt,
+			UpdatedAt:      a.UpdatedAt,
+			IsStale:        a.CreatedAt.Before(staleBefore),
+		})
+	}
+
+	return &ret, nil
+}
+
+func fetchRepoNames(db *gorm.DB, repoIDs []uint) (map[uint]string, error) {
+	ret := map[uint]string{}
+	if len(repoIDs) == 0 {
+		return ret, nil
+	}
+
+	var repos []models.Repo
+	if err := models.NewRepoQuerySet(db.Unscoped()).IDIn(repoIDs...).All(&repos); err != nil {
+		return nil, errors.Wrap(err, "failed to fetch repos")
+	}
+
+	for _, r := range repos {
+		ret[r.ID] = r.FullNameWithProvider()
+	}
+
+	return ret, nil
+}
+
+func (s BasicService) RerunPullAnalysis(rc *request.AuthorizedContext, id *AnalysisID) error {
+	if err := s.checkAdmin(rc); err != nil {
+		return err
+	}
+
+	var a models.PullRequestAnalysis
+	if err := models.NewPullRequestAnalysisQuerySet(rc.DB).GithubDeliveryGUIDEq(id.GUID).One(&a); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.Wrapf(apierrors.ErrNotFound, "no pull request analysis %s", id.GUID)
+		}
+		return errors.Wrapf(err, "failed to fetch pull request analysis %s", id.GUID)
+	}
+
+	var repo models.Repo
+	if err := models.NewRepoQuerySet(rc.DB).IDEq(a.RepoID).One(&repo); err != nil {
+		return errors.Wrapf(err, "failed to fetch repo %d", a.RepoID)
+	}
+
+	if err := pranalyzes.RestartAnalysis(rc.DB, s.PullAnalyzesRunner, &a, &repo); err != nil {
+		return errors.Wrapf(err, "failed to restart pull request analysis %s", id.GUID)
+	}
+
+	rc.Log.Infof("Admin %d forced rerun of pull request analysis %s of %s#%d",
+		rc.User.ID, id.GUID, repo.FullName, a.PullRequestNumber)
+	return nil
+}
+
+func (s BasicService) RerunRepoAnalysis(rc *request.AuthorizedContext, id *AnalysisID) error {
+	if err := s.checkAdmin(rc); err != nil {
+		return err
+	}
+
+	var a models.RepoAnalysis
+	err := models.NewRepoAnalysisQuerySet(rc.DB).AnalysisGUIDEq(id.GUID).PreloadRepoAnalysisStatus().One(&a)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.Wrapf(apierrors.ErrNotFound, "no repo analysis %s", id.GUID)
+		}
+		return errors.Wrapf(err, "failed to fetch repo analysis %s", id.GUID)
+	}
+
+	// launch a new analysis of the same commit: launcher creates all needed db entries
+	newGUID := uuid.NewV4().String()
+	if err = s.AnalysisLauncherQueue.Put(a.RepoAnalysisStatus.RepoID, a.CommitSHA, newGUID); err != nil {
+		return errors.Wrapf(err, "failed to launch reanalysis of repo analysis %s", id.GUID)
+	}
+
+	rc.Log.Infof("Admin %d forced rerun of repo analysis %s of repo %d as %s",
+		rc.User.ID, id.GUID, a.RepoAnalysisStatus.RepoID, newGUID)
+	return nil
+}
+
+func (s BasicService) checkQueueName(name string) error {
+	for _, q := range s.Queues {
+		if q == name {
+			return nil
+		}
+	}
+
+	return errors.Wrapf(apierrors.ErrNotFound, "no queue %q, available queues: %v", name, s.Queues)
+}
+
+func (s BasicService) ListQueues(rc *request.AuthorizedContext) (*QueueStates, error) {
+	if err := s.checkAdmin(rc); err != nil {
+		return nil, err
+	}
+
+	ret := QueueStates{Queues: []QueueState{}}
+	for _, q := range s.Queues {
+		paused, err := s.PauseStorage.IsPaused(q)
+		if err != nil {
+			return nil, err
+		}
+
+		ret.Queues = append(ret.Queues, QueueState{
+			Name:     q,
+			IsPaused: paused,
+		})
+	}
+
+	return &ret, nil
+}
+
+func (s BasicService) setQueuePaused(rc *request.AuthorizedContext, q *QueueName, paused bool) error {
+	if err := s.checkAdmin(rc); err != nil {
+		return err
+	}
+
+	if err := s.checkQueueName(q.Name); err != nil {
+		return err
+	}
+
+	if err := s.PauseStorage.SetPaused(q.Name, paused); err != nil {
+		return err
+	}
+
+	rc.Log.Infof("Admin %d set queue %s pause to %t", rc.User.ID, q.Name, paused)
+	return nil
+}
+
+func (s BasicService) PauseQueue(rc *request.AuthorizedContext, q *QueueName) error {
+	return s.setQueuePaused(rc, q, true)
+}
+
+func (s BasicService) ResumeQueue(rc *request.AuthorizedContext, q *QueueName) error {
+	return s.setQueuePaused(rc, q, false)
+}
@@ -0,0 +1,230 @@
+package apphook
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/golangci/golangci-api/internal/api/apierrors"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/providers/githubapp"
+	"github.com/golangci/golangci-api/internal/shared/providers/implementations"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/api/request"
+	"github.com/golangci/golangci-api/pkg/api/services/repohook"
+	gh "github.com/google/go-github/github"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+type GithubAppWebhook struct {
+	EventType    string `request:"X-GitHub-Event,header,"`
+	DeliveryGUID string `request:"X-GitHub-Delivery,header,optional"`
+	Signature    string `request:"X-Hub-Signature-256,header,optional"`
+}
+
+func (w GithubAppWebhook) FillLogContext(lctx logutil.Context) {
+	lctx["event_type"] = w.EventType
+	lctx["delivery_guid"] = w.DeliveryGUID
+}
+
+type Service interface {
+	//url:/v1/github/app/hooks method:POST
+	HandleGithubAppWebhook(rc *request.AnonymousContext, req *GithubAppWebhook, body request.Body) error
+}
+
+// BasicService handles webhooks of GitHub App: one url for all installations.
+// Repo events of repos connected through the app are handled as repo hooks.
+type BasicService struct {
+	GithubApp *githubapp.App
+	RepoHooks repohook.Service
+}
+
+func (s BasicService) HandleGithubAppWebhook(rc *request.AnonymousContext, req *GithubAppWebhook, body request.Body) error {
+	if err := s.GithubApp.CheckWebhookSignature(body, req.Signature); err != nil {
+		return errors.Wrapf(apierrors.ErrBadRequest, "invalid github app webhook: %s", err)
+	}
+
+	switch req.EventType {
+	case "ping":
+		rc.Log.Infof("Got github app ping webhook")
+		return nil
+	case "installation":
+		return s.handleInstallationWebhook(rc, body)
+	case "pull_request", "push":
+		return s.handleRepoWebhook(rc, req, body)
+	}
+
+	// the app is subscribed to events needed by other apps of the installation too
+	rc.Log.Infof("Skip github app webhook with event type %s", req.EventType)
+	return nil
+}
+
+func (s BasicService) handleInstallationWebhook(rc *request.AnonymousContext, body request.Body) error {
+	var ev gh.InstallationEvent
+	if err := json.Unmarshal(body, &ev); err != nil {
+		return errors.Wrapf(apierrors.ErrBadRequest, "invalid payload json: %s", err)
+	}
+
+	inst := ev.GetInstallation()
+	if inst == nil || inst.GetID() == 0 {
+		return errors.Wrap(apierrors.ErrBadRequest, "no installation in payload")
+	}
+
+	action := ev.GetAction()
+	rc.Log.Infof("Got github app installation %d webhook for account %s with action %s",
+		inst.GetID(), inst.GetAccount().GetLogin(), action)
+
+	switch action {
+	case "created", "unsuspend", "new_permissions_accepted":
+		return s.saveInstallation(rc, inst)
+	case "deleted", "suspend":
+		return s.deleteInstallation(rc, inst)
+	}
+
+	return nil
+}
+
+func (s BasicService) saveInstallation(rc *request.AnonymousContext, inst *gh.Installation) error {
+	var existing models.Installation
+	err := models.NewInstallationQuerySet(rc.DB).
+		ProviderEq(implementations.GithubProviderName).
+		ProviderIDEq(inst.GetID()).
+		One(&existing)
+	if err == nil {
+		return nil // repeated webhook
+	}
+	if err != gorm.ErrRecordNotFound {
+		return errors.Wrapf(err, "failed to fetch installation %d", inst.GetID())
+	}
+
+	i := models.Installation{
+		Provider:          implementations.GithubProviderName,
+		ProviderID:        inst.GetID(),
+		AccountName:       strings.ToLower(inst.GetAccount().GetLogin()),
+		AccountProviderID: inst.GetAccount().GetID(),
+	}
+	if err = s.createInstallation(rc, &i); err != nil {
+		return err
+	}
+
+	rc.Log.Infof("Created installation %#v", i)
+	return nil
+}
+
+func (s BasicService) createInstallation(rc *request.AnonymousContext, i *models.Installation) (retErr error) {
+	tx, finishTx, err := gormdb.StartTx(rc.DB)
+	if err != nil {
+		return err
+	}
+	defer finishTx(&retErr)
+
+	if err = i.Create(tx); err != nil {
+		return errors.Wrapf(err, "failed to create installation %d", i.ProviderID)
+	}
+
+	n, err := remapRepos(tx, i)
+	if err != nil {
+		return err
+	}
+	if n != 0 {
+		rc.Log.Infof("Remapped %d repos of account %s to installation %d", n, i.AccountName, i.ProviderID)
+	}
+
+	return nil
+}
+
+// remapRepos moves repos connected through deleted installations of the account to the new installation:
+// GitHub assigns a new id to the app installation after the app reinstall
+func remapRepos(db *gorm.DB, i *models.Installation) (int64, error) {
+	if i.AccountProviderID == 0 {
+		return 0, nil
+	}
+
+	var deleted []models.Installation
+	err := models.NewInstallationQuerySet(db.Unscoped()).
+		ProviderEq(i.Provider).
+		AccountProviderIDEq(i.AccountProviderID).
+		DeletedAtIsNotNull().
+		All(&deleted)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to fetch deleted installations of account %s", i.AccountName)
+	}
+
+	var prevIDs []int
+	for _, d := range deleted {
+		if d.ProviderID != i.ProviderID { // suspended installations keep their id
+			prevIDs = append(prevIDs, d.ProviderID)
+		}
+	}
+	if len(prevIDs) == 0 {
+		return 0, nil
+	}
+
+	n, err := models.NewRepoQuerySet(db).
+		ProviderEq(i.Provider).
+		ProviderInstallationIDIn(prevIDs...).
+		GetUpdater().
+		SetProviderInstallationID(i.ProviderID).
+		UpdateNum()
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to remap repos of installations %v", prevIDs)
+	}
+
+	return n, nil
+}
+
+func (s BasicService) deleteInstallation(rc *request.AnonymousContext, inst *gh.Installation) error {
+	err := models.NewInstallationQuerySet(rc.DB).
+		ProviderEq(implementations.GithubProviderName).
+		ProviderIDEq(inst.GetID()).
+		Delete()
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete installation %d", inst.GetID())
+	}
+
+	// Analyzes of connected repos fail until the app is installed into the account again.
+	// The reinstalled app gets a new installation id: repos are remapped to it in remapRepos.
+	return nil
+}
+
+func (s BasicService) handleRepoWebhook(rc *request.AnonymousContext, req *GithubAppWebhook, body request.Body) error {
+	var payload struct {
+		Repo         *gh.Repository   `json:"repository,omitempty"`
+		Installation *gh.Installation `json:"installation,omitempty"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return errors.Wrapf(apierrors.ErrBadRequest, "invalid payload json: %s", err)
+	}
+
+	if payload.Repo.GetID() == 0 || payload.Installation.GetID() == 0 {
+		return errors.Wrap(apierrors.ErrBadRequest, "no repository or installation in payload")
+	}
+
+	var repo models.Repo
+	err := models.NewRepoQuerySet(rc.DB).
+		ProviderEq(implementations.GithubProviderName).
+		ProviderIDEq(payload.Repo.GetID()).
+		ProviderInstallationIDEq(payload.Installation.GetID()).
+		One(&repo)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			// not all repos of the installation are connected
+			rc.Log.Infof("Skip github app %s webhook for not connected repo %s",
+				req.EventType, payload.Repo.GetFullName())
+			return nil
+		}
+
+		return errors.Wrapf(err, "failed to fetch repo with provider id %d", payload.Repo.GetID())
+	}
+
+	return s.RepoHooks.HandleGithubWebhook(rc, &repohook.GithubWebhook{
+		ShortRepo: request.ShortRepo{
+			Owner: repo.Owner(),
+			Name:  repo.Repo(),
+		},
+		HookID:       repo.HookID,
+		EventType:    req.EventType,
+		DeliveryGUID: req.DeliveryGUID,
+	}, body)
+}
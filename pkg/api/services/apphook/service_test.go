@@ -0,0 +1,49 @@
+package apphook
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb/gormdbtest"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemapReposToReinstalledApp(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	mock.ExpectQuery(`SELECT \* FROM "installations" WHERE \(provider = \$1\) AND \(account_provider_id = \$2\) AND \(deleted_at IS NOT NULL\)`).
+		WithArgs("github.com", 7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "provider_id"}).
+			AddRow(1, 100).
+			AddRow(2, 300)) // suspended and unsuspended installation
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "repos" SET "provider_installation_id" = \$1, "updated_at" = \$2 `+
+		`WHERE .*\(provider = \$3\) AND \(provider_installation_id IN \(\$4\)\)`).
+		WithArgs(300, sqlmock.AnyArg(), "github.com", 100).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	n, err := remapRepos(db, &models.Installation{
+		Provider:          "github.com",
+		ProviderID:        300,
+		AccountName:       "golangci",
+		AccountProviderID: 7,
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, n)
+}
+
+func TestRemapReposWithoutDeletedInstallations(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	mock.ExpectQuery(`SELECT \* FROM "installations"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "provider_id"}))
+
+	n, err := remapRepos(db, &models.Installation{Provider: "github.com", ProviderID: 300, AccountProviderID: 7})
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/golangci/golangci-api/internal/api/apierrors"
 	"github.com/golangci/golangci-api/internal/api/endpointutil"
 	"github.com/golangci/golangci-api/internal/api/transportutil"
+	"github.com/golangci/golangci-api/internal/shared/metrics"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 )
@@ -29,7 +30,7 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("GET").Path("/v1/auth/check").Handler(hCheckAuth)
+	r.Methods("GET").Path("/v1/auth/check").Handler(metrics.InstrumentHandler("auth", "CheckAuth", hCheckAuth))
 
 	hLogout := httptransport.NewServer(
 		makeLogoutEndpoint(svc, regCtx.Log),
@@ -44,7 +45,7 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("GET").Path("/v1/auth/logout").Handler(hLogout)
+	r.Methods("GET").Path("/v1/auth/logout").Handler(metrics.InstrumentHandler("auth", "Logout", hLogout))
 
 	hUnlinkProvider := httptransport.NewServer(
 		makeUnlinkProviderEndpoint(svc, regCtx.Log),
@@ -59,7 +60,7 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("PUT").Path("/v1/auth/unlink").Handler(hUnlinkProvider)
+	r.Methods("PUT").Path("/v1/auth/unlink").Handler(metrics.InstrumentHandler("auth", "UnlinkProvider", hUnlinkProvider))
 
 	hRelogin := httptransport.NewServer(
 		makeReloginEndpoint(svc, regCtx.Log),
@@ -74,7 +75,7 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("GET").Path("/v1/auth/user/relogin").Handler(hRelogin)
+	r.Methods("GET").Path("/v1/auth/user/relogin").Handler(metrics.InstrumentHandler("auth", "Relogin", hRelogin))
 
 	hLoginPublic := httptransport.NewServer(
 		makeLoginPublicEndpoint(svc, regCtx.Log),
@@ -89,7 +90,7 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("GET").Path("/v1/auth/{provider}").Handler(hLoginPublic)
+	r.Methods("GET").Path("/v1/auth/{provider}").Handler(metrics.InstrumentHandler("auth", "LoginPublic", hLoginPublic))
 
 	hLoginPrivate := httptransport.NewServer(
 		makeLoginPrivateEndpoint(svc, regCtx.Log),
@@ -104,7 +105,7 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("GET").Path("/v1/auth/{provider}/private").Handler(hLoginPrivate)
+	r.Methods("GET").Path("/v1/auth/{provider}/private").Handler(metrics.InstrumentHandler("auth", "LoginPrivate", hLoginPrivate))
 
 	hLoginPublicOAuthCallback := httptransport.NewServer(
 		makeLoginPublicOAuthCallbackEndpoint(svc, regCtx.Log),
@@ -119,7 +120,7 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("GET").Path("/v1/auth/{provider}/callback/public").Handler(hLoginPublicOAuthCallback)
+	r.Methods("GET").Path("/v1/auth/{provider}/callback/public").Handler(metrics.InstrumentHandler("auth", "LoginPublicOAuthCallback", hLoginPublicOAuthCallback))
 
 	hLoginPrivateOAuthCallback := httptransport.NewServer(
 		makeLoginPrivateOAuthCallbackEndpoint(svc, regCtx.Log),
@@ -134,7 +135,7 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("GET").Path("/v1/auth/{provider}/callback/private").Handler(hLoginPrivateOAuthCallback)
+	r.Methods("GET").Path("/v1/auth/{provider}/callback/private").Handler(metrics.InstrumentHandler("auth", "LoginPrivateOAuthCallback", hLoginPrivateOAuthCallback))
 
 	hLoginAdmin := httptransport.NewServer(
 		makeLoginAdminEndpoint(svc, regCtx.Log),
@@ -149,7 +150,7 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("GET").Path("/v1/auth/{provider}/admin").Handler(hLoginAdmin)
+	r.Methods("GET").Path("/v1/auth/{provider}/admin").Handler(metrics.InstrumentHandler("auth", "LoginAdmin", hLoginAdmin))
 
 }
 
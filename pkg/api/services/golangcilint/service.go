@@ -0,0 +1,108 @@
+package golangcilint
+
+import (
+	"strings"
+
+	"github.com/golangci/golangci-api/internal/api/apierrors"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/pkg/api/lintversions"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/api/request"
+	"github.com/golangci/golangci-api/pkg/api/workers/primaryqueue/repoanalyzes"
+	"github.com/golangci/golangci-api/pkg/goenvbuild/config"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+type Release struct {
+	Version  string
+	Checksum string
+}
+
+func (r Release) FillLogContext(lctx logutil.Context) {
+	lctx["golangci_lint_version"] = r.Version
+}
+
+type DefaultVersion struct {
+	Version string
+}
+
+func (v DefaultVersion) FillLogContext(lctx logutil.Context) {
+	lctx["golangci_lint_version"] = v.Version
+}
+
+type Service interface {
+	//url:/v1/golangci-lint/registry/{provider}/{name}
+	GetRegistry(rc *request.InternalContext, reqOrg *request.Org) (*config.GolangciLintRegistry, error)
+
+	//url:/v1/golangci-lint/versions method:POST
+	AddRelease(rc *request.InternalContext, release *Release) error
+
+	//url:/v1/golangci-lint/default-version method:PUT
+	UpdateDefaultVersion(rc *request.InternalContext, v *DefaultVersion) error
+}
+
+type BasicService struct {
+	ReanalyzerQueue *repoanalyzes.ReanalyzerProducer
+}
+
+func (s BasicService) GetRegistry(rc *request.InternalContext, reqOrg *request.Org) (*config.GolangciLintRegistry, error) {
+	return lintversions.LoadForOrg(rc.DB, reqOrg.Provider, reqOrg.Name)
+}
+
+func (s BasicService) AddRelease(rc *request.InternalContext, release *Release) error {
+	// the db accepts only lower-cased hex checksums
+	rel := config.GolangciLintRelease{Version: release.Version, Checksum: strings.ToLower(release.Checksum)}
+	if err := rel.Validate(); err != nil {
+		return errors.Wrapf(apierrors.ErrBadRequest, "invalid release: %s", err)
+	}
+
+	v := models.GolangciLintVersion{
+		Version:  rel.Version,
+		Checksum: rel.Checksum,
+	}
+	if err := v.Create(rc.DB); err != nil {
+		return errors.Wrapf(err, "failed to create golangci-lint version %s", release.Version)
+	}
+
+	rc.Log.Infof("Added golangci-lint release %s", release.Version)
+	return nil
+}
+
+func (s BasicService) UpdateDefaultVersion(rc *request.InternalContext, v *DefaultVersion) error {
+	if err := s.setDefaultVersion(rc.DB, v.Version); err != nil {
+		return err
+	}
+	rc.Log.Infof("Set default golangci-lint version to %s", v.Version)
+
+	// there can be a lot of active repos: launch their reanalysis in the background
+	if err := s.ReanalyzerQueue.Put(v.Version); err != nil {
+		return errors.Wrap(err, "failed to enqueue reanalysis of repos")
+	}
+
+	return nil
+}
+
+func (s BasicService) setDefaultVersion(db *gorm.DB, version string) (retErr error) {
+	tx, finishTx, err := gormdb.StartTx(db)
+	if err != nil {
+		return err
+	}
+	defer finishTx(&retErr)
+
+	err = models.NewGolangciLintVersionQuerySet(tx).IsDefaultEq(true).GetUpdater().SetIsDefault(false).Update()
+	if err != nil {
+		return errors.Wrap(err, "failed to reset default golangci-lint version")
+	}
+
+	n, err := models.NewGolangciLintVersionQuerySet(tx).VersionEq(version).GetUpdater().SetIsDefault(true).UpdateNum()
+	if err != nil {
+		return errors.Wrapf(err, "failed to set default golangci-lint version %s", version)
+	}
+	if n == 0 {
+		return errors.Wrapf(apierrors.ErrNotFound, "no golangci-lint version %s", version)
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package notification
+
+import (
+	"github.com/golangci/golangci-api/internal/api/apierrors"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/api/notifications"
+	"github.com/golangci/golangci-api/pkg/api/request"
+	"github.com/pkg/errors"
+)
+
+type Preference struct {
+	Event       models.NotificationEvent `json:"event"`
+	Description string                   `json:"description,omitempty"`
+	Enabled     bool                     `json:"enabled"`
+}
+
+type Preferences struct {
+	Preferences []Preference `json:"preferences"`
+}
+
+type UpdatePayload Preferences
+
+func (p UpdatePayload) FillLogContext(lctx logutil.Context) {
+	for _, pref := range p.Preferences {
+		lctx["notification/"+string(pref.Event)] = pref.Enabled
+	}
+}
+
+type Service interface {
+	//url:/v1/notifications/preferences
+	GetPreferences(rc *request.AuthorizedContext) (*Preferences, error)
+
+	//url:/v1/notifications/preferences method:PUT
+	UpdatePreferences(rc *request.AuthorizedContext, payload *UpdatePayload) (*Preferences, error)
+}
+
+type BasicService struct{}
+
+func (s BasicService) GetPreferences(rc *request.AuthorizedContext) (*Preferences, error) {
+	var prefs []models.NotificationPreference
+	if err := models.NewNotificationPreferenceQuerySet(rc.DB).UserIDEq(rc.User.ID).All(&prefs); err != nil {
+		return nil, errors.Wrap(err, "failed to fetch notification preferences")
+	}
+
+	enabledByEvent := map[models.NotificationEvent]bool{}
+	for _, pref := range prefs {
+		enabledByEvent[pref.Event] = pref.Enabled
+	}
+
+	var ret Preferences
+	for _, ei := range notifications.Events {
+		enabled, ok := enabledByEvent[ei.Event]
+		if !ok {
+			enabled = ei.DefaultEnabled
+		}
+
+		ret.Preferences = append(ret.Preferences, Preference{
+			Event:       ei.Event,
+			Description: ei.Description,
+			Enabled:     enabled,
+		})
+	}
+
+	return &ret, nil
+}
+
+func (s BasicService) UpdatePreferences(rc *request.AuthorizedContext, payload *UpdatePayload) (*Preferences, error) {
+	for _, pref := range payload.Preferences {
+		if notifications.GetEventInfo(pref.Event) == nil {
+			return nil, errors.Wrapf(apierrors.ErrBadRequest, "unknown notification event %q", pref.Event)
+		}
+	}
+
+	if err := s.savePreferences(rc, payload); err != nil {
+		return nil, err
+	}
+
+	return s.GetPreferences(rc)
+}
+
+func (s BasicService) savePreferences(rc *request.AuthorizedContext, payload *UpdatePayload) (retErr error) {
+	tx, finishTx, err := gormdb.StartTx(rc.DB)
+	if err != nil {
+		return err
+	}
+	defer finishTx(&retErr)
+
+	for _, pref := range payload.Preferences {
+		qs := models.NewNotificationPreferenceQuerySet(tx).UserIDEq(rc.User.ID).EventEq(pref.Event)
+		n, err := qs.GetUpdater().SetEnabled(pref.Enabled).UpdateNum()
+		if err != nil {
+			return errors.Wrapf(err, "failed to update %s notification preference", pref.Event)
+		}
+		if n != 0 {
+			continue
+		}
+
+		dbPref := models.NotificationPreference{
+			UserID:  rc.User.ID,
+			Event:   pref.Event,
+			Enabled: pref.Enabled,
+		}
+		if err = dbPref.Create(tx); err != nil {
+			return errors.Wrapf(err, "failed to create %s notification preference", pref.Event)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,271 @@
+package organization
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golangci/golangci-api/pkg/api/issues"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/api/request"
+	"github.com/golangci/golangci-api/pkg/worker/analyze/processors"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+const (
+	dashboardCacheTTL           = 5 * time.Minute
+	dashboardPullRequestsPeriod = 7 * 24 * time.Hour
+	dashboardTopLintersCount    = 10
+)
+
+func (s *BasicService) GetDashboard(rc *request.AuthorizedContext, reqOrg *request.Org) (*Dashboard, error) {
+	org, err := s.Get(rc, reqOrg)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := fmt.Sprintf("orgs/dashboard/%s/%s", org.Provider, org.Name)
+	var cached Dashboard
+	if err = s.cache.Get(cacheKey, &cached); err != nil {
+		rc.Log.Warnf("Can't get %s from cache: %s", cacheKey, err)
+	} else if !cached.BuiltAt.IsZero() {
+		return &cached, nil
+	}
+
+	dashboard, err := s.buildDashboard(rc, org)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.cache.Set(cacheKey, dashboardCacheTTL, dashboard); err != nil {
+		rc.Log.Warnf("Can't save %s to cache: %s", cacheKey, err)
+	}
+
+	return dashboard, nil
+}
+
+func (s *BasicService) buildDashboard(rc *request.AuthorizedContext, org *models.Org) (*Dashboard, error) {
+	repoByID, statuses, err := s.getOrgRepos(rc, org)
+	if err != nil {
+		return nil, err
+	}
+
+	failingPullRequests, err := s.countFailingPullRequests(rc, repoByID)
+	if err != nil {
+		return nil, err
+	}
+
+	analyzes, err := fetchDashboardAnalyzes(rc.DB, statuses)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := Dashboard{
+		Repos:      []DashboardRepo{},
+		TopLinters: []DashboardLinter{},
+		BuiltAt:    time.Now(),
+	}
+
+	analyzedRepoIDs := map[uint]bool{}
+	for i := range statuses {
+		as := &statuses[i]
+		repo := repoByID[as.RepoID]
+		analyzedRepoIDs[repo.ID] = true
+
+		dr := analyzes.buildDashboardRepo(repo, as)
+		dr.FailingPullRequestsCount = failingPullRequests[repo.ID]
+		ret.Repos = append(ret.Repos, *dr)
+	}
+
+	for id, repo := range repoByID {
+		if !analyzedRepoIDs[id] {
+			ret.Repos = append(ret.Repos, DashboardRepo{
+				Repo:                     repo.DisplayFullName,
+				IssuesByLinter:           map[string]int{},
+				FailingPullRequestsCount: failingPullRequests[id],
+			})
+		}
+	}
+
+	sort.Slice(ret.Repos, func(i, j int) bool {
+		return strings.ToLower(ret.Repos[i].Repo) < strings.ToLower(ret.Repos[j].Repo)
+	})
+	ret.TopLinters = buildTopLinters(ret.Repos)
+
+	return &ret, nil
+}
+
+// dashboardAnalysisColumns don't include result_json: it's big and isn't needed
+const dashboardAnalysisColumns = "DISTINCT ON (repo_analysis_status_id) id, repo_analysis_status_id, status, " +
+	"score, max_score"
+
+// dashboardAnalyzes are fetched for all repos of the organization at once
+type dashboardAnalyzes struct {
+	lastByStatusID          map[uint]*models.RepoAnalysis
+	lastProcessedByStatusID map[uint]*models.RepoAnalysis
+	issuesByAnalysisID      map[uint]map[string]int
+}
+
+func fetchDashboardAnalyzes(db *gorm.DB, statuses []models.RepoAnalysisStatus) (*dashboardAnalyzes, error) {
+	ret := dashboardAnalyzes{
+		lastByStatusID:          map[uint]*models.RepoAnalysis{},
+		lastProcessedByStatusID: map[uint]*models.RepoAnalysis{},
+		issuesByAnalysisID:      map[uint]map[string]int{},
+	}
+	if len(statuses) == 0 {
+		return &ret, nil
+	}
+
+	statusIDs := make([]uint, 0, len(statuses))
+	for _, as := range statuses {
+		statusIDs = append(statusIDs, as.ID)
+	}
+
+	var err error
+	qs := models.NewRepoAnalysisQuerySet(db.Select(dashboardAnalysisColumns)).RepoAnalysisStatusIDIn(statusIDs...)
+	if ret.lastByStatusID, err = fetchLastAnalyzes(qs); err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch last analyzes for analysis statuses %v", statusIDs)
+	}
+	if ret.lastProcessedByStatusID, err = fetchLastAnalyzes(qs.StatusEq(processors.StatusProcessed)); err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch last processed analyzes for analysis statuses %v", statusIDs)
+	}
+
+	analysisIDs := make([]uint, 0, len(ret.lastProcessedByStatusID))
+	for _, a := range ret.lastProcessedByStatusID {
+		analysisIDs = append(analysisIDs, a.ID)
+	}
+	sort.Slice(analysisIDs, func(i, j int) bool {
+		return analysisIDs[i] < analysisIDs[j]
+	})
+
+	if ret.issuesByAnalysisID, err = issues.CountByLinterOfRepoAnalyzes(db, analysisIDs); err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+// fetchLastAnalyzes returns the last analysis of every analysis status by analysis status ids
+func fetchLastAnalyzes(qs models.RepoAnalysisQuerySet) (map[uint]*models.RepoAnalysis, error) {
+	var analyzes []models.RepoAnalysis
+	if err := qs.OrderAscByRepoAnalysisStatusID().OrderDescByID().All(&analyzes); err != nil {
+		return nil, err
+	}
+
+	ret := map[uint]*models.RepoAnalysis{}
+	for i := range analyzes {
+		ret[analyzes[i].RepoAnalysisStatusID] = &analyzes[i]
+	}
+	return ret, nil
+}
+
+func (da dashboardAnalyzes) buildDashboardRepo(repo *models.Repo, as *models.RepoAnalysisStatus) *DashboardRepo {
+	dr := DashboardRepo{
+		Repo:           repo.DisplayFullName,
+		IssuesByLinter: map[string]int{},
+	}
+	if !as.LastAnalyzedAt.IsZero() {
+		lastAnalyzedAt := as.LastAnalyzedAt
+		dr.LastAnalyzedAt = &lastAnalyzedAt
+	}
+
+	if last := da.lastByStatusID[as.ID]; last != nil {
+		dr.Status = last.Status
+	}
+
+	analysis := da.lastProcessedByStatusID[as.ID]
+	if analysis == nil {
+		return &dr
+	}
+
+	dr.Score, dr.MaxScore = analysis.Score, analysis.MaxScore
+	if issuesByLinter := da.issuesByAnalysisID[analysis.ID]; issuesByLinter != nil {
+		dr.IssuesByLinter = issuesByLinter
+	}
+	for _, n := range dr.IssuesByLinter {
+		dr.IssuesCount += n
+	}
+
+	return &dr
+}
+
+func isFailingPullRequestStatus(status string) bool {
+	return status == "processed/failure" || status == "processed/error"
+}
+
+// countFailingPullRequests returns counts of failing pull requests for the period by repo ids
+func (s *BasicService) countFailingPullRequests(rc *request.AuthorizedContext, repoByID map[uint]*models.Repo) (map[uint]int, error) {
+	ret := map[uint]int{}
+	if len(repoByID) == 0 {
+		return ret, nil
+	}
+
+	repoIDs := make([]uint, 0, len(repoByID))
+	for id := range repoByID {
+		repoIDs = append(repoIDs, id)
+	}
+
+	// don't fetch result json: it's big
+	db := rc.DB.Select("id, repo_id, pull_request_number, status")
+	var analyzes []models.PullRequestAnalysis
+	err := models.NewPullRequestAnalysisQuerySet(db).
+		RepoIDIn(repoIDs...).
+		CreatedAtGt(time.Now().Add(-dashboardPullRequestsPeriod)).
+		OrderAscByID().
+		All(&analyzes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch pull request analyzes")
+	}
+
+	type pullRequestKey struct {
+		repoID uint
+		number int
+	}
+	lastStatuses := map[pullRequestKey]string{}
+	for _, a := range analyzes {
+		lastStatuses[pullRequestKey{a.RepoID, a.PullRequestNumber}] = a.Status
+	}
+
+	for key, status := range lastStatuses {
+		if isFailingPullRequestStatus(status) {
+			ret[key.repoID]++
+		}
+	}
+
+	return ret, nil
+}
+
+func buildTopLinters(repos []DashboardRepo) []DashboardLinter {
+	linterByName := map[string]*DashboardLinter{}
+	for _, r := range repos {
+		for linter, n := range r.IssuesByLinter {
+			dl := linterByName[linter]
+			if dl == nil {
+				dl = &DashboardLinter{Linter: linter}
+				linterByName[linter] = dl
+			}
+			dl.IssuesCount += n
+			dl.ReposCount++
+		}
+	}
+
+	ret := make([]DashboardLinter, 0, len(linterByName))
+	for _, dl := range linterByName {
+		ret = append(ret, *dl)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].IssuesCount != ret[j].IssuesCount {
+			return ret[i].IssuesCount > ret[j].IssuesCount
+		}
+		return ret[i].Linter < ret[j].Linter
+	})
+
+	if len(ret) > dashboardTopLintersCount {
+		ret = ret[:dashboardTopLintersCount]
+	}
+
+	return ret
+}
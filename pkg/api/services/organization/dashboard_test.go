@@ -0,0 +1,172 @@
+package organization
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb/gormdbtest"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/api/request"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestContext(db *gorm.DB) *request.AuthorizedContext {
+	return &request.AuthorizedContext{
+		BaseContext: request.BaseContext{
+			Ctx: context.Background(),
+			Log: logutil.NewStderrLog("test"),
+			DB:  db,
+		},
+	}
+}
+
+func pullRequestAnalyzesRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "repo_id", "pull_request_number", "status"})
+}
+
+func expectPullRequestAnalyzes(mock sqlmock.Sqlmock, rows *sqlmock.Rows) {
+	mock.ExpectQuery(`SELECT id, repo_id, pull_request_number, status FROM "pull_request_analyzes" ` +
+		`WHERE .*\(repo_id IN \(.*\)\) AND \(created_at > \$\d\)\) ORDER BY id ASC`).
+		WillReturnRows(rows)
+}
+
+func TestBuildDashboard(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	lastAnalyzedAt := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	mock.ExpectQuery(`SELECT \* FROM "repos" WHERE .*\(provider = \$1\) AND \(name LIKE \$2\)`).
+		WithArgs("github.com", "golangci/%").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "display_name"}).
+			AddRow(10, "golangci/b", "golangci/B").
+			AddRow(11, "golangci/a", "golangci/a"))
+	mock.ExpectQuery(`SELECT \* FROM "repo_analysis_statuses" WHERE .*\(repo_id IN \(\$1,\$2\)\)`).
+		WithArgs(10, 11).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "repo_id", "last_analyzed_at"}).AddRow(20, 10, lastAnalyzedAt))
+	expectPullRequestAnalyzes(mock, pullRequestAnalyzesRows().
+		AddRow(1, 10, 1, "processed/failure").
+		AddRow(2, 11, 3, "processed/error").
+		AddRow(3, 10, 1, "processed/success"). // the pull request was fixed
+		AddRow(4, 10, 2, "processed/failure"))
+
+	// the last analyzes of all repos are fetched at once without results
+	mock.ExpectQuery(`SELECT DISTINCT ON \(repo_analysis_status_id\) id, repo_analysis_status_id, status, score, max_score ` +
+		`FROM "repo_analyzes" WHERE .*\(repo_analysis_status_id IN \(\$1\)\)\) ` +
+		`ORDER BY repo_analysis_status_id ASC,id DESC`).
+		WithArgs(20).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "repo_analysis_status_id", "status"}).AddRow(31, 20, "processing"))
+	mock.ExpectQuery(`SELECT DISTINCT ON \(repo_analysis_status_id\) id, .* FROM "repo_analyzes" `+
+		`WHERE .*\(repo_analysis_status_id IN \(\$1\)\) AND \(status = \$2\)\) ORDER BY repo_analysis_status_id ASC,id DESC`).
+		WithArgs(20, "processed").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "repo_analysis_status_id", "status", "score", "max_score"}).
+			AddRow(30, 20, "processed", 90, 100))
+	// suppressed issues are excluded by the db
+	mock.ExpectQuery(`SELECT issues.analysis_id, issues.from_linter, COUNT\(\*\) AS count FROM "issues" `+
+		`JOIN repo_analyzes ON .* JOIN repo_analysis_statuses ON .* `+
+		`WHERE \(issues.analysis_type = \$1 AND issues.analysis_id IN \(\$2\)\) AND \(NOT EXISTS \(SELECT 1 FROM issue_suppressions `+
+		`WHERE issue_suppressions.repo_id = repo_analysis_statuses.repo_id .*\)\) GROUP BY issues.analysis_id, issues.from_linter`).
+		WithArgs(models.IssueAnalysisTypeRepo, 30).
+		WillReturnRows(sqlmock.NewRows([]string{"analysis_id", "from_linter", "count"}).
+			AddRow(30, "govet", 3).
+			AddRow(30, "errcheck", 1))
+
+	org := &models.Org{Provider: "github.com", Name: "golangci"}
+	dashboard, err := (&BasicService{}).buildDashboard(newTestContext(db), org)
+	require.NoError(t, err)
+
+	assert.False(t, dashboard.BuiltAt.IsZero())
+	assert.Equal(t, []DashboardRepo{
+		{
+			Repo:                     "golangci/a",
+			IssuesByLinter:           map[string]int{},
+			FailingPullRequestsCount: 1,
+		},
+		{
+			Repo:                     "golangci/B",
+			Status:                   "processing",
+			LastAnalyzedAt:           &lastAnalyzedAt,
+			IssuesCount:              4,
+			IssuesByLinter:           map[string]int{"govet": 3, "errcheck": 1},
+			Score:                    90,
+			MaxScore:                 100,
+			FailingPullRequestsCount: 1,
+		},
+	}, dashboard.Repos)
+	assert.Equal(t, []DashboardLinter{
+		{Linter: "govet", IssuesCount: 3, ReposCount: 1},
+		{Linter: "errcheck", IssuesCount: 1, ReposCount: 1},
+	}, dashboard.TopLinters)
+}
+
+func TestBuildDashboardWithoutRepos(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	mock.ExpectQuery(`SELECT \* FROM "repos"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	org := &models.Org{Provider: "github.com", Name: "golangci"}
+	dashboard, err := (&BasicService{}).buildDashboard(newTestContext(db), org)
+	require.NoError(t, err)
+	assert.Empty(t, dashboard.Repos)
+	assert.Empty(t, dashboard.TopLinters)
+}
+
+func TestCountFailingPullRequests(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	expectPullRequestAnalyzes(mock, pullRequestAnalyzesRows().
+		AddRow(1, 10, 1, "processed/failure").
+		AddRow(2, 10, 2, "processed/error").
+		AddRow(3, 10, 3, "processed/success").
+		AddRow(4, 10, 4, "processed/success").
+		AddRow(5, 10, 4, "processed/failure"). // the last analysis of the pull request is failing
+		AddRow(6, 11, 1, "processing").
+		AddRow(7, 11, 2, "processed/failure").
+		AddRow(8, 11, 2, "sent_to_queue"))
+
+	repoByID := map[uint]*models.Repo{10: makeRepo(10, "golangci/a"), 11: makeRepo(11, "golangci/b")}
+	counts, err := (&BasicService{}).countFailingPullRequests(newTestContext(db), repoByID)
+	require.NoError(t, err)
+	assert.Equal(t, map[uint]int{10: 3}, counts)
+}
+
+func TestCountFailingPullRequestsWithoutRepos(t *testing.T) {
+	counts, err := (&BasicService{}).countFailingPullRequests(newTestContext(nil), map[uint]*models.Repo{})
+	require.NoError(t, err)
+	assert.Empty(t, counts)
+}
+
+func TestBuildTopLinters(t *testing.T) {
+	repos := []DashboardRepo{
+		{Repo: "a", IssuesByLinter: map[string]int{"govet": 2, "golint": 5}},
+		{Repo: "b", IssuesByLinter: map[string]int{"govet": 3, "errcheck": 5}},
+		{Repo: "c", IssuesByLinter: map[string]int{}},
+	}
+
+	// linters with the same count of issues are ordered by name
+	assert.Equal(t, []DashboardLinter{
+		{Linter: "errcheck", IssuesCount: 5, ReposCount: 1},
+		{Linter: "golint", IssuesCount: 5, ReposCount: 1},
+		{Linter: "govet", IssuesCount: 5, ReposCount: 2},
+	}, buildTopLinters(repos))
+}
+
+func TestBuildTopLintersIsLimited(t *testing.T) {
+	issuesByLinter := map[string]int{}
+	for i := 0; i < dashboardTopLintersCount+5; i++ {
+		issuesByLinter[fmt.Sprintf("linter%02d", i)] = i + 1
+	}
+
+	top := buildTopLinters([]DashboardRepo{{IssuesByLinter: issuesByLinter}})
+	require.Len(t, top, dashboardTopLintersCount)
+	assert.Equal(t, DashboardLinter{Linter: "linter14", IssuesCount: 15, ReposCount: 1}, top[0])
+	assert.Equal(t, "linter05", top[dashboardTopLintersCount-1].Linter)
+}
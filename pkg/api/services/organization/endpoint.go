@@ -114,3 +114,153 @@ func makeGetEndpoint(svc Service, log logutil.Log) endpoint.Endpoint {
 
 	}
 }
+
+type ListAuditLogRequest struct {
+	Req *AuditLogRequest
+}
+
+type ListAuditLogResponse struct {
+	err error
+	*AuditLogPage
+}
+
+func makeListAuditLogEndpoint(svc Service, log logutil.Log) endpoint.Endpoint {
+	return func(ctx context.Context, reqObj interface{}) (resp interface{}, err error) {
+
+		req := reqObj.(ListAuditLogRequest)
+
+		reqLogger := log
+		defer func() {
+			if rerr := recover(); rerr != nil {
+				reqLogger.Errorf("Panic occurred")
+				reqLogger.Infof("%s", debug.Stack())
+				resp = ListAuditLogResponse{
+					err: errors.New("panic occurred"),
+				}
+				err = nil
+			}
+		}()
+
+		if err := endpointutil.Error(ctx); err != nil {
+			log.Warnf("Error occurred during request context creation: %s", err)
+			resp = ListAuditLogResponse{
+				err: err,
+			}
+			return resp, nil
+		}
+
+		rc := endpointutil.RequestContext(ctx).(*request.AuthorizedContext)
+		reqLogger = rc.Log
+
+		req.Req.FillLogContext(rc.Lctx)
+
+		v, err := svc.ListAuditLog(rc, req.Req)
+		if err != nil {
+			rc.Log.Errorf("organization.Service.ListAuditLog failed: %s", err)
+			return ListAuditLogResponse{err, v}, nil
+		}
+
+		return ListAuditLogResponse{nil, v}, nil
+
+	}
+}
+
+type GetLeaderboardRequest struct {
+	ReqOrg *request.Org
+}
+
+type GetLeaderboardResponse struct {
+	err error
+	*Leaderboard
+}
+
+func makeGetLeaderboardEndpoint(svc Service, log logutil.Log) endpoint.Endpoint {
+	return func(ctx context.Context, reqObj interface{}) (resp interface{}, err error) {
+
+		req := reqObj.(GetLeaderboardRequest)
+
+		reqLogger := log
+		defer func() {
+			if rerr := recover(); rerr != nil {
+				reqLogger.Errorf("Panic occurred")
+				reqLogger.Infof("%s", debug.Stack())
+				resp = GetLeaderboardResponse{
+					err: errors.New("panic occurred"),
+				}
+				err = nil
+			}
+		}()
+
+		if err := endpointutil.Error(ctx); err != nil {
+			log.Warnf("Error occurred during request context creation: %s", err)
+			resp = GetLeaderboardResponse{
+				err: err,
+			}
+			return resp, nil
+		}
+
+		rc := endpointutil.RequestContext(ctx).(*request.AuthorizedContext)
+		reqLogger = rc.Log
+
+		req.ReqOrg.FillLogContext(rc.Lctx)
+
+		v, err := svc.GetLeaderboard(rc, req.ReqOrg)
+		if err != nil {
+			rc.Log.Errorf("organization.Service.GetLeaderboard failed: %s", err)
+			return GetLeaderboardResponse{err, v}, nil
+		}
+
+		return GetLeaderboardResponse{nil, v}, nil
+
+	}
+}
+
+type GetDashboardRequest struct {
+	ReqOrg *request.Org
+}
+
+type GetDashboardResponse struct {
+	err error
+	*Dashboard
+}
+
+func makeGetDashboardEndpoint(svc Service, log logutil.Log) endpoint.Endpoint {
+	return func(ctx context.Context, reqObj interface{}) (resp interface{}, err error) {
+
+		req := reqObj.(GetDashboardRequest)
+
+		reqLogger := log
+		defer func() {
+			if rerr := recover(); rerr != nil {
+				reqLogger.Errorf("Panic occurred")
+				reqLogger.Infof("%s", debug.Stack())
+				resp = GetDashboardResponse{
+					err: errors.New("panic occurred"),
+				}
+				err = nil
+			}
+		}()
+
+		if err := endpointutil.Error(ctx); err != nil {
+			log.Warnf("Error occurred during request context creation: %s", err)
+			resp = GetDashboardResponse{
+				err: err,
+			}
+			return resp, nil
+		}
+
+		rc := endpointutil.RequestContext(ctx).(*request.AuthorizedContext)
+		reqLogger = rc.Log
+
+		req.ReqOrg.FillLogContext(rc.Lctx)
+
+		v, err := svc.GetDashboard(rc, req.ReqOrg)
+		if err != nil {
+			rc.Log.Errorf("organization.Service.GetDashboard failed: %s", err)
+			return GetDashboardResponse{err, v}, nil
+		}
+
+		return GetDashboardResponse{nil, v}, nil
+
+	}
+}
@@ -1,11 +1,21 @@
 package organization
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
 	"github.com/golangci/golangci-api/internal/api/apierrors"
+	"github.com/golangci/golangci-api/internal/api/score"
+	"github.com/golangci/golangci-api/internal/shared/cache"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb"
 	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/pkg/api/auditlog"
 	"github.com/golangci/golangci-api/pkg/api/models"
 	"github.com/golangci/golangci-api/pkg/api/policy"
 	"github.com/golangci/golangci-api/pkg/api/request"
+	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
 )
 
@@ -18,31 +28,115 @@ func (p UpdatePayload) FillLogContext(lctx logutil.Context) {
 	lctx["version"] = p.Version
 }
 
+type AuditLogRequest struct {
+	request.Org
+	Before uint `request:"before,urlParam,optional"` // id of the last entry of the previous page
+	Limit  int  `request:"limit,urlParam,optional"`
+}
+
+func (r AuditLogRequest) FillLogContext(lctx logutil.Context) {
+	r.Org.FillLogContext(lctx)
+	lctx["before"] = r.Before
+}
+
+type AuditLogPage struct {
+	Entries    []models.AuditLogEntry `json:"entries"`
+	NextBefore uint                   `json:"nextBefore,omitempty"` // 0 if it's the last page
+}
+
+type LeaderboardEntry struct {
+	Repo         string `json:"repo"`
+	AnalysisGUID string `json:"analysisGuid"`
+	CommitSHA    string `json:"commitSha"`
+	Score        int    `json:"score"`
+	MaxScore     int    `json:"maxScore"`
+}
+
+// Leaderboard ranks repos of the org by the score of the last scored analysis of the default branch
+type Leaderboard struct {
+	Entries []LeaderboardEntry `json:"entries"`
+}
+
+type DashboardRepo struct {
+	Repo string `json:"repo"`
+
+	// Status is the status of the last analysis of the default branch, it's empty if the repo wasn't analyzed yet
+	Status         string     `json:"status,omitempty"`
+	LastAnalyzedAt *time.Time `json:"lastAnalyzedAt,omitempty"`
+
+	// issues and score are from the last processed analysis, suppressed issues aren't counted
+	IssuesCount    int            `json:"issuesCount"`
+	IssuesByLinter map[string]int `json:"issuesByLinter"`
+	Score          int            `json:"score"`
+	MaxScore       int            `json:"maxScore"`
+
+	// pull requests whose last analysis in the period found issues or failed
+	FailingPullRequestsCount int `json:"failingPullRequestsCount"`
+}
+
+type DashboardLinter struct {
+	Linter      string `json:"linter"`
+	IssuesCount int    `json:"issuesCount"`
+	ReposCount  int    `json:"reposCount"`
+}
+
+// Dashboard aggregates analyzes of all connected repos of the org, it's cached for a few minutes
+type Dashboard struct {
+	Repos      []DashboardRepo   `json:"repos"`
+	TopLinters []DashboardLinter `json:"topLinters"`
+	BuiltAt    time.Time         `json:"builtAt"`
+}
+
+const (
+	defaultAuditLogPageSize = 50
+	maxAuditLogPageSize     = 200
+)
+
 type Service interface {
 	//url:/v1/orgs/{provider}/{name} method:PUT
 	Update(rc *request.AuthorizedContext, reqOrg *request.Org, payload *UpdatePayload) (*models.Org, error)
 
 	//url:/v1/orgs/{provider}/{name}
 	Get(rc *request.AuthorizedContext, reqOrg *request.Org) (*models.Org, error)
+
+	//url:/v1/orgs/{provider}/{name}/audit
+	ListAuditLog(rc *request.AuthorizedContext, req *AuditLogRequest) (*AuditLogPage, error)
+
+	//url:/v1/orgs/{provider}/{name}/leaderboard
+	GetLeaderboard(rc *request.AuthorizedContext, reqOrg *request.Org) (*Leaderboard, error)
+
+	//url:/v1/orgs/{provider}/{name}/dashboard
+	GetDashboard(rc *request.AuthorizedContext, reqOrg *request.Org) (*Dashboard, error)
 }
 
 type BasicService struct {
 	orgPolicy *policy.Organization
+	cache     cache.Cache
 }
 
-func NewBasicService(orgPolicy *policy.Organization) *BasicService {
+func NewBasicService(orgPolicy *policy.Organization, c cache.Cache) *BasicService {
 	return &BasicService{
 		orgPolicy: orgPolicy,
+		cache:     c,
 	}
 }
 
 func (s BasicService) Update(rc *request.AuthorizedContext, reqOrg *request.Org, payload *UpdatePayload) (*models.Org, error) {
-	qs := models.NewOrgQuerySet(rc.DB).NameEq(reqOrg.Name).ProviderEq(reqOrg.Provider)
 	var org models.Org
-	if err := qs.One(&org); err != nil {
+	if err := models.NewOrgQuerySet(rc.DB).NameEq(reqOrg.Name).ProviderEq(reqOrg.Provider).One(&org); err != nil {
 		return nil, errors.Wrap(err, "failed to to get org from db")
 	}
 
+	if payload.Settings != nil && !isValidGolangciLintChannel(payload.Settings.GolangciLintChannel) {
+		return nil, errors.Wrapf(apierrors.ErrBadRequest, "invalid golangci-lint channel %q", payload.Settings.GolangciLintChannel)
+	}
+
+	if payload.Settings != nil {
+		if err := validateScoreProfiles(payload.Settings); err != nil {
+			return nil, errors.Wrapf(apierrors.ErrBadRequest, "invalid score profiles: %s", err)
+		}
+	}
+
 	if org.Version != payload.Version {
 		return nil, apierrors.NewRaceConditionError("organization settings were changed in parallel")
 	}
@@ -57,19 +151,43 @@ func (s BasicService) Update(rc *request.AuthorizedContext, reqOrg *request.Org,
 		return nil, errors.Wrap(err, "check access to org")
 	}
 
+	prevSettings := org.Settings
 	if err := org.MarshalSettings(payload.Settings); err != nil {
 		return nil, errors.Wrapf(err, "failed to set settings for %d", org.ID)
 	}
 
-	upd := qs.VersionEq(org.Version).GetUpdater().SetSettings(org.Settings).SetVersion(org.Version + 1)
-	if err := upd.UpdateRequired(); err != nil {
-		return nil, errors.Wrapf(err, "failed to commit settings change for %d", org.ID)
+	// the access check makes provider calls: don't hold the transaction during them
+	if err := saveSettings(rc, &org, prevSettings); err != nil {
+		return nil, err
 	}
-	org.Version++
 
 	return &org, nil
 }
 
+func saveSettings(rc *request.AuthorizedContext, org *models.Org, prevSettings json.RawMessage) (retErr error) {
+	tx, finishTx, err := gormdb.StartTx(rc.DB)
+	if err != nil {
+		return err
+	}
+	defer finishTx(&retErr)
+
+	upd := models.NewOrgQuerySet(tx).IDEq(org.ID).VersionEq(org.Version).GetUpdater().
+		SetSettings(org.Settings).
+		SetVersion(org.Version + 1)
+	if err = upd.UpdateRequired(); err != nil {
+		return errors.Wrapf(err, "failed to commit settings change for %d", org.ID)
+	}
+	org.Version++
+
+	entry := &models.AuditLogEntry{
+		Provider: org.Provider,
+		OrgName:  org.Name,
+		Action:   models.AuditLogActionOrgUpdate,
+		TargetID: org.ID,
+	}
+	return auditlog.Write(rc, tx, entry, prevSettings, org.Settings)
+}
+
 func (s *BasicService) Get(rc *request.AuthorizedContext, reqOrg *request.Org) (*models.Org, error) {
 	var org models.Org
 	if err := models.NewOrgQuerySet(rc.DB).NameEq(reqOrg.Name).ProviderEq(reqOrg.Provider).One(&org); err != nil {
@@ -88,3 +206,158 @@ func (s *BasicService) Get(rc *request.AuthorizedContext, reqOrg *request.Org) (
 
 	return &org, nil
 }
+
+func (s *BasicService) ListAuditLog(rc *request.AuthorizedContext, req *AuditLogRequest) (*AuditLogPage, error) {
+	org, err := s.Get(rc, &req.Org)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultAuditLogPageSize
+	}
+	if limit > maxAuditLogPageSize {
+		limit = maxAuditLogPageSize
+	}
+
+	qs := models.NewAuditLogEntryQuerySet(rc.DB).ProviderEq(org.Provider).OrgNameEq(org.Name)
+	if req.Before != 0 {
+		qs = qs.IDLt(req.Before)
+	}
+
+	var entries []models.AuditLogEntry
+	if err = qs.OrderDescByID().Limit(limit + 1).All(&entries); err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch audit log of org %d", org.ID)
+	}
+
+	page := AuditLogPage{
+		Entries: entries,
+	}
+	if len(entries) > limit {
+		page.Entries = entries[:limit]
+		page.NextBefore = page.Entries[limit-1].ID
+	}
+
+	return &page, nil
+}
+
+// getOrgRepos returns connected repos of the org and their analysis statuses
+func (s *BasicService) getOrgRepos(rc *request.AuthorizedContext,
+	org *models.Org) (map[uint]*models.Repo, []models.RepoAnalysisStatus, error) {
+
+	var repos []models.Repo
+	if err := models.NewRepoQuerySet(rc.DB).ProviderEq(org.Provider).OwnerEq(org.Name).All(&repos); err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to fetch repos of org %d", org.ID)
+	}
+
+	repoByID := map[uint]*models.Repo{}
+	if len(repos) == 0 {
+		return repoByID, nil, nil
+	}
+
+	repoIDs := make([]uint, 0, len(repos))
+	for i := range repos {
+		repoIDs = append(repoIDs, repos[i].ID)
+		repoByID[repos[i].ID] = &repos[i]
+	}
+
+	var statuses []models.RepoAnalysisStatus
+	if err := models.NewRepoAnalysisStatusQuerySet(rc.DB).RepoIDIn(repoIDs...).All(&statuses); err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to fetch repo analysis statuses of org %d", org.ID)
+	}
+
+	return repoByID, statuses, nil
+}
+
+func (s *BasicService) GetLeaderboard(rc *request.AuthorizedContext, reqOrg *request.Org) (*Leaderboard, error) {
+	org, err := s.Get(rc, reqOrg)
+	if err != nil {
+		return nil, err
+	}
+
+	repoByID, statuses, err := s.getOrgRepos(rc, org)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildLeaderboard(rc.DB, repoByID, statuses)
+}
+
+// leaderboardColumns don't include result_json: it's big and isn't needed
+const leaderboardColumns = "DISTINCT ON (repo_analysis_status_id) id, repo_analysis_status_id, analysis_guid, " +
+	"commit_sha, score, max_score"
+
+func buildLeaderboard(db *gorm.DB, repoByID map[uint]*models.Repo,
+	statuses []models.RepoAnalysisStatus) (*Leaderboard, error) {
+
+	ret := Leaderboard{Entries: []LeaderboardEntry{}}
+	if len(statuses) == 0 {
+		return &ret, nil
+	}
+
+	repoIDByStatusID := map[uint]uint{}
+	statusIDs := make([]uint, 0, len(statuses))
+	for _, as := range statuses {
+		repoIDByStatusID[as.ID] = as.RepoID
+		statusIDs = append(statusIDs, as.ID)
+	}
+
+	// the last scored analysis of every repo: not scored yet repos aren't included
+	var analyzes []models.RepoAnalysis
+	err := models.NewRepoAnalysisQuerySet(db.Select(leaderboardColumns)).
+		RepoAnalysisStatusIDIn(statusIDs...).
+		MaxScoreNe(0).
+		OrderAscByRepoAnalysisStatusID().
+		OrderDescByID().
+		All(&analyzes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch last scored analyzes for analysis statuses %v", statusIDs)
+	}
+
+	for _, a := range analyzes {
+		ret.Entries = append(ret.Entries, LeaderboardEntry{
+			Repo:         repoByID[repoIDByStatusID[a.RepoAnalysisStatusID]].DisplayFullName,
+			AnalysisGUID: a.AnalysisGUID,
+			CommitSHA:    a.CommitSHA,
+			Score:        a.Score,
+			MaxScore:     a.MaxScore,
+		})
+	}
+
+	sort.SliceStable(ret.Entries, func(i, j int) bool {
+		ei, ej := ret.Entries[i], ret.Entries[j]
+		if ei.Score != ej.Score {
+			return ei.Score > ej.Score
+		}
+		return ei.Repo < ej.Repo
+	})
+
+	return &ret, nil
+}
+
+func isValidGolangciLintChannel(channel string) bool {
+	return channel == "" || channel == models.OrgGolangciLintChannelAuto
+}
+
+func validateScoreProfiles(settings *models.OrgSettings) error {
+	names := map[string]bool{}
+	for _, p := range settings.ScoreProfiles {
+		if err := p.Validate(); err != nil {
+			return errors.Wrapf(err, "profile %q", p.Name)
+		}
+		if p.Name == score.DefaultProfileName {
+			return fmt.Errorf("profile name %q is reserved", p.Name)
+		}
+		if names[p.Name] {
+			return fmt.Errorf("duplicate profile %q", p.Name)
+		}
+		names[p.Name] = true
+	}
+
+	if settings.ScoreProfile != "" && !names[settings.ScoreProfile] {
+		return fmt.Errorf("no profile %q", settings.ScoreProfile)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package organization
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb/gormdbtest"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeRepo(id uint, name string) *models.Repo {
+	r := &models.Repo{FullName: name, DisplayFullName: name}
+	r.ID = id
+	return r
+}
+
+func makeStatus(id, repoID uint) models.RepoAnalysisStatus {
+	as := models.RepoAnalysisStatus{RepoID: repoID}
+	as.ID = id
+	return as
+}
+
+func TestBuildLeaderboard(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	repoByID := map[uint]*models.Repo{
+		10: makeRepo(10, "golangci/b"),
+		11: makeRepo(11, "golangci/a"),
+		12: makeRepo(12, "golangci/c"),
+		13: makeRepo(13, "golangci/not-scored"),
+	}
+	statuses := []models.RepoAnalysisStatus{makeStatus(1, 10), makeStatus(2, 11), makeStatus(3, 12), makeStatus(4, 13)}
+
+	// one query returns the last scored analysis of every repo
+	mock.ExpectQuery(`SELECT DISTINCT ON \(repo_analysis_status_id\) id, .* FROM "repo_analyzes" `+
+		`WHERE .*\(repo_analysis_status_id IN \(\$1,\$2,\$3,\$4\)\) AND \(max_score != \$5\)\) `+
+		`ORDER BY repo_analysis_status_id ASC,id DESC$`).
+		WithArgs(1, 2, 3, 4, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "repo_analysis_status_id", "analysis_guid", "score", "max_score"}).
+			AddRow(100, 1, "b", 80, 100).
+			AddRow(101, 2, "a", 80, 100).
+			AddRow(102, 3, "c", 95, 100))
+
+	lb, err := buildLeaderboard(db, repoByID, statuses)
+	require.NoError(t, err)
+
+	// ordered by score, repos with the same score are ordered by name
+	var repos []string
+	for _, e := range lb.Entries {
+		repos = append(repos, e.Repo)
+	}
+	assert.Equal(t, []string{"golangci/c", "golangci/a", "golangci/b"}, repos)
+	assert.Equal(t, LeaderboardEntry{Repo: "golangci/c", AnalysisGUID: "c", Score: 95, MaxScore: 100}, lb.Entries[0])
+}
+
+func TestBuildLeaderboardWithoutRepos(t *testing.T) {
+	lb, err := buildLeaderboard(nil, map[uint]*models.Repo{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, &Leaderboard{Entries: []LeaderboardEntry{}}, lb)
+}
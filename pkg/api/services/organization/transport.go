@@ -10,6 +10,7 @@ import (
 	"github.com/golangci/golangci-api/internal/api/apierrors"
 	"github.com/golangci/golangci-api/internal/api/endpointutil"
 	"github.com/golangci/golangci-api/internal/api/transportutil"
+	"github.com/golangci/golangci-api/internal/shared/metrics"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 )
@@ -29,7 +30,7 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("PUT").Path("/v1/orgs/{provider}/{name}").Handler(hUpdate)
+	r.Methods("PUT").Path("/v1/orgs/{provider}/{name}").Handler(metrics.InstrumentHandler("organization", "Update", hUpdate))
 
 	hGet := httptransport.NewServer(
 		makeGetEndpoint(svc, regCtx.Log),
@@ -44,7 +45,52 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("GET").Path("/v1/orgs/{provider}/{name}").Handler(hGet)
+	r.Methods("GET").Path("/v1/orgs/{provider}/{name}").Handler(metrics.InstrumentHandler("organization", "Get", hGet))
+
+	hListAuditLog := httptransport.NewServer(
+		makeListAuditLogEndpoint(svc, regCtx.Log),
+		decodeListAuditLogRequest,
+		encodeListAuditLogResponse,
+		httptransport.ServerBefore(transportutil.StoreHTTPRequestToContext),
+		httptransport.ServerAfter(transportutil.FinalizeSession),
+
+		httptransport.ServerBefore(transportutil.MakeStoreAuthorizedRequestContext(*regCtx)),
+
+		httptransport.ServerFinalizer(transportutil.FinalizeRequest),
+		httptransport.ServerErrorEncoder(transportutil.EncodeError),
+		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
+	)
+	r.Methods("GET").Path("/v1/orgs/{provider}/{name}/audit").Handler(metrics.InstrumentHandler("organization", "ListAuditLog", hListAuditLog))
+
+	hGetLeaderboard := httptransport.NewServer(
+		makeGetLeaderboardEndpoint(svc, regCtx.Log),
+		decodeGetLeaderboardRequest,
+		encodeGetLeaderboardResponse,
+		httptransport.ServerBefore(transportutil.StoreHTTPRequestToContext),
+		httptransport.ServerAfter(transportutil.FinalizeSession),
+
+		httptransport.ServerBefore(transportutil.MakeStoreAuthorizedRequestContext(*regCtx)),
+
+		httptransport.ServerFinalizer(transportutil.FinalizeRequest),
+		httptransport.ServerErrorEncoder(transportutil.EncodeError),
+		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
+	)
+	r.Methods("GET").Path("/v1/orgs/{provider}/{name}/leaderboard").Handler(metrics.InstrumentHandler("organization", "GetLeaderboard", hGetLeaderboard))
+
+	hGetDashboard := httptransport.NewServer(
+		makeGetDashboardEndpoint(svc, regCtx.Log),
+		decodeGetDashboardRequest,
+		encodeGetDashboardResponse,
+		httptransport.ServerBefore(transportutil.StoreHTTPRequestToContext),
+		httptransport.ServerAfter(transportutil.FinalizeSession),
+
+		httptransport.ServerBefore(transportutil.MakeStoreAuthorizedRequestContext(*regCtx)),
+
+		httptransport.ServerFinalizer(transportutil.FinalizeRequest),
+		httptransport.ServerErrorEncoder(transportutil.EncodeError),
+		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
+	)
+	r.Methods("GET").Path("/v1/orgs/{provider}/{name}/dashboard").Handler(metrics.InstrumentHandler("organization", "GetDashboard", hGetDashboard))
 
 }
 
@@ -131,3 +177,129 @@ func encodeGetResponse(ctx context.Context, w http.ResponseWriter, response inte
 
 	return json.NewEncoder(w).Encode(wrappedResp)
 }
+
+func decodeListAuditLogRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request ListAuditLogRequest
+	if err := transportutil.DecodeRequest(&request, r); err != nil {
+		return nil, errors.Wrap(err, "can't decode request")
+	}
+
+	return request, nil
+}
+
+func encodeListAuditLogResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Add("Content-Type", "application/json; charset=UTF-8")
+	if err := transportutil.GetContextError(ctx); err != nil {
+		wrappedResp := struct {
+			Error *transportutil.Error
+		}{
+			Error: transportutil.MakeError(err),
+		}
+		w.WriteHeader(wrappedResp.Error.HTTPCode)
+		return json.NewEncoder(w).Encode(wrappedResp)
+	}
+
+	resp := response.(ListAuditLogResponse)
+	wrappedResp := struct {
+		transportutil.ErrorResponse
+		ListAuditLogResponse
+	}{
+		ListAuditLogResponse: resp,
+	}
+
+	if resp.err != nil {
+		if apierrors.IsErrorLikeResult(resp.err) {
+			return transportutil.HandleErrorLikeResult(ctx, w, resp.err)
+		}
+
+		terr := transportutil.MakeError(resp.err)
+		wrappedResp.Error = terr
+		w.WriteHeader(terr.HTTPCode)
+	}
+
+	return json.NewEncoder(w).Encode(wrappedResp)
+}
+
+func decodeGetLeaderboardRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request GetLeaderboardRequest
+	if err := transportutil.DecodeRequest(&request, r); err != nil {
+		return nil, errors.Wrap(err, "can't decode request")
+	}
+
+	return request, nil
+}
+
+func encodeGetLeaderboardResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Add("Content-Type", "application/json; charset=UTF-8")
+	if err := transportutil.GetContextError(ctx); err != nil {
+		wrappedResp := struct {
+			Error *transportutil.Error
+		}{
+			Error: transportutil.MakeError(err),
+		}
+		w.WriteHeader(wrappedResp.Error.HTTPCode)
+		return json.NewEncoder(w).Encode(wrappedResp)
+	}
+
+	resp := response.(GetLeaderboardResponse)
+	wrappedResp := struct {
+		transportutil.ErrorResponse
+		GetLeaderboardResponse
+	}{
+		GetLeaderboardResponse: resp,
+	}
+
+	if resp.err != nil {
+		if apierrors.IsErrorLikeResult(resp.err) {
+			return transportutil.HandleErrorLikeResult(ctx, w, resp.err)
+		}
+
+		terr := transportutil.MakeError(resp.err)
+		wrappedResp.Error = terr
+		w.WriteHeader(terr.HTTPCode)
+	}
+
+	return json.NewEncoder(w).Encode(wrappedResp)
+}
+
+func decodeGetDashboardRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request GetDashboardRequest
+	if err := transportutil.DecodeRequest(&request, r); err != nil {
+		return nil, errors.Wrap(err, "can't decode request")
+	}
+
+	return request, nil
+}
+
+func encodeGetDashboardResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Add("Content-Type", "application/json; charset=UTF-8")
+	if err := transportutil.GetContextError(ctx); err != nil {
+		wrappedResp := struct {
+			Error *transportutil.Error
+		}{
+			Error: transportutil.MakeError(err),
+		}
+		w.WriteHeader(wrappedResp.Error.HTTPCode)
+		return json.NewEncoder(w).Encode(wrappedResp)
+	}
+
+	resp := response.(GetDashboardResponse)
+	wrappedResp := struct {
+		transportutil.ErrorResponse
+		GetDashboardResponse
+	}{
+		GetDashboardResponse: resp,
+	}
+
+	if resp.err != nil {
+		if apierrors.IsErrorLikeResult(resp.err) {
+			return transportutil.HandleErrorLikeResult(ctx, w, resp.err)
+		}
+
+		terr := transportutil.MakeError(resp.err)
+		wrappedResp.Error = terr
+		w.WriteHeader(terr.HTTPCode)
+	}
+
+	return json.NewEncoder(w).Encode(wrappedResp)
+}
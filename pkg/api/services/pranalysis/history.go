@@ -0,0 +1,196 @@
+package pranalysis
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/pkg/api/issues"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/api/request"
+	"github.com/pkg/errors"
+)
+
+const maxHistoryAnalyzesCount = 100
+
+type resultTimings struct {
+	WorkerRes struct {
+		Timings json.RawMessage
+	}
+}
+
+// extractTimingsJSON returns timings of the result to store them separately:
+// the result can be archived but timings are shown in the history
+func extractTimingsJSON(resultJSON []byte) ([]byte, error) {
+	if len(resultJSON) == 0 {
+		return nil, nil
+	}
+
+	var res resultTimings
+	if err := json.Unmarshal(resultJSON, &res); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal result json")
+	}
+
+	if len(res.WorkerRes.Timings) == 0 || string(res.WorkerRes.Timings) == "null" {
+		return nil, nil
+	}
+
+	return res.WorkerRes.Timings, nil
+}
+
+func parseTimings(timingsJSON []byte) ([]Timing, error) {
+	if len(timingsJSON) == 0 {
+		return nil, nil
+	}
+
+	var timings []Timing
+	if err := json.Unmarshal(timingsJSON, &timings); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal timings json")
+	}
+
+	return timings, nil
+}
+
+// lastAnalyzesOfCommits leaves only the last analysis of every commit and orders analyzes from the oldest one
+func lastAnalyzesOfCommits(analyzes []models.PullRequestAnalysis) []models.PullRequestAnalysis {
+	seenCommitSHAs := map[string]bool{}
+	var ret []models.PullRequestAnalysis
+	for _, a := range analyzes { // analyzes are ordered from the newest one
+		if seenCommitSHAs[a.CommitSHA] {
+			continue
+		}
+
+		seenCommitSHAs[a.CommitSHA] = true
+		ret = append(ret, a)
+	}
+
+	for i, j := 0, len(ret)-1; i < j; i, j = i+1, j-1 {
+		ret[i], ret[j] = ret[j], ret[i]
+	}
+
+	return ret
+}
+
+func (s BasicService) fetchNotSuppressedIssues(rc *request.AnonymousContext,
+	analyzes []models.PullRequestAnalysis) (map[uint][]models.Issue, error) {
+
+	analysisIDs := make([]uint, 0, len(analyzes))
+	for _, a := range analyzes {
+		analysisIDs = append(analysisIDs, a.ID)
+	}
+
+	var allIssues []models.Issue
+	err := models.NewIssueQuerySet(rc.DB).
+		AnalysisTypeEq(models.IssueAnalysisTypePullRequest).
+		AnalysisIDIn(analysisIDs...).
+		OrderAscByID().
+		All(&allIssues)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't get issues of pull request analyzes %v", analysisIDs)
+	}
+
+	// the repo could be reconnected: suppressions are per repo id
+	suppressedByRepoID := map[uint]map[string]bool{}
+	repoIDByAnalysisID := map[uint]uint{}
+	for _, a := range analyzes {
+		repoIDByAnalysisID[a.ID] = a.RepoID
+		if _, ok := suppressedByRepoID[a.RepoID]; ok {
+			continue
+		}
+
+		suppressed, suppressedErr := issues.SuppressedSet(rc.DB, a.RepoID)
+		if suppressedErr != nil {
+			return nil, suppressedErr
+		}
+		suppressedByRepoID[a.RepoID] = suppressed
+	}
+
+	ret := map[uint][]models.Issue{}
+	for _, issue := range allIssues {
+		if suppressedByRepoID[repoIDByAnalysisID[issue.AnalysisID]][issue.Fingerprint] {
+			continue
+		}
+		ret[issue.AnalysisID] = append(ret[issue.AnalysisID], issue)
+	}
+
+	return ret, nil
+}
+
+// buildHistoryEntries diffs issues of every processed analysis with the previous processed analysis
+func buildHistoryEntries(log logutil.Log, analyzes []models.PullRequestAnalysis,
+	issuesByAnalysisID map[uint][]models.Issue) []HistoryEntry {
+
+	ret := make([]HistoryEntry, 0, len(analyzes))
+	var prevIssues []models.Issue
+	hasProcessedPrev := false
+	for _, a := range analyzes {
+		timings, parseErr := parseTimings(a.TimingsJSON)
+		if parseErr != nil {
+			// timings are optional, don't fail the whole history
+			log.Warnf("Failed to parse timings of pull request analysis %s: %s", a.GithubDeliveryGUID, parseErr)
+		}
+
+		curIssues := issuesByAnalysisID[a.ID]
+		entry := HistoryEntry{
+			AnalysisGUID:        a.GithubDeliveryGUID,
+			CommitSHA:           a.CommitSHA,
+			CreatedAt:           a.CreatedAt,
+			Status:              a.Status,
+			ReportedIssuesCount: a.ReportedIssuesCount,
+			IssuesCount:         len(curIssues),
+			Timings:             timings,
+		}
+
+		// not processed analyzes have no issues: compare only processed ones
+		if strings.HasPrefix(a.Status, "processed/") {
+			if hasProcessedPrev {
+				diff := issues.Classify(prevIssues, curIssues)
+				entry.NewIssuesCount, entry.FixedIssuesCount = diff.New, diff.Fixed
+			}
+			prevIssues, hasProcessedPrev = curIssues, true
+		}
+
+		ret = append(ret, entry)
+	}
+
+	return ret
+}
+
+func (s BasicService) GetHistoryByPRNumber(rc *request.AnonymousContext, req *PullRequest) (*History, error) {
+	repoIDs, fullName, err := s.getRepoIDsForPullRequest(rc, &RepoPullRequest{Repo: req.Repo})
+	if err != nil {
+		return nil, err
+	}
+
+	// results aren't needed: issues are stored separately and timings are kept in their own column
+	var analyzes []models.PullRequestAnalysis
+	err = models.NewPullRequestAnalysisQuerySet(rc.DB.Select("id, created_at, repo_id, github_delivery_guid, " +
+		"commit_sha, status, reported_issues_count, timings_json")).
+		PullRequestNumberEq(req.PullRequestNumber).
+		RepoIDIn(repoIDs...).
+		OrderDescByID().
+		Limit(maxHistoryAnalyzesCount).
+		All(&analyzes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't get pull request analyzes with number %d and repo ids %v",
+			req.PullRequestNumber, repoIDs)
+	}
+
+	ret := History{
+		GithubPullRequestNumber: req.PullRequestNumber,
+		GithubRepoName:          fullName,
+		Entries:                 []HistoryEntry{},
+	}
+	if len(analyzes) == 0 {
+		return &ret, nil
+	}
+
+	analyzes = lastAnalyzesOfCommits(analyzes)
+	issuesByAnalysisID, err := s.fetchNotSuppressedIssues(rc, analyzes)
+	if err != nil {
+		return nil, err
+	}
+
+	ret.Entries = buildHistoryEntries(rc.Log, analyzes, issuesByAnalysisID)
+	return &ret, nil
+}
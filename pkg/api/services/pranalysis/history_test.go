@@ -0,0 +1,209 @@
+package pranalysis
+
+import (
+	"testing"
+
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeAnalysis(id uint, commitSHA, status string) models.PullRequestAnalysis {
+	a := models.PullRequestAnalysis{
+		GithubDeliveryGUID: commitSHA + "-guid",
+		CommitSHA:          commitSHA,
+		Status:             status,
+	}
+	a.ID = id
+	return a
+}
+
+func makeIssues(fingerprints ...string) []models.Issue {
+	var ret []models.Issue
+	for _, fp := range fingerprints {
+		ret = append(ret, models.Issue{Fingerprint: fp})
+	}
+	return ret
+}
+
+func analysisIDs(analyzes []models.PullRequestAnalysis) []uint {
+	var ret []uint
+	for _, a := range analyzes {
+		ret = append(ret, a.ID)
+	}
+	return ret
+}
+
+func TestLastAnalyzesOfCommits(t *testing.T) {
+	cases := []struct {
+		name     string
+		analyzes []models.PullRequestAnalysis
+		expIDs   []uint
+	}{
+		{
+			name: "no analyzes",
+		},
+		{
+			name: "ordered from the oldest",
+			analyzes: []models.PullRequestAnalysis{
+				makeAnalysis(3, "c", "processed/success"),
+				makeAnalysis(2, "b", "processed/success"),
+				makeAnalysis(1, "a", "processed/success"),
+			},
+			expIDs: []uint{1, 2, 3},
+		},
+		{
+			name: "the last analysis of the commit is kept",
+			analyzes: []models.PullRequestAnalysis{
+				makeAnalysis(4, "b", "processed/success"),
+				makeAnalysis(3, "b", "processed/error"),
+				makeAnalysis(2, "a", "processed/success"),
+				makeAnalysis(1, "a", "processing"),
+			},
+			expIDs: []uint{2, 4},
+		},
+		{
+			name: "reanalyzed commit is ordered by the last analysis",
+			analyzes: []models.PullRequestAnalysis{
+				makeAnalysis(3, "a", "processed/success"),
+				makeAnalysis(2, "b", "processed/success"),
+				makeAnalysis(1, "a", "processed/failure"),
+			},
+			expIDs: []uint{2, 3},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expIDs, analysisIDs(lastAnalyzesOfCommits(tc.analyzes)))
+		})
+	}
+}
+
+func TestBuildHistoryEntries(t *testing.T) {
+	type counts struct {
+		issues, new, fixed int
+	}
+
+	cases := []struct {
+		name      string
+		analyzes  []models.PullRequestAnalysis
+		issues    map[uint][]models.Issue
+		expCounts []counts
+	}{
+		{
+			name: "first analysis has no diff",
+			analyzes: []models.PullRequestAnalysis{
+				makeAnalysis(1, "a", "processed/failure"),
+			},
+			issues:    map[uint][]models.Issue{1: makeIssues("x", "y")},
+			expCounts: []counts{{issues: 2}},
+		},
+		{
+			name: "new and fixed issues",
+			analyzes: []models.PullRequestAnalysis{
+				makeAnalysis(1, "a", "processed/failure"),
+				makeAnalysis(2, "b", "processed/failure"),
+				makeAnalysis(3, "c", "processed/success"),
+			},
+			issues: map[uint][]models.Issue{
+				1: makeIssues("x", "y"),
+				2: makeIssues("y", "z", "z"),
+			},
+			expCounts: []counts{
+				{issues: 2},
+				{issues: 3, new: 2, fixed: 1},
+				{fixed: 3},
+			},
+		},
+		{
+			name: "not processed analyzes are skipped in diffs",
+			analyzes: []models.PullRequestAnalysis{
+				makeAnalysis(1, "a", "processed/failure"),
+				makeAnalysis(2, "b", "processing"),
+				makeAnalysis(3, "c", "processed/error"),
+				makeAnalysis(4, "d", "processed/failure"),
+			},
+			issues: map[uint][]models.Issue{
+				1: makeIssues("x"),
+				4: makeIssues("x", "y"),
+			},
+			expCounts: []counts{
+				{issues: 1},
+				{},
+				{fixed: 1},
+				{issues: 2, new: 2},
+			},
+		},
+		{
+			name: "first processed analysis after not processed one has no diff",
+			analyzes: []models.PullRequestAnalysis{
+				makeAnalysis(1, "a", "sent_to_queue"),
+				makeAnalysis(2, "b", "processed/failure"),
+			},
+			issues:    map[uint][]models.Issue{2: makeIssues("x")},
+			expCounts: []counts{{}, {issues: 1}},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			entries := buildHistoryEntries(logutil.NewStderrLog("test"), tc.analyzes, tc.issues)
+
+			var gotCounts []counts
+			for i, e := range entries {
+				assert.Equal(t, tc.analyzes[i].CommitSHA, e.CommitSHA)
+				assert.Equal(t, tc.analyzes[i].GithubDeliveryGUID, e.AnalysisGUID)
+				gotCounts = append(gotCounts, counts{issues: e.IssuesCount, new: e.NewIssuesCount, fixed: e.FixedIssuesCount})
+			}
+			assert.Equal(t, tc.expCounts, gotCounts)
+		})
+	}
+}
+
+func TestBuildHistoryEntriesParsesTimings(t *testing.T) {
+	withTimings := makeAnalysis(1, "a", "processed/success")
+	withTimings.TimingsJSON = []byte(`[{"Name":"run","DurationMs":10}]`)
+	broken := makeAnalysis(2, "b", "processed/success")
+	broken.TimingsJSON = []byte(`{`)
+
+	entries := buildHistoryEntries(logutil.NewStderrLog("test"),
+		[]models.PullRequestAnalysis{withTimings, broken}, map[uint][]models.Issue{})
+	assert.Equal(t, []Timing{{Name: "run", DurationMs: 10}}, entries[0].Timings)
+	assert.Empty(t, entries[1].Timings)
+}
+
+func TestExtractTimingsJSON(t *testing.T) {
+	cases := []struct {
+		name       string
+		resultJSON string
+		exp        string
+		isErr      bool
+	}{
+		{name: "no result"},
+		{name: "no timings", resultJSON: `{"WorkerRes":{}}`},
+		{name: "null timings", resultJSON: `{"WorkerRes":{"Timings":null}}`},
+		{
+			name:       "timings",
+			resultJSON: `{"Issues":[],"WorkerRes":{"Timings":[{"Name":"run","DurationMs":10}]}}`,
+			exp:        `[{"Name":"run","DurationMs":10}]`,
+		},
+		{name: "invalid result", resultJSON: `{`, isErr: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			timingsJSON, err := extractTimingsJSON([]byte(tc.resultJSON))
+			if tc.isErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.exp, string(timingsJSON))
+		})
+	}
+}
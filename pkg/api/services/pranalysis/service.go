@@ -11,10 +11,15 @@ import (
 	"github.com/golangci/golangci-api/pkg/api/policy"
 
 	"github.com/golangci/golangci-api/internal/api/apierrors"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb"
 	"github.com/golangci/golangci-api/internal/shared/logutil"
 	"github.com/golangci/golangci-api/internal/shared/providers"
+	"github.com/golangci/golangci-api/pkg/api/issues"
 	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/api/reports"
 	"github.com/golangci/golangci-api/pkg/api/request"
+	"github.com/golangci/golangci-api/pkg/api/resultsarchive"
+	"github.com/golangci/golangci-api/pkg/api/sarif"
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
 )
@@ -30,6 +35,16 @@ type State struct {
 	GithubRepoName          string
 
 	PreviousAnalyzes []SamePullStateLink `json:",omitempty"`
+
+	// SupersededBy is a guid of a newer analysis of the same pull request:
+	// the worker aborts superseded analyzes
+	SupersededBy string `json:",omitempty"`
+
+	SuppressedIssuesCount int `json:",omitempty"`
+
+	// SuppressedFingerprints are fingerprints of issues the worker doesn't report,
+	// they are set only for internal requests with WithSuppressed
+	SuppressedFingerprints []string `json:",omitempty"`
 }
 
 type SamePullStateLink struct {
@@ -59,6 +74,14 @@ type AnalyzedRepo struct {
 	AnalysisGUID string `request:",urlPart,"`
 }
 
+// AnalysisStateRequest is the internal request of the worker for the analysis state
+type AnalysisStateRequest struct {
+	AnalyzedRepo
+
+	// the worker needs suppressed fingerprints only once per analysis to filter issues before reporting
+	WithSuppressed bool `request:"with_suppressed,urlParam,optional"`
+}
+
 type RepoPullRequest struct {
 	request.Repo
 	PullRequestNumber int    `request:",urlPart,"`
@@ -72,24 +95,89 @@ func (r RepoPullRequest) FillLogContext(lctx logutil.Context) {
 	}
 }
 
+type PullRequest struct {
+	request.Repo
+	PullRequestNumber int `request:",urlPart,"`
+}
+
+func (r PullRequest) FillLogContext(lctx logutil.Context) {
+	r.Repo.FillLogContext(lctx)
+	lctx["pull_request_number"] = r.PullRequestNumber
+}
+
+type Timing struct {
+	Name       string
+	DurationMs int64
+}
+
+// HistoryEntry is the last analysis of a pushed commit of the pull request
+type HistoryEntry struct {
+	AnalysisGUID        string
+	CommitSHA           string
+	CreatedAt           time.Time
+	Status              string
+	ReportedIssuesCount int
+
+	// IssuesCount doesn't include suppressed issues,
+	// new and fixed issues are counted relative to the previous entry
+	IssuesCount      int
+	NewIssuesCount   int
+	FixedIssuesCount int
+
+	Timings []Timing `json:",omitempty"`
+}
+
+// History is ordered from the first pushed commit to the last one
+type History struct {
+	GithubPullRequestNumber int
+	GithubRepoName          string
+	Entries                 []HistoryEntry
+}
+
+type ReportRequest struct {
+	request.Repo
+	AnalysisGUID string `request:",urlPart,"`
+
+	Format string `request:"format,urlParam,optional"` // json by default
+}
+
+func (r ReportRequest) FillLogContext(lctx logutil.Context) {
+	r.Repo.FillLogContext(lctx)
+	lctx["analysis_guid"] = r.AnalysisGUID
+	lctx["format"] = r.Format
+}
+
 type Service interface {
 	//url:/v1/repos/{provider}/{owner}/{name}/analyzes/{analysisguid}/state
-	GetAnalysisStateByAnalysisGUID(rc *request.InternalContext, req *AnalyzedRepo) (*State, error)
+	GetAnalysisStateByAnalysisGUID(rc *request.InternalContext, req *AnalysisStateRequest) (*State, error)
 
 	//url:/v1/repos/{provider}/{owner}/{name}/pulls/{pullrequestnumber}
 	GetAnalysisStateByPRNumber(rc *request.AnonymousContext, req *RepoPullRequest) (*State, error)
 
+	//url:/v1/repos/{provider}/{owner}/{name}/pulls/{pullrequestnumber}/analyzes
+	GetHistoryByPRNumber(rc *request.AnonymousContext, req *PullRequest) (*History, error)
+
 	//url:/v1/repos/{provider}/{owner}/{name}/analyzes/{analysisguid}/state method:PUT
 	UpdateAnalysisStateByAnalysisGUID(rc *request.InternalContext, req *AnalyzedRepo, state *State) error
+
+	//url:/v1/repos/{provider}/{owner}/{name}/analyzes/{analysisguid}/issues
+	ListIssues(rc *request.AnonymousContext, req *issues.ListRequest) (*issues.Page, error)
+
+	//url:/v1/repos/{provider}/{owner}/{name}/analyzes/{analysisguid}/sarif
+	ExportSARIF(rc *request.AnonymousContext, req *AnalyzedRepo) (*sarif.Log, error)
+
+	//url:/v1/repos/{provider}/{owner}/{name}/analyzes/{analysisguid}/report
+	GetReport(rc *request.AnonymousContext, req *ReportRequest) error
 }
 
 type BasicService struct {
-	RepoPolicy *policy.Repo
-	Pf         providers.Factory
-	Cfg        config.Config
+	RepoPolicy     *policy.Repo
+	Pf             providers.Factory
+	ResultsArchive *resultsarchive.Archive // nil if archiving of results is disabled
+	Cfg            config.Config
 }
 
-func (s BasicService) GetAnalysisStateByAnalysisGUID(rc *request.InternalContext, req *AnalyzedRepo) (*State, error) {
+func (s BasicService) GetAnalysisStateByAnalysisGUID(rc *request.InternalContext, req *AnalysisStateRequest) (*State, error) {
 	var analysis models.PullRequestAnalysis
 	err := models.NewPullRequestAnalysisQuerySet(rc.DB).GithubDeliveryGUIDEq(req.AnalysisGUID).One(&analysis)
 	if err != nil {
@@ -102,7 +190,35 @@ func (s BasicService) GetAnalysisStateByAnalysisGUID(rc *request.InternalContext
 		return nil, errors.Wrapf(err, "can't get repo id %d", analysis.RepoID)
 	}
 
-	return stateFromAnalysis(&analysis, repo.FullName), nil
+	if err = s.ResultsArchive.LoadPullRequestAnalysisResult(rc.Ctx, &analysis); err != nil {
+		return nil, err
+	}
+
+	var newerAnalyzes []models.PullRequestAnalysis
+	err = models.NewPullRequestAnalysisQuerySet(rc.DB).
+		RepoIDEq(analysis.RepoID).
+		PullRequestNumberEq(analysis.PullRequestNumber).
+		IDGt(analysis.ID).
+		CommitSHANe(analysis.CommitSHA).
+		OrderDescByID().
+		Limit(1).
+		All(&newerAnalyzes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't get newer analyzes of pull request %d", analysis.PullRequestNumber)
+	}
+
+	state := stateFromAnalysis(&analysis, repo.FullName)
+	if len(newerAnalyzes) != 0 {
+		state.SupersededBy = newerAnalyzes[0].GithubDeliveryGUID
+	}
+
+	if req.WithSuppressed {
+		if state.SuppressedFingerprints, err = issues.SuppressedFingerprints(rc.DB, repo.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return state, nil
 }
 
 func (s BasicService) tryGetRenamedRepo(rc *request.AnonymousContext, req *RepoPullRequest, repos *[]models.Repo) error {
@@ -184,7 +300,7 @@ func (s BasicService) GetAnalysisStateByPRNumber(rc *request.AnonymousContext, r
 			return nil, errors.Wrapf(err, "can't get pull request analysus with number %d and repo ids %v and commit sha %s",
 				req.PullRequestNumber, repoIDs, req.CommitSHA)
 		}
-		return stateFromAnalysis(&analysis, fullName), nil
+		return s.buildPublicState(rc, &analysis, fullName)
 	}
 
 	const maxPreviousAnalyzesCount = 5
@@ -199,7 +315,10 @@ func (s BasicService) GetAnalysisStateByPRNumber(rc *request.AnonymousContext, r
 		return nil, fmt.Errorf("got 0 pull request analyzes for repo ids %v", repoIDs)
 	}
 
-	state := stateFromAnalysis(&analyzes[0], fullName)
+	state, err := s.buildPublicState(rc, &analyzes[0], fullName)
+	if err != nil {
+		return nil, err
+	}
 
 	seenCommitSHAs := map[string]bool{state.CommitSHA: true}
 	for _, a := range analyzes[1:] {
@@ -217,6 +336,24 @@ func (s BasicService) GetAnalysisStateByPRNumber(rc *request.AnonymousContext, r
 	return state, nil
 }
 
+func (s BasicService) buildPublicState(rc *request.AnonymousContext, analysis *models.PullRequestAnalysis,
+	fullName string) (*State, error) {
+
+	err := s.ResultsArchive.LoadPullRequestAnalysisResult(rc.Ctx, analysis)
+	if err != nil {
+		return nil, err
+	}
+
+	state := stateFromAnalysis(analysis, fullName)
+	state.SuppressedIssuesCount, err = issues.CountSuppressed(rc.DB, models.IssueAnalysisTypePullRequest,
+		analysis.ID, analysis.RepoID)
+	if err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
 func (s BasicService) UpdateAnalysisStateByAnalysisGUID(rc *request.InternalContext, req *AnalyzedRepo, state *State) error {
 	var analysis models.PullRequestAnalysis
 	err := models.NewPullRequestAnalysisQuerySet(rc.DB).GithubDeliveryGUIDEq(req.AnalysisGUID).One(&analysis)
@@ -231,15 +368,127 @@ func (s BasicService) UpdateAnalysisStateByAnalysisGUID(rc *request.InternalCont
 	if analysis.ResultJSON == nil {
 		analysis.ResultJSON = []byte("{}")
 	}
+	analysis.ResultArchiveKey = "" // the new result replaces the archived one
+
+	if err = s.saveAnalysis(rc, &analysis); err != nil {
+		return err
+	}
 
-	err = analysis.Update(rc.DB,
+	rc.Log.Infof("Updated analysis %s status: %s -> %s", req.AnalysisGUID, prevStatus, analysis.Status)
+	return nil
+}
+
+func (s BasicService) saveAnalysis(rc *request.InternalContext, analysis *models.PullRequestAnalysis) (retErr error) {
+	analysisIssues, err := issues.Parse(analysis.ResultJSON)
+	if err != nil {
+		// don't lose the analysis state because of unexpected result format
+		rc.Log.Warnf("Failed to parse issues of pull request analysis %s: %s", analysis.GithubDeliveryGUID, err)
+	}
+
+	analysis.TimingsJSON, err = extractTimingsJSON(analysis.ResultJSON)
+	if err != nil {
+		rc.Log.Warnf("Failed to extract timings of pull request analysis %s: %s", analysis.GithubDeliveryGUID, err)
+	}
+
+	tx, finishTx, err := gormdb.StartTx(rc.DB)
+	if err != nil {
+		return err
+	}
+	defer finishTx(&retErr)
+
+	analysis.IssuesStored = true
+	err = analysis.Update(tx,
 		models.PullRequestAnalysisDBSchema.Status,
 		models.PullRequestAnalysisDBSchema.ReportedIssuesCount,
-		models.PullRequestAnalysisDBSchema.ResultJSON)
+		models.PullRequestAnalysisDBSchema.ResultJSON,
+		models.PullRequestAnalysisDBSchema.TimingsJSON,
+		models.PullRequestAnalysisDBSchema.ResultArchiveKey,
+		models.PullRequestAnalysisDBSchema.IssuesStored)
 	if err != nil {
 		return errors.Wrapf(err, "can't update pr analysis state for analytis %#v", analysis)
 	}
 
-	rc.Log.Infof("Updated analysis %s status: %s -> %s", req.AnalysisGUID, prevStatus, analysis.Status)
-	return nil
+	return issues.Replace(tx, models.IssueAnalysisTypePullRequest, analysis.ID, analysisIssues)
+}
+
+// getAccessibleAnalysis returns the analysis of the repo if the user can read the repo
+func (s BasicService) getAccessibleAnalysis(rc *request.AnonymousContext, reqRepo *request.Repo,
+	analysisGUID string) (*models.PullRequestAnalysis, error) {
+
+	repoIDs, _, err := s.getRepoIDsForPullRequest(rc, &RepoPullRequest{Repo: *reqRepo})
+	if err != nil {
+		return nil, err
+	}
+
+	var analysis models.PullRequestAnalysis
+	err = models.NewPullRequestAnalysisQuerySet(rc.DB).
+		GithubDeliveryGUIDEq(analysisGUID).
+		RepoIDIn(repoIDs...).
+		One(&analysis)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.Wrapf(apierrors.ErrNotFound, "no analysis with guid %s for repo ids %v",
+				analysisGUID, repoIDs)
+		}
+		return nil, errors.Wrapf(err, "can't get analysis with guid %s", analysisGUID)
+	}
+
+	return &analysis, nil
+}
+
+func (s BasicService) ListIssues(rc *request.AnonymousContext, req *issues.ListRequest) (*issues.Page, error) {
+	analysis, err := s.getAccessibleAnalysis(rc, &req.Repo, req.AnalysisGUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return issues.List(rc.DB, models.IssueAnalysisTypePullRequest, analysis.ID, analysis.RepoID, req.Filter())
+}
+
+func (s BasicService) ExportSARIF(rc *request.AnonymousContext, req *AnalyzedRepo) (*sarif.Log, error) {
+	analysis, err := s.getAccessibleAnalysis(rc, &req.Repo, req.AnalysisGUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(analysis.Status, "processed/") {
+		return nil, errors.Wrapf(apierrors.ErrBadRequest, "analysis %s isn't processed: %s",
+			analysis.GithubDeliveryGUID, analysis.Status)
+	}
+
+	if err = s.ResultsArchive.LoadPullRequestAnalysisResult(rc.Ctx, analysis); err != nil {
+		return nil, err
+	}
+
+	return sarif.BuildFromResultJSON(rc.DB, analysis.ResultJSON, analysis.RepoID, "")
+}
+
+// GetReport responds with the file of the report in the requested format for CI dashboards
+func (s BasicService) GetReport(rc *request.AnonymousContext, req *ReportRequest) error {
+	format, err := reports.ParseFormat(req.Format)
+	if err != nil {
+		return errors.Wrap(apierrors.ErrBadRequest, err.Error())
+	}
+
+	analysis, err := s.getAccessibleAnalysis(rc, &req.Repo, req.AnalysisGUID)
+	if err != nil {
+		return err
+	}
+
+	if !strings.HasPrefix(analysis.Status, "processed/") {
+		return errors.Wrapf(apierrors.ErrBadRequest, "analysis %s isn't processed: %s",
+			analysis.GithubDeliveryGUID, analysis.Status)
+	}
+
+	if err = s.ResultsArchive.LoadPullRequestAnalysisResult(rc.Ctx, analysis); err != nil {
+		return err
+	}
+
+	report, err := reports.BuildFromResultJSON(rc.DB, format, analysis.ResultJSON, analysis.RepoID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to build %s report", format)
+	}
+
+	fileName := fmt.Sprintf("golangci-%s-%s.%s", analysis.CommitSHA, format, report.Extension)
+	return apierrors.NewFileResult(report.ContentType, fileName, report.Body)
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/golangci/golangci-api/internal/api/apierrors"
 	"github.com/golangci/golangci-api/internal/api/endpointutil"
 	"github.com/golangci/golangci-api/internal/api/transportutil"
+	"github.com/golangci/golangci-api/internal/shared/metrics"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 )
@@ -29,7 +30,7 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("GET").Path("/v1/repos/{provider}/{owner}/{name}/analyzes/{analysisguid}/state").Handler(hGetAnalysisStateByAnalysisGUID)
+	r.Methods("GET").Path("/v1/repos/{provider}/{owner}/{name}/analyzes/{analysisguid}/state").Handler(metrics.InstrumentHandler("pranalysis", "GetAnalysisStateByAnalysisGUID", hGetAnalysisStateByAnalysisGUID))
 
 	hGetAnalysisStateByPRNumber := httptransport.NewServer(
 		makeGetAnalysisStateByPRNumberEndpoint(svc, regCtx.Log),
@@ -44,7 +45,22 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("GET").Path("/v1/repos/{provider}/{owner}/{name}/pulls/{pullrequestnumber}").Handler(hGetAnalysisStateByPRNumber)
+	r.Methods("GET").Path("/v1/repos/{provider}/{owner}/{name}/pulls/{pullrequestnumber}").Handler(metrics.InstrumentHandler("pranalysis", "GetAnalysisStateByPRNumber", hGetAnalysisStateByPRNumber))
+
+	hGetHistoryByPRNumber := httptransport.NewServer(
+		makeGetHistoryByPRNumberEndpoint(svc, regCtx.Log),
+		decodeGetHistoryByPRNumberRequest,
+		encodeGetHistoryByPRNumberResponse,
+		httptransport.ServerBefore(transportutil.StoreHTTPRequestToContext),
+		httptransport.ServerAfter(transportutil.FinalizeSession),
+
+		httptransport.ServerBefore(transportutil.MakeStoreAnonymousRequestContext(*regCtx)),
+
+		httptransport.ServerFinalizer(transportutil.FinalizeRequest),
+		httptransport.ServerErrorEncoder(transportutil.EncodeError),
+		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
+	)
+	r.Methods("GET").Path("/v1/repos/{provider}/{owner}/{name}/pulls/{pullrequestnumber}/analyzes").Handler(metrics.InstrumentHandler("pranalysis", "GetHistoryByPRNumber", hGetHistoryByPRNumber))
 
 	hUpdateAnalysisStateByAnalysisGUID := httptransport.NewServer(
 		makeUpdateAnalysisStateByAnalysisGUIDEndpoint(svc, regCtx.Log),
@@ -59,7 +75,52 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("PUT").Path("/v1/repos/{provider}/{owner}/{name}/analyzes/{analysisguid}/state").Handler(hUpdateAnalysisStateByAnalysisGUID)
+	r.Methods("PUT").Path("/v1/repos/{provider}/{owner}/{name}/analyzes/{analysisguid}/state").Handler(metrics.InstrumentHandler("pranalysis", "UpdateAnalysisStateByAnalysisGUID", hUpdateAnalysisStateByAnalysisGUID))
+
+	hListIssues := httptransport.NewServer(
+		makeListIssuesEndpoint(svc, regCtx.Log),
+		decodeListIssuesRequest,
+		encodeListIssuesResponse,
+		httptransport.ServerBefore(transportutil.StoreHTTPRequestToContext),
+		httptransport.ServerAfter(transportutil.FinalizeSession),
+
+		httptransport.ServerBefore(transportutil.MakeStoreAnonymousRequestContext(*regCtx)),
+
+		httptransport.ServerFinalizer(transportutil.FinalizeRequest),
+		httptransport.ServerErrorEncoder(transportutil.EncodeError),
+		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
+	)
+	r.Methods("GET").Path("/v1/repos/{provider}/{owner}/{name}/analyzes/{analysisguid}/issues").Handler(metrics.InstrumentHandler("pranalysis", "ListIssues", hListIssues))
+
+	hExportSARIF := httptransport.NewServer(
+		makeExportSARIFEndpoint(svc, regCtx.Log),
+		decodeExportSARIFRequest,
+		encodeExportSARIFResponse,
+		httptransport.ServerBefore(transportutil.StoreHTTPRequestToContext),
+		httptransport.ServerAfter(transportutil.FinalizeSession),
+
+		httptransport.ServerBefore(transportutil.MakeStoreAnonymousRequestContext(*regCtx)),
+
+		httptransport.ServerFinalizer(transportutil.FinalizeRequest),
+		httptransport.ServerErrorEncoder(transportutil.EncodeError),
+		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
+	)
+	r.Methods("GET").Path("/v1/repos/{provider}/{owner}/{name}/analyzes/{analysisguid}/sarif").Handler(metrics.InstrumentHandler("pranalysis", "ExportSARIF", hExportSARIF))
+
+	hGetReport := httptransport.NewServer(
+		makeGetReportEndpoint(svc, regCtx.Log),
+		decodeGetReportRequest,
+		encodeGetReportResponse,
+		httptransport.ServerBefore(transportutil.StoreHTTPRequestToContext),
+		httptransport.ServerAfter(transportutil.FinalizeSession),
+
+		httptransport.ServerBefore(transportutil.MakeStoreAnonymousRequestContext(*regCtx)),
+
+		httptransport.ServerFinalizer(transportutil.FinalizeRequest),
+		httptransport.ServerErrorEncoder(transportutil.EncodeError),
+		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
+	)
+	r.Methods("GET").Path("/v1/repos/{provider}/{owner}/{name}/analyzes/{analysisguid}/report").Handler(metrics.InstrumentHandler("pranalysis", "GetReport", hGetReport))
 
 }
 
@@ -147,6 +208,48 @@ func encodeGetAnalysisStateByPRNumberResponse(ctx context.Context, w http.Respon
 	return json.NewEncoder(w).Encode(wrappedResp)
 }
 
+func decodeGetHistoryByPRNumberRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request GetHistoryByPRNumberRequest
+	if err := transportutil.DecodeRequest(&request, r); err != nil {
+		return nil, errors.Wrap(err, "can't decode request")
+	}
+
+	return request, nil
+}
+
+func encodeGetHistoryByPRNumberResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Add("Content-Type", "application/json; charset=UTF-8")
+	if err := transportutil.GetContextError(ctx); err != nil {
+		wrappedResp := struct {
+			Error *transportutil.Error
+		}{
+			Error: transportutil.MakeError(err),
+		}
+		w.WriteHeader(wrappedResp.Error.HTTPCode)
+		return json.NewEncoder(w).Encode(wrappedResp)
+	}
+
+	resp := response.(GetHistoryByPRNumberResponse)
+	wrappedResp := struct {
+		transportutil.ErrorResponse
+		GetHistoryByPRNumberResponse
+	}{
+		GetHistoryByPRNumberResponse: resp,
+	}
+
+	if resp.err != nil {
+		if apierrors.IsErrorLikeResult(resp.err) {
+			return transportutil.HandleErrorLikeResult(ctx, w, resp.err)
+		}
+
+		terr := transportutil.MakeError(resp.err)
+		wrappedResp.Error = terr
+		w.WriteHeader(terr.HTTPCode)
+	}
+
+	return json.NewEncoder(w).Encode(wrappedResp)
+}
+
 func decodeUpdateAnalysisStateByAnalysisGUIDRequest(_ context.Context, r *http.Request) (interface{}, error) {
 	var request UpdateAnalysisStateByAnalysisGUIDRequest
 	if err := transportutil.DecodeRequest(&request, r); err != nil {
@@ -188,3 +291,129 @@ func encodeUpdateAnalysisStateByAnalysisGUIDResponse(ctx context.Context, w http
 
 	return json.NewEncoder(w).Encode(wrappedResp)
 }
+
+func decodeListIssuesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request ListIssuesRequest
+	if err := transportutil.DecodeRequest(&request, r); err != nil {
+		return nil, errors.Wrap(err, "can't decode request")
+	}
+
+	return request, nil
+}
+
+func encodeListIssuesResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Add("Content-Type", "application/json; charset=UTF-8")
+	if err := transportutil.GetContextError(ctx); err != nil {
+		wrappedResp := struct {
+			Error *transportutil.Error
+		}{
+			Error: transportutil.MakeError(err),
+		}
+		w.WriteHeader(wrappedResp.Error.HTTPCode)
+		return json.NewEncoder(w).Encode(wrappedResp)
+	}
+
+	resp := response.(ListIssuesResponse)
+	wrappedResp := struct {
+		transportutil.ErrorResponse
+		ListIssuesResponse
+	}{
+		ListIssuesResponse: resp,
+	}
+
+	if resp.err != nil {
+		if apierrors.IsErrorLikeResult(resp.err) {
+			return transportutil.HandleErrorLikeResult(ctx, w, resp.err)
+		}
+
+		terr := transportutil.MakeError(resp.err)
+		wrappedResp.Error = terr
+		w.WriteHeader(terr.HTTPCode)
+	}
+
+	return json.NewEncoder(w).Encode(wrappedResp)
+}
+
+func decodeExportSARIFRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request ExportSARIFRequest
+	if err := transportutil.DecodeRequest(&request, r); err != nil {
+		return nil, errors.Wrap(err, "can't decode request")
+	}
+
+	return request, nil
+}
+
+func encodeExportSARIFResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Add("Content-Type", "application/json; charset=UTF-8")
+	if err := transportutil.GetContextError(ctx); err != nil {
+		wrappedResp := struct {
+			Error *transportutil.Error
+		}{
+			Error: transportutil.MakeError(err),
+		}
+		w.WriteHeader(wrappedResp.Error.HTTPCode)
+		return json.NewEncoder(w).Encode(wrappedResp)
+	}
+
+	resp := response.(ExportSARIFResponse)
+	wrappedResp := struct {
+		transportutil.ErrorResponse
+		ExportSARIFResponse
+	}{
+		ExportSARIFResponse: resp,
+	}
+
+	if resp.err != nil {
+		if apierrors.IsErrorLikeResult(resp.err) {
+			return transportutil.HandleErrorLikeResult(ctx, w, resp.err)
+		}
+
+		terr := transportutil.MakeError(resp.err)
+		wrappedResp.Error = terr
+		w.WriteHeader(terr.HTTPCode)
+	}
+
+	return json.NewEncoder(w).Encode(wrappedResp)
+}
+
+func decodeGetReportRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request GetReportRequest
+	if err := transportutil.DecodeRequest(&request, r); err != nil {
+		return nil, errors.Wrap(err, "can't decode request")
+	}
+
+	return request, nil
+}
+
+func encodeGetReportResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Add("Content-Type", "application/json; charset=UTF-8")
+	if err := transportutil.GetContextError(ctx); err != nil {
+		wrappedResp := struct {
+			Error *transportutil.Error
+		}{
+			Error: transportutil.MakeError(err),
+		}
+		w.WriteHeader(wrappedResp.Error.HTTPCode)
+		return json.NewEncoder(w).Encode(wrappedResp)
+	}
+
+	resp := response.(GetReportResponse)
+	wrappedResp := struct {
+		transportutil.ErrorResponse
+		GetReportResponse
+	}{
+		GetReportResponse: resp,
+	}
+
+	if resp.err != nil {
+		if apierrors.IsErrorLikeResult(resp.err) {
+			return transportutil.HandleErrorLikeResult(ctx, w, resp.err)
+		}
+
+		terr := transportutil.MakeError(resp.err)
+		wrappedResp.Error = terr
+		w.WriteHeader(terr.HTTPCode)
+	}
+
+	return json.NewEncoder(w).Encode(wrappedResp)
+}
@@ -12,9 +12,11 @@ import (
 	"github.com/golangci/golangci-api/internal/api/util"
 	"github.com/golangci/golangci-api/internal/shared/cache"
 	"github.com/golangci/golangci-api/internal/shared/config"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb"
 	"github.com/golangci/golangci-api/internal/shared/logutil"
 	"github.com/golangci/golangci-api/internal/shared/providers"
 	"github.com/golangci/golangci-api/internal/shared/providers/provider"
+	"github.com/golangci/golangci-api/pkg/api/auditlog"
 	"github.com/golangci/golangci-api/pkg/api/models"
 	"github.com/golangci/golangci-api/pkg/api/request"
 	"github.com/golangci/golangci-api/pkg/api/returntypes"
@@ -253,10 +255,59 @@ func (s BasicService) Create(rc *request.AuthorizedContext, reqRepo *request.Bod
 		}
 	}
 
-	return s.storeRepo(rc, providerRepo)
+	installationID, err := s.findInstallation(rc, providerRepo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find app installation")
+	}
+
+	return s.storeRepo(rc, providerRepo, installationID)
 }
 
-func (s BasicService) storeRepo(rc *request.AuthorizedContext, providerRepo *provider.Repo) (*returntypes.WrappedRepoInfo, error) {
+// findInstallation returns provider id of the app installation which has access to the repo or 0:
+// such repos are connected through the app without a hook and a collaborator
+func (s BasicService) findInstallation(rc *request.AuthorizedContext, providerRepo *provider.Repo) (int, error) {
+	owner := strings.ToLower(strings.Split(providerRepo.FullName, "/")[0])
+
+	var installations []models.Installation
+	err := models.NewInstallationQuerySet(rc.DB).
+		ProviderEq(rc.Auth.Provider).
+		AccountNameEq(owner).
+		OrderDescByID().
+		Limit(1).
+		All(&installations)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to fetch installations of %s", owner)
+	}
+	if len(installations) == 0 {
+		return 0, nil
+	}
+
+	inst := installations[0]
+	p, err := s.ProviderFactory.BuildForInstallation(inst.Provider, inst.ProviderID)
+	if err != nil {
+		if errors.Cause(err) == provider.ErrUnauthorized {
+			rc.Log.Warnf("Installation %#v isn't accessible, connect repo without it: %s", inst, err)
+			return 0, nil
+		}
+		return 0, errors.Wrapf(err, "failed to build provider for installation %d", inst.ProviderID)
+	}
+
+	// the app can be installed only for selected repos of the account
+	if _, err = p.GetRepoByName(rc.Ctx, owner, strings.Split(providerRepo.FullName, "/")[1]); err != nil {
+		if err == provider.ErrNotFound || err == provider.ErrUnauthorized {
+			rc.Log.Infof("Installation %#v has no access to repo %s", inst, providerRepo.FullName)
+			return 0, nil
+		}
+		return 0, errors.Wrapf(err, "failed to get repo %s by installation %d", providerRepo.FullName, inst.ProviderID)
+	}
+
+	rc.Log.Infof("Connecting repo %s through installation %#v", providerRepo.FullName, inst)
+	return inst.ProviderID, nil
+}
+
+func (s BasicService) storeRepo(rc *request.AuthorizedContext, providerRepo *provider.Repo,
+	installationID int) (*returntypes.WrappedRepoInfo, error) {
+
 	hookID, err := util.GenerateRandomString(32)
 	if err != nil {
 		return nil, errors.Wrap(err, "can't generate hook id")
@@ -272,8 +323,10 @@ func (s BasicService) storeRepo(rc *request.AuthorizedContext, providerRepo *pro
 		CommitState:     models.RepoCommitStateCreateInit,
 		StargazersCount: -1, // will be fetched later
 		IsPrivate:       providerRepo.IsPrivate,
+
+		ProviderInstallationID: installationID,
 	}
-	if err = repo.Create(rc.DB); err != nil {
+	if err = s.createRepo(rc, &repo); err != nil {
 		var existingRepo models.Repo
 		exists := models.NewRepoQuerySet(rc.DB).ProviderIDEq(providerRepo.ID).One(&existingRepo) == nil
 		if exists {
@@ -293,6 +346,21 @@ func (s BasicService) storeRepo(rc *request.AuthorizedContext, providerRepo *pro
 	return ret, nil
 }
 
+func (s BasicService) createRepo(rc *request.AuthorizedContext, repo *models.Repo) (retErr error) {
+	tx, finishTx, err := gormdb.StartTx(rc.DB)
+	if err != nil {
+		return err
+	}
+	defer finishTx(&retErr)
+
+	if err = repo.Create(tx); err != nil {
+		return err
+	}
+
+	entry := auditlog.NewRepoEntry(repo, models.AuditLogActionRepoCreate)
+	return auditlog.Write(rc, tx, entry, nil, auditlog.NewRepoState(repo))
+}
+
 func (s BasicService) Get(rc *request.AuthorizedContext, reqRepo *request.RepoID) (*returntypes.WrappedRepoInfo, error) {
 	var repo models.Repo
 	if err := models.NewRepoQuerySet(rc.DB.Unscoped()).IDEq(reqRepo.ID).One(&repo); err != nil {
@@ -347,13 +415,8 @@ func (s BasicService) startDelete(rc *request.AuthorizedContext, repo *models.Re
 		return nil, errors.New("no admin permission on repo")
 	}
 
-	n, err := models.NewRepoQuerySet(rc.DB).IDEq(repo.ID).CommitStateEq(models.RepoCommitStateCreateDone).
-		GetUpdater().SetCommitState(models.RepoCommitStateDeleteInit).UpdateNum()
-	if err != nil {
-		return nil, errors.Wrap(err, "can't update repo commit state")
-	}
-	if n != 1 {
-		return nil, fmt.Errorf("race condition during update repo with id %d, n=%d, repo=%#v", repo.ID, n, repo)
+	if err = s.markRepoDeleting(rc, repo); err != nil {
+		return nil, err
 	}
 
 	ret, err := s.sendToDeleteQueue(rc, repo)
@@ -365,6 +428,29 @@ func (s BasicService) startDelete(rc *request.AuthorizedContext, repo *models.Re
 	return ret, nil
 }
 
+func (s BasicService) markRepoDeleting(rc *request.AuthorizedContext, repo *models.Repo) (retErr error) {
+	tx, finishTx, err := gormdb.StartTx(rc.DB)
+	if err != nil {
+		return err
+	}
+	defer finishTx(&retErr)
+
+	n, err := models.NewRepoQuerySet(tx).IDEq(repo.ID).CommitStateEq(models.RepoCommitStateCreateDone).
+		GetUpdater().SetCommitState(models.RepoCommitStateDeleteInit).UpdateNum()
+	if err != nil {
+		return errors.Wrap(err, "can't update repo commit state")
+	}
+	if n != 1 {
+		return fmt.Errorf("race condition during update repo with id %d, n=%d, repo=%#v", repo.ID, n, repo)
+	}
+
+	before := auditlog.NewRepoState(repo)
+	repo.CommitState = models.RepoCommitStateDeleteInit
+
+	entry := auditlog.NewRepoEntry(repo, models.AuditLogActionRepoDelete)
+	return auditlog.Write(rc, tx, entry, before, nil)
+}
+
 func (s BasicService) sendToDeleteQueue(rc *request.AuthorizedContext, repo *models.Repo) (*returntypes.WrappedRepoInfo, error) {
 	// It's important to send rc.Auth.UserID to queue because it can differ from repo.UserID
 	if err := s.DeleteQueue.Put(repo.ID, rc.Auth.UserID); err != nil {
@@ -10,6 +10,7 @@ import (
 	"github.com/golangci/golangci-api/internal/api/apierrors"
 	"github.com/golangci/golangci-api/internal/api/endpointutil"
 	"github.com/golangci/golangci-api/internal/api/transportutil"
+	"github.com/golangci/golangci-api/internal/shared/metrics"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 )
@@ -29,7 +30,7 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("POST").Path("/v1/repos").Handler(hCreate)
+	r.Methods("POST").Path("/v1/repos").Handler(metrics.InstrumentHandler("repo", "Create", hCreate))
 
 	hGet := httptransport.NewServer(
 		makeGetEndpoint(svc, regCtx.Log),
@@ -44,7 +45,7 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("GET").Path("/v1/repos/{repoid}").Handler(hGet)
+	r.Methods("GET").Path("/v1/repos/{repoid}").Handler(metrics.InstrumentHandler("repo", "Get", hGet))
 
 	hDelete := httptransport.NewServer(
 		makeDeleteEndpoint(svc, regCtx.Log),
@@ -59,7 +60,7 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("DELETE").Path("/v1/repos/{repoid}").Handler(hDelete)
+	r.Methods("DELETE").Path("/v1/repos/{repoid}").Handler(metrics.InstrumentHandler("repo", "Delete", hDelete))
 
 	hList := httptransport.NewServer(
 		makeListEndpoint(svc, regCtx.Log),
@@ -74,7 +75,7 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("GET").Path("/v1/repos").Handler(hList)
+	r.Methods("GET").Path("/v1/repos").Handler(metrics.InstrumentHandler("repo", "List", hList))
 
 }
 
@@ -9,8 +9,10 @@ import (
 	"github.com/golangci/golangci-api/internal/api/apierrors"
 	"github.com/golangci/golangci-api/internal/api/endpointutil"
 	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/pkg/api/issues"
 	"github.com/golangci/golangci-api/pkg/api/models"
 	"github.com/golangci/golangci-api/pkg/api/request"
+	"github.com/golangci/golangci-api/pkg/api/sarif"
 	"github.com/pkg/errors"
 )
 
@@ -168,3 +170,204 @@ func makeUpdateByAnalysisGUIDEndpoint(svc Service, log logutil.Log) endpoint.End
 
 	}
 }
+
+type ListIssuesRequest struct {
+	Req *issues.ListRequest
+}
+
+type ListIssuesResponse struct {
+	err error
+	*issues.Page
+}
+
+func makeListIssuesEndpoint(svc Service, log logutil.Log) endpoint.Endpoint {
+	return func(ctx context.Context, reqObj interface{}) (resp interface{}, err error) {
+
+		req := reqObj.(ListIssuesRequest)
+
+		reqLogger := log
+		defer func() {
+			if rerr := recover(); rerr != nil {
+				reqLogger.Errorf("Panic occurred")
+				reqLogger.Infof("%s", debug.Stack())
+				resp = ListIssuesResponse{
+					err: errors.New("panic occurred"),
+				}
+				err = nil
+			}
+		}()
+
+		if err := endpointutil.Error(ctx); err != nil {
+			log.Warnf("Error occurred during request context creation: %s", err)
+			resp = ListIssuesResponse{
+				err: err,
+			}
+			return resp, nil
+		}
+
+		rc := endpointutil.RequestContext(ctx).(*request.AnonymousContext)
+		reqLogger = rc.Log
+
+		req.Req.FillLogContext(rc.Lctx)
+
+		v, err := svc.ListIssues(rc, req.Req)
+		if err != nil {
+			rc.Log.Errorf("repoanalysis.Service.ListIssues failed: %s", err)
+			return ListIssuesResponse{err, v}, nil
+		}
+
+		return ListIssuesResponse{nil, v}, nil
+
+	}
+}
+
+type ExportSARIFRequest struct {
+	Rac *Context
+}
+
+type ExportSARIFResponse struct {
+	err error
+	*sarif.Log
+}
+
+func makeExportSARIFEndpoint(svc Service, log logutil.Log) endpoint.Endpoint {
+	return func(ctx context.Context, reqObj interface{}) (resp interface{}, err error) {
+
+		req := reqObj.(ExportSARIFRequest)
+
+		reqLogger := log
+		defer func() {
+			if rerr := recover(); rerr != nil {
+				reqLogger.Errorf("Panic occurred")
+				reqLogger.Infof("%s", debug.Stack())
+				resp = ExportSARIFResponse{
+					err: errors.New("panic occurred"),
+				}
+				err = nil
+			}
+		}()
+
+		if err := endpointutil.Error(ctx); err != nil {
+			log.Warnf("Error occurred during request context creation: %s", err)
+			resp = ExportSARIFResponse{
+				err: err,
+			}
+			return resp, nil
+		}
+
+		rc := endpointutil.RequestContext(ctx).(*request.AnonymousContext)
+		reqLogger = rc.Log
+
+		req.Rac.FillLogContext(rc.Lctx)
+
+		v, err := svc.ExportSARIF(rc, req.Rac)
+		if err != nil {
+			rc.Log.Errorf("repoanalysis.Service.ExportSARIF failed: %s", err)
+			return ExportSARIFResponse{err, v}, nil
+		}
+
+		return ExportSARIFResponse{nil, v}, nil
+
+	}
+}
+
+type GetReportRequest struct {
+	Req *ReportRequest
+}
+
+type GetReportResponse struct {
+	err error
+}
+
+func makeGetReportEndpoint(svc Service, log logutil.Log) endpoint.Endpoint {
+	return func(ctx context.Context, reqObj interface{}) (resp interface{}, err error) {
+
+		req := reqObj.(GetReportRequest)
+
+		reqLogger := log
+		defer func() {
+			if rerr := recover(); rerr != nil {
+				reqLogger.Errorf("Panic occurred")
+				reqLogger.Infof("%s", debug.Stack())
+				resp = GetReportResponse{
+					err: errors.New("panic occurred"),
+				}
+				err = nil
+			}
+		}()
+
+		if err := endpointutil.Error(ctx); err != nil {
+			log.Warnf("Error occurred during request context creation: %s", err)
+			resp = GetReportResponse{
+				err: err,
+			}
+			return resp, nil
+		}
+
+		rc := endpointutil.RequestContext(ctx).(*request.AnonymousContext)
+		reqLogger = rc.Log
+
+		req.Req.FillLogContext(rc.Lctx)
+
+		err = svc.GetReport(rc, req.Req)
+		if err != nil {
+			if !apierrors.IsErrorLikeResult(err) {
+				rc.Log.Errorf("repoanalysis.Service.GetReport failed: %s", err)
+			}
+			return GetReportResponse{err}, nil
+		}
+
+		return GetReportResponse{nil}, nil
+
+	}
+}
+
+type GetScoreHistoryRequest struct {
+	Req *ScoreHistoryRequest
+}
+
+type GetScoreHistoryResponse struct {
+	err error
+	*ScoreHistory
+}
+
+func makeGetScoreHistoryEndpoint(svc Service, log logutil.Log) endpoint.Endpoint {
+	return func(ctx context.Context, reqObj interface{}) (resp interface{}, err error) {
+
+		req := reqObj.(GetScoreHistoryRequest)
+
+		reqLogger := log
+		defer func() {
+			if rerr := recover(); rerr != nil {
+				reqLogger.Errorf("Panic occurred")
+				reqLogger.Infof("%s", debug.Stack())
+				resp = GetScoreHistoryResponse{
+					err: errors.New("panic occurred"),
+				}
+				err = nil
+			}
+		}()
+
+		if err := endpointutil.Error(ctx); err != nil {
+			log.Warnf("Error occurred during request context creation: %s", err)
+			resp = GetScoreHistoryResponse{
+				err: err,
+			}
+			return resp, nil
+		}
+
+		rc := endpointutil.RequestContext(ctx).(*request.AnonymousContext)
+		reqLogger = rc.Log
+
+		req.Req.FillLogContext(rc.Lctx)
+
+		v, err := svc.GetScoreHistory(rc, req.Req)
+		if err != nil {
+			rc.Log.Errorf("repoanalysis.Service.GetScoreHistory failed: %s", err)
+			return GetScoreHistoryResponse{err, v}, nil
+		}
+
+		return GetScoreHistoryResponse{nil, v}, nil
+
+	}
+}
@@ -1,15 +1,29 @@
 package repoanalysis
 
 import (
+	"encoding/json"
+	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/golangci/golangci-api/pkg/worker/analyze/processors"
 
 	"github.com/golangci/golangci-api/pkg/api/policy"
 
+	"github.com/golangci/golangci-api/internal/api/apierrors"
+	"github.com/golangci/golangci-api/internal/api/score"
+	"github.com/golangci/golangci-api/internal/shared/config"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb"
 	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/pkg/api/issues"
 	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/api/reports"
 	"github.com/golangci/golangci-api/pkg/api/request"
+	"github.com/golangci/golangci-api/pkg/api/resultsarchive"
+	"github.com/golangci/golangci-api/pkg/api/sarif"
+	"github.com/golangci/golangci-api/pkg/api/workers/primaryqueue/codescanning"
+	"github.com/golangci/golangci-api/pkg/api/workers/primaryqueue/emails"
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
 )
@@ -22,6 +36,8 @@ type Status struct {
 	IsPreparing        bool   `json:",omitempty"`
 	RepoIsNotConnected bool   `json:",omitempty"`
 	IsEmpty            bool   `json:",omitempty"`
+
+	SuppressedIssuesCount int `json:",omitempty"`
 }
 
 type Context struct {
@@ -44,8 +60,48 @@ func (r statusRequest) FillLogContext(lctx logutil.Context) {
 
 type updateRepoPayload models.RepoAnalysis
 
+type ReportRequest struct {
+	request.Repo
+	AnalysisGUID string `request:",urlPart,"`
+
+	Format string `request:"format,urlParam,optional"` // json by default
+}
+
+func (r ReportRequest) FillLogContext(lctx logutil.Context) {
+	r.Repo.FillLogContext(lctx)
+	lctx["analysis_guid"] = r.AnalysisGUID
+	lctx["format"] = r.Format
+}
+
 func (p updateRepoPayload) FillLogContext(lctx logutil.Context) {}
 
+type ScoreHistoryRequest struct {
+	request.Repo
+	Limit int `request:"limit,urlParam,optional"`
+}
+
+func (r ScoreHistoryRequest) FillLogContext(lctx logutil.Context) {
+	r.Repo.FillLogContext(lctx)
+}
+
+type ScorePoint struct {
+	AnalysisGUID string    `json:"analysisGuid"`
+	CommitSHA    string    `json:"commitSha"`
+	CreatedAt    time.Time `json:"createdAt"`
+	Score        int       `json:"score"`
+	MaxScore     int       `json:"maxScore"`
+}
+
+// ScoreHistory is ordered from the newest analysis to the oldest one
+type ScoreHistory struct {
+	Points []ScorePoint `json:"points"`
+}
+
+const (
+	defaultScoreHistorySize = 30
+	maxScoreHistorySize     = 365
+)
+
 type Service interface {
 	//url:/v1/repos/{provider}/{owner}/{name}/repoanalyzes
 	GetStatus(rc *request.AnonymousContext, repo *request.Repo, sr *statusRequest) (*Status, error)
@@ -55,10 +111,26 @@ type Service interface {
 
 	//url:/v1/repos/{provider}/{owner}/{name}/repoanalyzes/{analysisguid} method:PUT
 	UpdateByAnalysisGUID(rc *request.InternalContext, rac *Context, update *updateRepoPayload) error
+
+	//url:/v1/repos/{provider}/{owner}/{name}/repoanalyzes/{analysisguid}/issues
+	ListIssues(rc *request.AnonymousContext, req *issues.ListRequest) (*issues.Page, error)
+
+	//url:/v1/repos/{provider}/{owner}/{name}/repoanalyzes/{analysisguid}/sarif
+	ExportSARIF(rc *request.AnonymousContext, rac *Context) (*sarif.Log, error)
+
+	//url:/v1/repos/{provider}/{owner}/{name}/repoanalyzes/{analysisguid}/report
+	GetReport(rc *request.AnonymousContext, req *ReportRequest) error
+
+	//url:/v1/repos/{provider}/{owner}/{name}/scores
+	GetScoreHistory(rc *request.AnonymousContext, req *ScoreHistoryRequest) (*ScoreHistory, error)
 }
 
 type BasicService struct {
-	RepoPolicy *policy.Repo
+	RepoPolicy           *policy.Repo
+	Notifier             *emails.SenderProducer
+	CodeScanningUploader *codescanning.UploaderProducer
+	ResultsArchive       *resultsarchive.Archive // nil if archiving of results is disabled
+	Cfg                  config.Config
 }
 
 func (s BasicService) isCompleteAnalysisStatus(status string) bool {
@@ -122,7 +194,19 @@ func (s BasicService) GetStatus(rc *request.AnonymousContext, reqRepo *request.R
 		return nil, errors.Wrapf(err, "can't get repo analyzes with analysis status id %d", as.ID)
 	}
 
-	return s.buildStatus(analyzes, &repo, &as), nil
+	status := s.buildStatus(analyzes, &repo, &as)
+	if status.ID != 0 {
+		if err = s.ResultsArchive.LoadRepoAnalysisResult(rc.Ctx, &status.RepoAnalysis); err != nil {
+			return nil, err
+		}
+
+		status.SuppressedIssuesCount, err = issues.CountSuppressed(rc.DB, models.IssueAnalysisTypeRepo, status.ID, repo.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return status, nil
 }
 
 func (s BasicService) buildStatus(analyzes []models.RepoAnalysis, repo *models.Repo, as *models.RepoAnalysisStatus) *Status {
@@ -170,6 +254,10 @@ func (s BasicService) GetByAnalysisGUID(rc *request.InternalContext, rac *Contex
 		return nil, errors.Wrapf(err, "can't get repo analysis with guid %s", rac.AnalysisGUID)
 	}
 
+	if err = s.ResultsArchive.LoadRepoAnalysisResult(rc.Ctx, &analysis); err != nil {
+		return nil, err
+	}
+
 	return &analysis, nil
 }
 
@@ -188,13 +276,402 @@ func (s BasicService) UpdateByAnalysisGUID(rc *request.InternalContext, rac *Con
 	if analysis.ResultJSON == nil {
 		analysis.ResultJSON = []byte("{}")
 	}
-	err = analysis.Update(rc.DB,
+	analysis.ResultArchiveKey = "" // the new result replaces the archived one
+	if version, _ := usedLintersVersion(analysis.ResultJSON); version != "" {
+		analysis.LintersVersion = version
+	}
+	if err = s.saveAnalysis(rc, &analysis); err != nil {
+		return err
+	}
+
+	rc.Log.Infof("Updated repo analysis %s state: status: %s -> %s", rac.AnalysisGUID, prevStatus, analysis.Status)
+
+	if analysis.Status == processors.StatusError && prevStatus != processors.StatusError {
+		if err = s.notifyAboutFailure(rc, &analysis); err != nil {
+			rc.Log.Warnf("Failed to notify about failed repo analysis %s: %s", analysis.AnalysisGUID, err)
+		}
+	}
+
+	if analysis.Status == processors.StatusProcessed && analysis.NewIssuesCount != 0 {
+		if err = s.notifyAboutNewIssues(rc, &analysis); err != nil {
+			rc.Log.Warnf("Failed to notify about new issues of repo analysis %s: %s", analysis.AnalysisGUID, err)
+		}
+	}
+
+	// uploading is optional: not every provider plan supports code scanning
+	if analysis.Status == processors.StatusProcessed && s.Cfg.GetBool("CODE_SCANNING_UPLOAD_ENABLED", false) {
+		if err = s.CodeScanningUploader.Put(analysis.ID); err != nil {
+			rc.Log.Warnf("Failed to enqueue code scanning upload of repo analysis %s: %s", analysis.AnalysisGUID, err)
+		}
+	}
+
+	return nil
+}
+
+type resultLintersVersion struct {
+	WorkerRes struct {
+		GolangciLintVersion      string
+		GolangciLintVersionRange string
+	}
+}
+
+// usedLintersVersion returns the golangci-lint version used by the worker with the "v" prefix
+// or an empty string if the worker didn't set it up, and the version range from the repo config
+// or an empty string if the default version was used
+func usedLintersVersion(resultJSON []byte) (string, string) {
+	var res resultLintersVersion
+	if err := json.Unmarshal(resultJSON, &res); err != nil || res.WorkerRes.GolangciLintVersion == "" {
+		return "", ""
+	}
+
+	return "v" + res.WorkerRes.GolangciLintVersion, res.WorkerRes.GolangciLintVersionRange
+}
+
+func (s BasicService) saveAnalysis(rc *request.InternalContext, analysis *models.RepoAnalysis) (retErr error) {
+	analysisIssues, err := issues.Parse(analysis.ResultJSON)
+	if err != nil {
+		// don't lose the analysis status because of unexpected result format
+		rc.Log.Warnf("Failed to parse issues of repo analysis %s: %s", analysis.AnalysisGUID, err)
+	}
+
+	if analysis.Status == processors.StatusProcessed {
+		if err = s.calcScore(rc, analysis); err != nil {
+			rc.Log.Warnf("Failed to calculate score of repo analysis %s: %s", analysis.AnalysisGUID, err)
+		}
+	}
+
+	tx, finishTx, err := gormdb.StartTx(rc.DB)
+	if err != nil {
+		return err
+	}
+	defer finishTx(&retErr)
+
+	if analysis.Status == processors.StatusProcessed {
+		if err = s.diffWithPreviousAnalysis(tx, analysis, analysisIssues); err != nil {
+			return err
+		}
+	}
+
+	analysis.IssuesStored = true
+	err = analysis.Update(tx,
 		models.RepoAnalysisDBSchema.Status,
-		models.RepoAnalysisDBSchema.ResultJSON)
+		models.RepoAnalysisDBSchema.ResultJSON,
+		models.RepoAnalysisDBSchema.ResultArchiveKey,
+		models.RepoAnalysisDBSchema.IssuesStored,
+		models.RepoAnalysisDBSchema.PreviousAnalysisID,
+		models.RepoAnalysisDBSchema.NewIssuesCount,
+		models.RepoAnalysisDBSchema.FixedIssuesCount,
+		models.RepoAnalysisDBSchema.UnchangedIssuesCount,
+		models.RepoAnalysisDBSchema.Score,
+		models.RepoAnalysisDBSchema.MaxScore,
+		models.RepoAnalysisDBSchema.ScoreRecommendations,
+		models.RepoAnalysisDBSchema.LintersVersion)
 	if err != nil {
 		return errors.Wrap(err, "can't update repo analysis")
 	}
 
-	rc.Log.Infof("Updated repo analysis %s state: status: %s -> %s", rac.AnalysisGUID, prevStatus, analysis.Status)
+	if analysis.Status == processors.StatusProcessed && analysis.LintersVersion != "" {
+		// the range is kept to reanalyze the repo only if its version is changed
+		_, versionRange := usedLintersVersion(analysis.ResultJSON)
+		err = models.NewRepoAnalysisStatusQuerySet(tx).
+			IDEq(analysis.RepoAnalysisStatusID).
+			GetUpdater().
+			SetLastAnalyzedLintersVersion(analysis.LintersVersion).
+			SetLastAnalyzedLintersVersionRange(versionRange).
+			Update()
+		if err != nil {
+			return errors.Wrap(err, "can't update last analyzed golangci-lint version")
+		}
+	}
+
+	return issues.Replace(tx, models.IssueAnalysisTypeRepo, analysis.ID, analysisIssues)
+}
+
+// getScoreProfile returns the scoring profile of the repo owner org or nil for the default one
+func (s BasicService) getScoreProfile(rc *request.InternalContext, analysis *models.RepoAnalysis) (*score.Profile, error) {
+	repo, err := s.getAnalysisRepo(rc, analysis)
+	if err != nil {
+		return nil, err
+	}
+
+	var orgs []models.Org
+	if err = models.NewOrgQuerySet(rc.DB).ProviderEq(repo.Provider).NameEq(repo.Owner()).Limit(1).All(&orgs); err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch org %s/%s", repo.Provider, repo.Owner())
+	}
+	if len(orgs) == 0 {
+		return nil, nil
+	}
+
+	settings, err := orgs[0].UnmarshalSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	return settings.GetScoreProfile(), nil
+}
+
+func (s BasicService) calcScore(rc *request.InternalContext, analysis *models.RepoAnalysis) error {
+	profile, err := s.getScoreProfile(rc, analysis)
+	if err != nil {
+		return err
+	}
+
+	res, err := score.Calculator{Profile: profile}.CalcForResultJSON(analysis.ResultJSON)
+	if err != nil {
+		return err
+	}
+
+	recommendations, err := json.Marshal(res.Recommendations)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal recommendations")
+	}
+
+	analysis.Score = res.Score
+	analysis.MaxScore = res.MaxScore
+	analysis.ScoreRecommendations = recommendations
+	return nil
+}
+
+// diffWithPreviousAnalysis classifies issues of the analysis as new, fixed or unchanged
+// comparing them with the previous processed analysis of the default branch
+func (s BasicService) diffWithPreviousAnalysis(db *gorm.DB, analysis *models.RepoAnalysis, analysisIssues []models.Issue) error {
+	var prevAnalyzes []models.RepoAnalysis
+	err := models.NewRepoAnalysisQuerySet(db).
+		RepoAnalysisStatusIDEq(analysis.RepoAnalysisStatusID).
+		StatusEq(processors.StatusProcessed).
+		IDLt(analysis.ID).
+		OrderDescByID().
+		Limit(1).
+		All(&prevAnalyzes)
+	if err != nil {
+		return errors.Wrapf(err, "can't get previous analysis of repo analysis %d", analysis.ID)
+	}
+	if len(prevAnalyzes) == 0 {
+		return nil // the first analysis: nothing to compare with
+	}
+
+	prev := &prevAnalyzes[0]
+	var prevIssues []models.Issue
+	err = models.NewIssueQuerySet(db).
+		AnalysisTypeEq(models.IssueAnalysisTypeRepo).
+		AnalysisIDEq(prev.ID).
+		All(&prevIssues)
+	if err != nil {
+		return errors.Wrapf(err, "can't get issues of repo analysis %d", prev.ID)
+	}
+
+	d := issues.Classify(prevIssues, analysisIssues)
+	analysis.PreviousAnalysisID = prev.ID
+	analysis.NewIssuesCount = d.New
+	analysis.FixedIssuesCount = d.Fixed
+	analysis.UnchangedIssuesCount = d.Unchanged
 	return nil
 }
+
+func (s BasicService) getAnalysisRepo(rc *request.InternalContext, analysis *models.RepoAnalysis) (*models.Repo, error) {
+	var as models.RepoAnalysisStatus
+	if err := models.NewRepoAnalysisStatusQuerySet(rc.DB).IDEq(analysis.RepoAnalysisStatusID).One(&as); err != nil {
+		return nil, errors.Wrapf(err, "can't get repo analysis status %d", analysis.RepoAnalysisStatusID)
+	}
+
+	var repo models.Repo
+	if err := models.NewRepoQuerySet(rc.DB).IDEq(as.RepoID).One(&repo); err != nil {
+		return nil, errors.Wrapf(err, "can't get repo %d", as.RepoID)
+	}
+
+	return &repo, nil
+}
+
+func (s BasicService) getReportURL(repo *models.Repo) string {
+	return fmt.Sprintf("%s/r/%s/%s", s.Cfg.GetString("WEB_ROOT"), repo.Provider, repo.DisplayFullName)
+}
+
+func (s BasicService) notifyAboutFailure(rc *request.InternalContext, analysis *models.RepoAnalysis) error {
+	repo, err := s.getAnalysisRepo(rc, analysis)
+	if err != nil {
+		return err
+	}
+
+	data := map[string]string{
+		"Repo":      repo.DisplayFullName,
+		"ReportURL": s.getReportURL(repo),
+	}
+	return s.Notifier.Put(models.NotificationEventAnalysisFailed, []uint{repo.UserID}, data, analysis.CommitSHA)
+}
+
+func (s BasicService) notifyAboutNewIssues(rc *request.InternalContext, analysis *models.RepoAnalysis) error {
+	repo, err := s.getAnalysisRepo(rc, analysis)
+	if err != nil {
+		return err
+	}
+
+	data := map[string]string{
+		"Repo":            repo.DisplayFullName,
+		"CommitSHA":       analysis.CommitSHA,
+		"NewIssues":       strconv.Itoa(analysis.NewIssuesCount),
+		"FixedIssues":     strconv.Itoa(analysis.FixedIssuesCount),
+		"UnchangedIssues": strconv.Itoa(analysis.UnchangedIssuesCount),
+		"ReportURL":       s.getReportURL(repo),
+	}
+	return s.Notifier.Put(models.NotificationEventNewIssues, []uint{repo.UserID}, data, analysis.CommitSHA)
+}
+
+// getAccessibleRepo returns the connected repo if the user can read it
+func (s BasicService) getAccessibleRepo(rc *request.AnonymousContext, reqRepo *request.Repo) (*models.Repo, error) {
+	var repo models.Repo
+	err := models.NewRepoQuerySet(rc.DB).
+		ProviderEq(reqRepo.Provider).
+		FullNameEq(strings.ToLower(reqRepo.FullName())).
+		One(&repo)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.Wrapf(apierrors.ErrNotFound, "no repo %s", reqRepo.FullNameWithProvider())
+		}
+		return nil, errors.Wrapf(err, "can't get repo for %s", reqRepo.FullNameWithProvider())
+	}
+
+	if repo.IsPrivate {
+		if err = s.RepoPolicy.CanReadPrivateRepo(rc, &repo); err != nil {
+			return nil, err
+		}
+	}
+
+	return &repo, nil
+}
+
+// getAccessibleAnalysis returns the analysis of the repo if the user can read the repo
+func (s BasicService) getAccessibleAnalysis(rc *request.AnonymousContext, reqRepo *request.Repo,
+	analysisGUID string) (*models.Repo, *models.RepoAnalysis, error) {
+
+	repo, err := s.getAccessibleRepo(rc, reqRepo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var analysis models.RepoAnalysis
+	err = models.NewRepoAnalysisQuerySet(rc.DB).
+		AnalysisGUIDEq(analysisGUID).
+		One(&analysis)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil, errors.Wrapf(apierrors.ErrNotFound, "no repo analysis with guid %s", analysisGUID)
+		}
+		return nil, nil, errors.Wrapf(err, "can't get repo analysis with guid %s", analysisGUID)
+	}
+
+	var as models.RepoAnalysisStatus
+	if err = models.NewRepoAnalysisStatusQuerySet(rc.DB).IDEq(analysis.RepoAnalysisStatusID).One(&as); err != nil {
+		return nil, nil, errors.Wrapf(err, "can't get repo analysis status %d", analysis.RepoAnalysisStatusID)
+	}
+	if as.RepoID != repo.ID {
+		return nil, nil, errors.Wrapf(apierrors.ErrNotFound, "repo analysis %s doesn't belong to repo %d",
+			analysisGUID, repo.ID)
+	}
+
+	return repo, &analysis, nil
+}
+
+func (s BasicService) ListIssues(rc *request.AnonymousContext, req *issues.ListRequest) (*issues.Page, error) {
+	repo, analysis, err := s.getAccessibleAnalysis(rc, &req.Repo, req.AnalysisGUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return issues.List(rc.DB, models.IssueAnalysisTypeRepo, analysis.ID, repo.ID, req.Filter())
+}
+
+func (s BasicService) ExportSARIF(rc *request.AnonymousContext, rac *Context) (*sarif.Log, error) {
+	repo, analysis, err := s.getAccessibleAnalysis(rc, &rac.Repo, rac.AnalysisGUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if analysis.Status != processors.StatusProcessed {
+		return nil, errors.Wrapf(apierrors.ErrBadRequest, "repo analysis %s isn't processed: %s",
+			analysis.AnalysisGUID, analysis.Status)
+	}
+
+	if err = s.ResultsArchive.LoadRepoAnalysisResult(rc.Ctx, analysis); err != nil {
+		return nil, err
+	}
+
+	return sarif.BuildFromResultJSON(rc.DB, analysis.ResultJSON, repo.ID, analysis.LintersVersion)
+}
+
+// GetReport responds with the file of the report in the requested format for CI dashboards
+func (s BasicService) GetReport(rc *request.AnonymousContext, req *ReportRequest) error {
+	format, err := reports.ParseFormat(req.Format)
+	if err != nil {
+		return errors.Wrap(apierrors.ErrBadRequest, err.Error())
+	}
+
+	repo, analysis, err := s.getAccessibleAnalysis(rc, &req.Repo, req.AnalysisGUID)
+	if err != nil {
+		return err
+	}
+
+	if analysis.Status != processors.StatusProcessed {
+		return errors.Wrapf(apierrors.ErrBadRequest, "repo analysis %s isn't processed: %s",
+			analysis.AnalysisGUID, analysis.Status)
+	}
+
+	if err = s.ResultsArchive.LoadRepoAnalysisResult(rc.Ctx, analysis); err != nil {
+		return err
+	}
+
+	report, err := reports.BuildFromResultJSON(rc.DB, format, analysis.ResultJSON, repo.ID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to build %s report", format)
+	}
+
+	fileName := fmt.Sprintf("golangci-%s-%s.%s", analysis.CommitSHA, format, report.Extension)
+	return apierrors.NewFileResult(report.ContentType, fileName, report.Body)
+}
+
+func (s BasicService) GetScoreHistory(rc *request.AnonymousContext, req *ScoreHistoryRequest) (*ScoreHistory, error) {
+	repo, err := s.getAccessibleRepo(rc, &req.Repo)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultScoreHistorySize
+	}
+	if limit > maxScoreHistorySize {
+		limit = maxScoreHistorySize
+	}
+
+	ret := ScoreHistory{Points: []ScorePoint{}}
+
+	var as models.RepoAnalysisStatus
+	if err = models.NewRepoAnalysisStatusQuerySet(rc.DB).RepoIDEq(repo.ID).One(&as); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return &ret, nil // not analyzed yet
+		}
+		return nil, errors.Wrapf(err, "can't get repo analysis status for repo %d", repo.ID)
+	}
+
+	var analyzes []models.RepoAnalysis
+	err = models.NewRepoAnalysisQuerySet(rc.DB).
+		RepoAnalysisStatusIDEq(as.ID).
+		MaxScoreNe(0).
+		OrderDescByID().
+		Limit(limit).
+		All(&analyzes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't get scored repo analyzes with analysis status id %d", as.ID)
+	}
+
+	for _, a := range analyzes {
+		ret.Points = append(ret.Points, ScorePoint{
+			AnalysisGUID: a.AnalysisGUID,
+			CommitSHA:    a.CommitSHA,
+			CreatedAt:    a.CreatedAt,
+			Score:        a.Score,
+			MaxScore:     a.MaxScore,
+		})
+	}
+
+	return &ret, nil
+}
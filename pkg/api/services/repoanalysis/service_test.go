@@ -0,0 +1,82 @@
+package repoanalysis
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb/gormdbtest"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/pkg/api/request"
+	"github.com/jinzhu/gorm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testRepo = request.Repo{Provider: "github.com", Owner: "golangci", Name: "golangci-api"}
+
+func newTestContext(db *gorm.DB) *request.AnonymousContext {
+	return &request.AnonymousContext{
+		BaseContext: request.BaseContext{
+			Ctx: context.Background(),
+			Log: logutil.NewStderrLog("test"),
+			DB:  db,
+		},
+	}
+}
+
+func expectPublicRepo(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(`SELECT \* FROM "repos" WHERE .*\(provider = \$1\) AND \(name = \$2\)`).
+		WithArgs("github.com", "golangci/golangci-api").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "is_private"}).AddRow(10, "golangci/golangci-api", false))
+}
+
+func TestGetScoreHistoryLimits(t *testing.T) {
+	cases := []struct {
+		limit, expLimit int
+	}{
+		{limit: 0, expLimit: defaultScoreHistorySize},
+		{limit: -1, expLimit: defaultScoreHistorySize},
+		{limit: 5, expLimit: 5},
+		{limit: maxScoreHistorySize + 1, expLimit: maxScoreHistorySize},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(fmt.Sprint(tc.limit), func(t *testing.T) {
+			db, mock, finish := gormdbtest.NewMockDB(t)
+			defer finish()
+
+			expectPublicRepo(mock)
+			mock.ExpectQuery(`SELECT \* FROM "repo_analysis_statuses" WHERE .*\(repo_id = \$1\)`).
+				WithArgs(10).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(20))
+			mock.ExpectQuery(`SELECT \* FROM "repo_analyzes" WHERE .*\(repo_analysis_status_id = \$1\) AND \(max_score != \$2\)\) `+
+				fmt.Sprintf(`ORDER BY id DESC LIMIT %d$`, tc.expLimit)).
+				WithArgs(20, 0).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "analysis_guid", "score", "max_score"}).
+					AddRow(2, "new", 90, 100).
+					AddRow(1, "old", 70, 100))
+
+			h, err := BasicService{}.GetScoreHistory(newTestContext(db), &ScoreHistoryRequest{Repo: testRepo, Limit: tc.limit})
+			require.NoError(t, err)
+			require.Len(t, h.Points, 2)
+			assert.Equal(t, "new", h.Points[0].AnalysisGUID)
+			assert.Equal(t, 90, h.Points[0].Score)
+		})
+	}
+}
+
+func TestGetScoreHistoryOfNotAnalyzedRepo(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	expectPublicRepo(mock)
+	mock.ExpectQuery(`SELECT \* FROM "repo_analysis_statuses"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	h, err := BasicService{}.GetScoreHistory(newTestContext(db), &ScoreHistoryRequest{Repo: testRepo})
+	require.NoError(t, err)
+	assert.Equal(t, &ScoreHistory{Points: []ScorePoint{}}, h)
+}
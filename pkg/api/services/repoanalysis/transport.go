@@ -10,6 +10,7 @@ import (
 	"github.com/golangci/golangci-api/internal/api/apierrors"
 	"github.com/golangci/golangci-api/internal/api/endpointutil"
 	"github.com/golangci/golangci-api/internal/api/transportutil"
+	"github.com/golangci/golangci-api/internal/shared/metrics"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 )
@@ -29,7 +30,7 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("GET").Path("/v1/repos/{provider}/{owner}/{name}/repoanalyzes").Handler(hGetStatus)
+	r.Methods("GET").Path("/v1/repos/{provider}/{owner}/{name}/repoanalyzes").Handler(metrics.InstrumentHandler("repoanalysis", "GetStatus", hGetStatus))
 
 	hGetByAnalysisGUID := httptransport.NewServer(
 		makeGetByAnalysisGUIDEndpoint(svc, regCtx.Log),
@@ -44,7 +45,7 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("GET").Path("/v1/repos/{provider}/{owner}/{name}/repoanalyzes/{analysisguid}").Handler(hGetByAnalysisGUID)
+	r.Methods("GET").Path("/v1/repos/{provider}/{owner}/{name}/repoanalyzes/{analysisguid}").Handler(metrics.InstrumentHandler("repoanalysis", "GetByAnalysisGUID", hGetByAnalysisGUID))
 
 	hUpdateByAnalysisGUID := httptransport.NewServer(
 		makeUpdateByAnalysisGUIDEndpoint(svc, regCtx.Log),
@@ -59,7 +60,67 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("PUT").Path("/v1/repos/{provider}/{owner}/{name}/repoanalyzes/{analysisguid}").Handler(hUpdateByAnalysisGUID)
+	r.Methods("PUT").Path("/v1/repos/{provider}/{owner}/{name}/repoanalyzes/{analysisguid}").Handler(metrics.InstrumentHandler("repoanalysis", "UpdateByAnalysisGUID", hUpdateByAnalysisGUID))
+
+	hListIssues := httptransport.NewServer(
+		makeListIssuesEndpoint(svc, regCtx.Log),
+		decodeListIssuesRequest,
+		encodeListIssuesResponse,
+		httptransport.ServerBefore(transportutil.StoreHTTPRequestToContext),
+		httptransport.ServerAfter(transportutil.FinalizeSession),
+
+		httptransport.ServerBefore(transportutil.MakeStoreAnonymousRequestContext(*regCtx)),
+
+		httptransport.ServerFinalizer(transportutil.FinalizeRequest),
+		httptransport.ServerErrorEncoder(transportutil.EncodeError),
+		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
+	)
+	r.Methods("GET").Path("/v1/repos/{provider}/{owner}/{name}/repoanalyzes/{analysisguid}/issues").Handler(metrics.InstrumentHandler("repoanalysis", "ListIssues", hListIssues))
+
+	hExportSARIF := httptransport.NewServer(
+		makeExportSARIFEndpoint(svc, regCtx.Log),
+		decodeExportSARIFRequest,
+		encodeExportSARIFResponse,
+		httptransport.ServerBefore(transportutil.StoreHTTPRequestToContext),
+		httptransport.ServerAfter(transportutil.FinalizeSession),
+
+		httptransport.ServerBefore(transportutil.MakeStoreAnonymousRequestContext(*regCtx)),
+
+		httptransport.ServerFinalizer(transportutil.FinalizeRequest),
+		httptransport.ServerErrorEncoder(transportutil.EncodeError),
+		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
+	)
+	r.Methods("GET").Path("/v1/repos/{provider}/{owner}/{name}/repoanalyzes/{analysisguid}/sarif").Handler(metrics.InstrumentHandler("repoanalysis", "ExportSARIF", hExportSARIF))
+
+	hGetReport := httptransport.NewServer(
+		makeGetReportEndpoint(svc, regCtx.Log),
+		decodeGetReportRequest,
+		encodeGetReportResponse,
+		httptransport.ServerBefore(transportutil.StoreHTTPRequestToContext),
+		httptransport.ServerAfter(transportutil.FinalizeSession),
+
+		httptransport.ServerBefore(transportutil.MakeStoreAnonymousRequestContext(*regCtx)),
+
+		httptransport.ServerFinalizer(transportutil.FinalizeRequest),
+		httptransport.ServerErrorEncoder(transportutil.EncodeError),
+		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
+	)
+	r.Methods("GET").Path("/v1/repos/{provider}/{owner}/{name}/repoanalyzes/{analysisguid}/report").Handler(metrics.InstrumentHandler("repoanalysis", "GetReport", hGetReport))
+
+	hGetScoreHistory := httptransport.NewServer(
+		makeGetScoreHistoryEndpoint(svc, regCtx.Log),
+		decodeGetScoreHistoryRequest,
+		encodeGetScoreHistoryResponse,
+		httptransport.ServerBefore(transportutil.StoreHTTPRequestToContext),
+		httptransport.ServerAfter(transportutil.FinalizeSession),
+
+		httptransport.ServerBefore(transportutil.MakeStoreAnonymousRequestContext(*regCtx)),
+
+		httptransport.ServerFinalizer(transportutil.FinalizeRequest),
+		httptransport.ServerErrorEncoder(transportutil.EncodeError),
+		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
+	)
+	r.Methods("GET").Path("/v1/repos/{provider}/{owner}/{name}/scores").Handler(metrics.InstrumentHandler("repoanalysis", "GetScoreHistory", hGetScoreHistory))
 
 }
 
@@ -188,3 +249,171 @@ func encodeUpdateByAnalysisGUIDResponse(ctx context.Context, w http.ResponseWrit
 
 	return json.NewEncoder(w).Encode(wrappedResp)
 }
+
+func decodeListIssuesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request ListIssuesRequest
+	if err := transportutil.DecodeRequest(&request, r); err != nil {
+		return nil, errors.Wrap(err, "can't decode request")
+	}
+
+	return request, nil
+}
+
+func encodeListIssuesResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Add("Content-Type", "application/json; charset=UTF-8")
+	if err := transportutil.GetContextError(ctx); err != nil {
+		wrappedResp := struct {
+			Error *transportutil.Error
+		}{
+			Error: transportutil.MakeError(err),
+		}
+		w.WriteHeader(wrappedResp.Error.HTTPCode)
+		return json.NewEncoder(w).Encode(wrappedResp)
+	}
+
+	resp := response.(ListIssuesResponse)
+	wrappedResp := struct {
+		transportutil.ErrorResponse
+		ListIssuesResponse
+	}{
+		ListIssuesResponse: resp,
+	}
+
+	if resp.err != nil {
+		if apierrors.IsErrorLikeResult(resp.err) {
+			return transportutil.HandleErrorLikeResult(ctx, w, resp.err)
+		}
+
+		terr := transportutil.MakeError(resp.err)
+		wrappedResp.Error = terr
+		w.WriteHeader(terr.HTTPCode)
+	}
+
+	return json.NewEncoder(w).Encode(wrappedResp)
+}
+
+func decodeExportSARIFRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request ExportSARIFRequest
+	if err := transportutil.DecodeRequest(&request, r); err != nil {
+		return nil, errors.Wrap(err, "can't decode request")
+	}
+
+	return request, nil
+}
+
+func encodeExportSARIFResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Add("Content-Type", "application/json; charset=UTF-8")
+	if err := transportutil.GetContextError(ctx); err != nil {
+		wrappedResp := struct {
+			Error *transportutil.Error
+		}{
+			Error: transportutil.MakeError(err),
+		}
+		w.WriteHeader(wrappedResp.Error.HTTPCode)
+		return json.NewEncoder(w).Encode(wrappedResp)
+	}
+
+	resp := response.(ExportSARIFResponse)
+	wrappedResp := struct {
+		transportutil.ErrorResponse
+		ExportSARIFResponse
+	}{
+		ExportSARIFResponse: resp,
+	}
+
+	if resp.err != nil {
+		if apierrors.IsErrorLikeResult(resp.err) {
+			return transportutil.HandleErrorLikeResult(ctx, w, resp.err)
+		}
+
+		terr := transportutil.MakeError(resp.err)
+		wrappedResp.Error = terr
+		w.WriteHeader(terr.HTTPCode)
+	}
+
+	return json.NewEncoder(w).Encode(wrappedResp)
+}
+
+func decodeGetReportRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request GetReportRequest
+	if err := transportutil.DecodeRequest(&request, r); err != nil {
+		return nil, errors.Wrap(err, "can't decode request")
+	}
+
+	return request, nil
+}
+
+func encodeGetReportResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Add("Content-Type", "application/json; charset=UTF-8")
+	if err := transportutil.GetContextError(ctx); err != nil {
+		wrappedResp := struct {
+			Error *transportutil.Error
+		}{
+			Error: transportutil.MakeError(err),
+		}
+		w.WriteHeader(wrappedResp.Error.HTTPCode)
+		return json.NewEncoder(w).Encode(wrappedResp)
+	}
+
+	resp := response.(GetReportResponse)
+	wrappedResp := struct {
+		transportutil.ErrorResponse
+		GetReportResponse
+	}{
+		GetReportResponse: resp,
+	}
+
+	if resp.err != nil {
+		if apierrors.IsErrorLikeResult(resp.err) {
+			return transportutil.HandleErrorLikeResult(ctx, w, resp.err)
+		}
+
+		terr := transportutil.MakeError(resp.err)
+		wrappedResp.Error = terr
+		w.WriteHeader(terr.HTTPCode)
+	}
+
+	return json.NewEncoder(w).Encode(wrappedResp)
+}
+
+func decodeGetScoreHistoryRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var request GetScoreHistoryRequest
+	if err := transportutil.DecodeRequest(&request, r); err != nil {
+		return nil, errors.Wrap(err, "can't decode request")
+	}
+
+	return request, nil
+}
+
+func encodeGetScoreHistoryResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Add("Content-Type", "application/json; charset=UTF-8")
+	if err := transportutil.GetContextError(ctx); err != nil {
+		wrappedResp := struct {
+			Error *transportutil.Error
+		}{
+			Error: transportutil.MakeError(err),
+		}
+		w.WriteHeader(wrappedResp.Error.HTTPCode)
+		return json.NewEncoder(w).Encode(wrappedResp)
+	}
+
+	resp := response.(GetScoreHistoryResponse)
+	wrappedResp := struct {
+		transportutil.ErrorResponse
+		GetScoreHistoryResponse
+	}{
+		GetScoreHistoryResponse: resp,
+	}
+
+	if resp.err != nil {
+		if apierrors.IsErrorLikeResult(resp.err) {
+			return transportutil.HandleErrorLikeResult(ctx, w, resp.err)
+		}
+
+		terr := transportutil.MakeError(resp.err)
+		wrappedResp.Error = terr
+		w.WriteHeader(terr.HTTPCode)
+	}
+
+	return json.NewEncoder(w).Encode(wrappedResp)
+}
@@ -3,10 +3,13 @@ package repohook
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/golangci/golangci-api/internal/shared/config"
+	"github.com/golangci/golangci-api/internal/shared/tracing"
 	uuid "github.com/satori/go.uuid"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/golangci/golangci-api/pkg/api/policy"
 
@@ -18,6 +21,7 @@ import (
 	"github.com/golangci/golangci-api/internal/shared/providers/provider"
 	"github.com/golangci/golangci-api/pkg/api/models"
 	"github.com/golangci/golangci-api/pkg/api/request"
+	"github.com/golangci/golangci-api/pkg/api/workers/primaryqueue/emails"
 	"github.com/golangci/golangci-api/pkg/api/workers/primaryqueue/repoanalyzes"
 	"github.com/golangci/golangci-api/pkg/worker/lib/github"
 	gh "github.com/google/go-github/github"
@@ -51,6 +55,7 @@ type BasicService struct {
 	AnalysisLauncherQueue *repoanalyzes.LauncherProducer
 	PullAnalyzeQueue      *pullanalyzesqueue.Producer
 	ActiveSubPolicy       *policy.ActiveSubscription
+	Notifier              *emails.SenderProducer
 	Cfg                   config.Config
 }
 
@@ -118,14 +123,13 @@ func (s BasicService) handleGithubPullRequestWebhook(rc *request.AnonymousContex
 		}
 	}
 
-	var auth models.Auth
-	if err := models.NewAuthQuerySet(rc.DB).UserIDEq(repo.UserID).One(&auth); err != nil {
-		return errors.Wrapf(err, "failed to get auth for repo %d", repo.ID)
-	}
-
-	p, err := s.ProviderFactory.Build(&auth)
+	p, err := s.ProviderFactory.BuildForRepo(rc.DB, repo)
 	if err != nil {
-		return errors.Wrapf(err, "failed to build provider for auth %d", auth.ID)
+		if errors.Cause(err) == provider.ErrUnauthorized {
+			rc.Log.Infof("Skip webhook for repo %s: %s", repo.FullName, err)
+			return errSkipWehbook
+		}
+		return errors.Wrapf(err, "failed to build provider for repo %d", repo.ID)
 	}
 
 	ev, err := p.ParsePullRequestEvent(rc.Ctx, body)
@@ -163,7 +167,6 @@ func (s BasicService) handleGithubPullRequestWebhook(rc *request.AnonymousContex
 		return nil
 	}
 
-	accessToken := auth.AccessToken
 	if ev.Repo.IsPrivate {
 		if err = s.ActiveSubPolicy.CheckForProviderPullRequestEvent(rc.Ctx, p, ev); err != nil {
 			logger := s.getNoSubWarnLogger(rc, repo)
@@ -174,19 +177,33 @@ func (s BasicService) handleGithubPullRequestWebhook(rc *request.AnonymousContex
 
 			if errors.Cause(err) == policy.ErrNoSeatInSubscription {
 				logger("Got PR to %s without matched private seat, skip it and set commit status: %s", repo.FullName, err)
+				if notifyErr := s.requestSeat(rc, p, ev); notifyErr != nil {
+					rc.Log.Warnf("Failed to request seat for PR %d to %s: %s", ev.PullRequestNumber, repo.FullName, notifyErr)
+				}
 				return setCommitStatus(github.StatusError, "Git author's email wasn't configured in GolangCI")
 			}
 
 			return err
 		}
 
-		if auth.PrivateAccessToken == "" {
-			rc.Log.Errorf("Got PR to %s with no user private access token", repo.FullName)
-			return setCommitStatus(github.StatusError, "No private repos access token")
+		rc.Log.Infof("Got PR webhook to the private repo %s", repo.String())
+	}
+
+	var accessToken string
+	if repo.ProviderInstallationID == 0 {
+		var auth models.Auth
+		if err = models.NewAuthQuerySet(rc.DB).UserIDEq(repo.UserID).One(&auth); err != nil {
+			return errors.Wrapf(err, "failed to get auth for repo %d", repo.ID)
 		}
-		accessToken = auth.PrivateAccessToken
 
-		rc.Log.Infof("Got PR webhook to the private repo %s", repo.String())
+		accessToken = auth.AccessToken
+		if ev.Repo.IsPrivate {
+			if auth.PrivateAccessToken == "" {
+				rc.Log.Errorf("Got PR to %s with no user private access token", repo.FullName)
+				return setCommitStatus(github.StatusError, "No private repos access token")
+			}
+			accessToken = auth.PrivateAccessToken
+		}
 	}
 
 	// TODO: create pr analysis only if could set commit status
@@ -206,17 +223,24 @@ func (s BasicService) handleGithubPullRequestWebhook(rc *request.AnonymousContex
 			Name:      repo.Repo(),
 			IsPrivate: ev.Repo.IsPrivate, // TODO: sync ev.Repo with models.Repo
 		},
-		GithubAccessToken: accessToken,
+		GithubAccessToken: accessToken, // the worker gets installation token by GithubInstallationID
 		PullRequestNumber: analysis.PullRequestNumber,
+
+		GithubInstallationID: repo.ProviderInstallationID,
 	}
 
+	ctx, span := tracing.StartSpan(rc.Ctx, "pullanalyzesqueue.Put",
+		attribute.String("analysis_guid", analysis.GithubDeliveryGUID))
 	msg := pullanalyzesqueue.RunMessage{
 		Context:      githubCtx,
 		UserID:       repo.UserID,
 		AnalysisGUID: analysis.GithubDeliveryGUID,
 		CommitSHA:    analysis.CommitSHA,
+		TraceContext: tracing.Inject(ctx),
 	}
-	if err = s.PullAnalyzeQueue.Put(&msg); err != nil {
+	err = s.PullAnalyzeQueue.Put(&msg)
+	tracing.EndSpan(span, err)
+	if err != nil {
 		return errors.Wrap(err, "can't send pull request for analysis into queue")
 	}
 
@@ -245,14 +269,9 @@ func (s BasicService) createGithubPullRequestAnalysis(rc *request.AnonymousConte
 }
 
 func (s BasicService) checkSubscription(rc *request.AnonymousContext, repo *models.Repo) error {
-	var auth models.Auth
-	if err := models.NewAuthQuerySet(rc.DB).UserIDEq(repo.UserID).One(&auth); err != nil {
-		return errors.Wrapf(err, "failed to get auth for repo %d", repo.ID)
-	}
-
-	p, err := s.ProviderFactory.Build(&auth)
+	p, err := s.ProviderFactory.BuildForRepo(rc.DB, repo)
 	if err != nil {
-		return errors.Wrapf(err, "failed to build provider for auth %d", auth.ID)
+		return errors.Wrapf(err, "failed to build provider for repo %d", repo.ID)
 	}
 
 	pr, err := p.GetRepoByName(rc.Ctx, repo.Owner(), repo.Repo())
@@ -274,6 +293,29 @@ func (s BasicService) getNoSubWarnLogger(rc *request.AnonymousContext, repo *mod
 	return rc.Log.Warnf
 }
 
+// requestSeat notifies the billing user that a commit author of the pull request has no seat
+func (s BasicService) requestSeat(rc *request.AnonymousContext, p provider.Provider, ev *provider.PullRequestEvent) error {
+	var org models.Org
+	qs := models.NewOrgQuerySet(rc.DB).ForProviderRepo(p.Name(), ev.Repo.Organization, ev.Repo.OwnerID)
+	if err := qs.One(&org); err != nil {
+		return errors.Wrapf(err, "failed to fetch org for repo %s", ev.Repo.FullName)
+	}
+
+	var sub models.OrgSub
+	if err := models.NewOrgSubQuerySet(rc.DB).OrgIDEq(org.ID).One(&sub); err != nil {
+		return errors.Wrapf(err, "failed to fetch sub of org %d", org.ID)
+	}
+
+	data := map[string]string{
+		"Repo":        ev.Repo.FullName,
+		"PullRequest": strconv.Itoa(ev.PullRequestNumber),
+		"Org":         org.DisplayName,
+		"SettingsURL": fmt.Sprintf("%s/orgs/%s/%s", s.Cfg.GetString("WEB_ROOT"), org.Provider, org.Name),
+	}
+	dedupKey := fmt.Sprintf("%s/%d", ev.Repo.FullName, ev.PullRequestNumber)
+	return s.Notifier.Put(models.NotificationEventSeatRequested, []uint{sub.BillingUserID}, data, dedupKey)
+}
+
 func (s BasicService) isNoSubError(err error) bool {
 	causeErr := errors.Cause(err)
 	return causeErr == policy.ErrNoActiveSubscription || causeErr == policy.ErrNoSeatInSubscription
@@ -10,6 +10,7 @@ import (
 	"github.com/golangci/golangci-api/internal/api/apierrors"
 	"github.com/golangci/golangci-api/internal/api/endpointutil"
 	"github.com/golangci/golangci-api/internal/api/transportutil"
+	"github.com/golangci/golangci-api/internal/shared/metrics"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 )
@@ -29,7 +30,7 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("POST").Path("/v1/repos/{owner}/{name}/hooks/{hookid}").Handler(hHandleGithubWebhook)
+	r.Methods("POST").Path("/v1/repos/{owner}/{name}/hooks/{hookid}").Handler(metrics.InstrumentHandler("repohook", "HandleGithubWebhook", hHandleGithubWebhook))
 
 }
 
@@ -0,0 +1,74 @@
+package serviceconfig
+
+import (
+	"github.com/golangci/golangci-api/internal/api/apierrors"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/pkg/api/lintversions"
+	"github.com/golangci/golangci-api/pkg/api/request"
+	"github.com/golangci/golangci-api/pkg/goenvbuild/config"
+)
+
+const maxConfigSize = 64 * 1024
+
+type ValidationRequest struct {
+	FileName string
+	Config   string
+}
+
+func (r ValidationRequest) FillLogContext(lctx logutil.Context) {
+	lctx["config_file_name"] = r.FileName
+	lctx["config_size"] = len(r.Config)
+}
+
+type ValidationResult struct {
+	IsValid bool
+	Issues  []config.ValidationIssue
+}
+
+type Service interface {
+	//url:/v1/serviceconfig/validate method:POST
+	Validate(rc *request.AnonymousContext, req *ValidationRequest) (*ValidationResult, error)
+}
+
+type BasicService struct{}
+
+func (s BasicService) Validate(rc *request.AnonymousContext, req *ValidationRequest) (*ValidationResult, error) {
+	fileName := req.FileName
+	if fileName == "" {
+		fileName = config.FileNames[0]
+	}
+
+	if !isKnownFileName(fileName) {
+		return nil, apierrors.NewNotAcceptableError("UNKNOWN_CONFIG_FILE_NAME")
+	}
+
+	if len(req.Config) > maxConfigSize {
+		return nil, apierrors.NewNotAcceptableError("CONFIG_IS_TOO_BIG")
+	}
+
+	registry, err := lintversions.Load(rc.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	// there is no repo: don't check paths existence
+	issues, err := config.NewValidator("", registry).Validate(fileName, []byte(req.Config))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ValidationResult{
+		IsValid: !config.HasValidationErrors(issues),
+		Issues:  issues,
+	}, nil
+}
+
+func isKnownFileName(fileName string) bool {
+	for _, fn := range config.FileNames {
+		if fn == fileName {
+			return true
+		}
+	}
+
+	return false
+}
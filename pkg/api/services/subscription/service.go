@@ -11,7 +11,9 @@ import (
 
 	"github.com/golangci/golangci-api/internal/api/paymentproviders/implementations/paddle"
 	"github.com/golangci/golangci-api/internal/shared/config"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb"
 	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/pkg/api/auditlog"
 	"github.com/golangci/golangci-api/pkg/api/models"
 	"github.com/golangci/golangci-api/pkg/api/request"
 	"github.com/golangci/golangci-api/pkg/api/returntypes"
@@ -244,7 +246,23 @@ func (s BasicService) Update(rc *request.AuthorizedContext, reqOrg *request.Org,
 		return fmt.Errorf("invalid sub commit state %s", sub.CommitState)
 	}
 
-	query := models.NewOrgSubQuerySet(rc.DB).
+	if err = s.startUpdate(rc, reqOrg, sub, payload); err != nil {
+		return err
+	}
+
+	return s.sendToUpdateQueue(rc, sub, payload)
+}
+
+func (s BasicService) startUpdate(rc *request.AuthorizedContext, reqOrg *request.Org,
+	sub *models.OrgSub, payload *UpdatePayload) (retErr error) {
+
+	tx, finishTx, err := gormdb.StartTx(rc.DB)
+	if err != nil {
+		return err
+	}
+	defer finishTx(&retErr)
+
+	query := models.NewOrgSubQuerySet(tx).
 		IDEq(sub.ID).
 		CommitStateEq(sub.CommitState).
 		VersionEq(sub.Version).
@@ -255,9 +273,22 @@ func (s BasicService) Update(rc *request.AuthorizedContext, reqOrg *request.Org,
 	if err = query.UpdateRequired(); err != nil {
 		return errors.Wrapf(err, "failed to update sub with id %d", sub.ID)
 	}
-	sub.Version++
 
-	return s.sendToUpdateQueue(rc, sub, payload)
+	// seats count will be changed by the payment provider asynchronously, log the requested one
+	entry := &models.AuditLogEntry{
+		Provider: reqOrg.Provider,
+		OrgName:  reqOrg.Name,
+		Action:   models.AuditLogActionSubscriptionUpdate,
+		TargetID: sub.ID,
+	}
+	before := map[string]int{"seatsCount": sub.SeatsCount}
+	after := map[string]int{"seatsCount": payload.SeatsCount}
+	if err = auditlog.Write(rc, tx, entry, before, after); err != nil {
+		return err
+	}
+
+	sub.Version++
+	return nil
 }
 
 func (s BasicService) EventCreate(rc *request.AnonymousContext, context *EventRequestContext, body request.Body) error {
@@ -10,6 +10,7 @@ import (
 	"github.com/golangci/golangci-api/internal/api/apierrors"
 	"github.com/golangci/golangci-api/internal/api/endpointutil"
 	"github.com/golangci/golangci-api/internal/api/transportutil"
+	"github.com/golangci/golangci-api/internal/shared/metrics"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 )
@@ -29,7 +30,7 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("GET").Path("/v1/orgs/{provider}/{name}/subscription").Handler(hGet)
+	r.Methods("GET").Path("/v1/orgs/{provider}/{name}/subscription").Handler(metrics.InstrumentHandler("subscription", "Get", hGet))
 
 	hUpdate := httptransport.NewServer(
 		makeUpdateEndpoint(svc, regCtx.Log),
@@ -44,7 +45,7 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("PUT").Path("/v1/orgs/{provider}/{name}/subscription").Handler(hUpdate)
+	r.Methods("PUT").Path("/v1/orgs/{provider}/{name}/subscription").Handler(metrics.InstrumentHandler("subscription", "Update", hUpdate))
 
 	hEventCreate := httptransport.NewServer(
 		makeEventCreateEndpoint(svc, regCtx.Log),
@@ -59,7 +60,7 @@ func RegisterHandlers(svc Service, r *mux.Router, regCtx *endpointutil.HandlerRe
 		httptransport.ServerErrorEncoder(transportutil.EncodeError),
 		httptransport.ServerErrorLogger(transportutil.AdaptErrorLogger(regCtx.Log)),
 	)
-	r.Methods("POST").Path("/v1/payments/{provider}/{token}/events").Handler(hEventCreate)
+	r.Methods("POST").Path("/v1/payments/{provider}/{token}/events").Handler(metrics.InstrumentHandler("subscription", "EventCreate", hEventCreate))
 
 }
 
@@ -0,0 +1,376 @@
+package suppression
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golangci/golangci-api/internal/api/apierrors"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/providers"
+	"github.com/golangci/golangci-api/internal/shared/providers/provider"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/api/request"
+	"github.com/golangci/golangci-api/pkg/worker/analyze/processors"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+type AcceptPayload struct {
+	Fingerprint string `json:"fingerprint"`
+	Reason      string `json:"reason"`
+}
+
+func (p AcceptPayload) FillLogContext(lctx logutil.Context) {
+	lctx["fingerprint"] = p.Fingerprint
+}
+
+type Request struct {
+	request.Repo
+	Fingerprint string `request:",urlPart,"`
+}
+
+func (r Request) FillLogContext(lctx logutil.Context) {
+	r.Repo.FillLogContext(lctx)
+	lctx["fingerprint"] = r.Fingerprint
+}
+
+type List struct {
+	Suppressions []models.IssueSuppression `json:"suppressions"`
+}
+
+type Baseline struct {
+	RepoAnalysisGUID string `json:"repoAnalysisGuid"`
+	SuppressedCount  int    `json:"suppressedCount"` // count of newly suppressed issues
+}
+
+type Service interface {
+	//url:/v1/repos/{provider}/{owner}/{name}/suppressions
+	List(rc *request.AuthorizedContext, repo *request.Repo) (*List, error)
+
+	//url:/v1/repos/{provider}/{owner}/{name}/suppressions method:POST
+	Accept(rc *request.AuthorizedContext, repo *request.Repo, payload *AcceptPayload) (*models.IssueSuppression, error)
+
+	//url:/v1/repos/{provider}/{owner}/{name}/suppressions/{fingerprint} method:DELETE
+	Unaccept(rc *request.AuthorizedContext, req *Request) error
+
+	//url:/v1/repos/{provider}/{owner}/{name}/baseline method:POST
+	CreateBaseline(rc *request.AuthorizedContext, repo *request.Repo) (*Baseline, error)
+
+	//url:/v1/repos/{provider}/{owner}/{name}/baseline method:DELETE
+	DeleteBaseline(rc *request.AuthorizedContext, repo *request.Repo) error
+}
+
+type BasicService struct {
+	ProviderFactory providers.Factory
+}
+
+// getRepo returns the connected repo if the user has access to it, admin access is needed for changes
+func (s BasicService) getRepo(rc *request.AuthorizedContext, reqRepo *request.Repo, needAdmin bool) (*models.Repo, error) {
+	var repo models.Repo
+	err := models.NewRepoQuerySet(rc.DB).
+		ProviderEq(reqRepo.Provider).
+		FullNameEq(reqRepo.FullName()).
+		One(&repo)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.Wrapf(apierrors.ErrNotFound, "no connected repo %s", reqRepo.FullNameWithProvider())
+		}
+		return nil, errors.Wrapf(err, "failed to fetch repo %s", reqRepo.FullNameWithProvider())
+	}
+
+	p, err := s.ProviderFactory.Build(rc.Auth)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build provider")
+	}
+
+	providerRepo, err := p.GetRepoByName(rc.Ctx, repo.Owner(), repo.Repo())
+	if err != nil {
+		if err == provider.ErrNotFound {
+			return nil, apierrors.NewForbiddenError("NO_ACCESS_TO_REPO_OR_DOESNT_EXIST")
+		}
+		return nil, errors.Wrapf(err, "can't get repo %s from provider", repo.FullName)
+	}
+
+	if needAdmin && !providerRepo.IsAdmin {
+		return nil, apierrors.NewForbiddenError("NEED_ADMIN_ACCESS_TO_REPO")
+	}
+
+	return &repo, nil
+}
+
+func (s BasicService) List(rc *request.AuthorizedContext, reqRepo *request.Repo) (*List, error) {
+	repo, err := s.getRepo(rc, reqRepo, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret List
+	err = models.NewIssueSuppressionQuerySet(rc.DB).RepoIDEq(repo.ID).OrderAscByID().All(&ret.Suppressions)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch issue suppressions of repo %d", repo.ID)
+	}
+
+	return &ret, nil
+}
+
+func isValidFingerprint(fp string) bool {
+	b, err := hex.DecodeString(fp)
+	return err == nil && len(b) == 16 && fp == strings.ToLower(fp)
+}
+
+func (s BasicService) Accept(rc *request.AuthorizedContext, reqRepo *request.Repo,
+	payload *AcceptPayload) (*models.IssueSuppression, error) {
+
+	if !isValidFingerprint(payload.Fingerprint) {
+		return nil, errors.Wrapf(apierrors.ErrBadRequest, "invalid fingerprint %q", payload.Fingerprint)
+	}
+	if strings.TrimSpace(payload.Reason) == "" {
+		return nil, errors.Wrap(apierrors.ErrBadRequest, "reason is required")
+	}
+
+	repo, err := s.getRepo(rc, reqRepo, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing []models.IssueSuppression
+	err = models.NewIssueSuppressionQuerySet(rc.DB).
+		RepoIDEq(repo.ID).
+		FingerprintEq(payload.Fingerprint).
+		All(&existing)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch suppression %s of repo %d", payload.Fingerprint, repo.ID)
+	}
+	reason := strings.TrimSpace(payload.Reason)
+	if len(existing) != 0 {
+		return s.makeManual(rc, &existing[0], reason)
+	}
+
+	suppression := models.IssueSuppression{
+		RepoID:       repo.ID,
+		Fingerprint:  payload.Fingerprint,
+		Source:       models.IssueSuppressionSourceManual,
+		Reason:       reason,
+		AuthorUserID: rc.User.ID,
+	}
+	if err = suppression.Create(rc.DB); err != nil {
+		return nil, errors.Wrapf(err, "failed to create suppression %s of repo %d", payload.Fingerprint, repo.ID)
+	}
+
+	rc.Log.Infof("Suppressed issue %s in repo %d", payload.Fingerprint, repo.ID)
+	return &suppression, nil
+}
+
+// makeManual converts the baseline suppression to the manual one: it must survive deletion of the baseline
+func (s BasicService) makeManual(rc *request.AuthorizedContext, suppression *models.IssueSuppression,
+	reason string) (*models.IssueSuppression, error) {
+
+	if suppression.Source == models.IssueSuppressionSourceManual {
+		rc.Log.Infof("Issue %s is already suppressed in repo %d", suppression.Fingerprint, suppression.RepoID)
+		return suppression, nil
+	}
+
+	n, err := models.NewIssueSuppressionQuerySet(rc.DB).
+		IDEq(suppression.ID).
+		SourceEq(models.IssueSuppressionSourceBaseline).
+		GetUpdater().
+		SetSource(models.IssueSuppressionSourceManual).
+		SetReason(reason).
+		SetAuthorUserID(rc.User.ID).
+		UpdateNum()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update suppression %d", suppression.ID)
+	}
+	if n == 0 {
+		// the baseline was deleted or the issue was accepted in parallel
+		return nil, apierrors.NewRaceConditionError("suppression was changed in parallel")
+	}
+
+	suppression.Source = models.IssueSuppressionSourceManual
+	suppression.Reason = reason
+	suppression.AuthorUserID = rc.User.ID
+
+	rc.Log.Infof("Converted baseline suppression of issue %s in repo %d to manual",
+		suppression.Fingerprint, suppression.RepoID)
+	return suppression, nil
+}
+
+func (s BasicService) Unaccept(rc *request.AuthorizedContext, req *Request) error {
+	repo, err := s.getRepo(rc, &req.Repo, true)
+	if err != nil {
+		return err
+	}
+
+	n, err := models.NewIssueSuppressionQuerySet(rc.DB).
+		RepoIDEq(repo.ID).
+		FingerprintEq(req.Fingerprint).
+		DeleteNum()
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete suppression %s of repo %d", req.Fingerprint, repo.ID)
+	}
+	if n == 0 {
+		return errors.Wrapf(apierrors.ErrNotFound, "no suppression %s in repo %d", req.Fingerprint, repo.ID)
+	}
+
+	rc.Log.Infof("Unsuppressed issue %s in repo %d", req.Fingerprint, repo.ID)
+	return nil
+}
+
+// CreateBaseline suppresses all issues of the last complete analysis of the default branch
+func (s BasicService) CreateBaseline(rc *request.AuthorizedContext, reqRepo *request.Repo) (*Baseline, error) {
+	repo, err := s.getRepo(rc, reqRepo, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var as models.RepoAnalysisStatus
+	if err = models.NewRepoAnalysisStatusQuerySet(rc.DB).RepoIDEq(repo.ID).One(&as); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.Wrapf(apierrors.ErrBadRequest, "repo %d wasn't analyzed yet", repo.ID)
+		}
+		return nil, errors.Wrapf(err, "failed to fetch analysis status of repo %d", repo.ID)
+	}
+
+	var analyzes []models.RepoAnalysis
+	err = models.NewRepoAnalysisQuerySet(rc.DB).
+		RepoAnalysisStatusIDEq(as.ID).
+		StatusEq(processors.StatusProcessed).
+		OrderDescByID().
+		Limit(1).
+		All(&analyzes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch last analysis of repo %d", repo.ID)
+	}
+	if len(analyzes) == 0 {
+		return nil, errors.Wrapf(apierrors.ErrBadRequest, "no successful analysis of repo %d", repo.ID)
+	}
+
+	analysis := &analyzes[0]
+	if !analysis.IssuesStored {
+		// issues of old analyzes are stored by the issues backfiller in background
+		return nil, errors.Wrapf(apierrors.ErrBadRequest, "issues of analysis %s of repo %d aren't stored yet, try later",
+			analysis.AnalysisGUID, repo.ID)
+	}
+
+	n, err := s.saveBaseline(rc, repo, analysis)
+	if err != nil {
+		return nil, err
+	}
+
+	rc.Log.Infof("Created baseline of repo %d from analysis %s: suppressed %d issues", repo.ID, analysis.AnalysisGUID, n)
+	return &Baseline{
+		RepoAnalysisGUID: analysis.AnalysisGUID,
+		SuppressedCount:  n,
+	}, nil
+}
+
+func (s BasicService) saveBaseline(rc *request.AuthorizedContext, repo *models.Repo,
+	analysis *models.RepoAnalysis) (_ int, retErr error) {
+
+	tx, finishTx, err := gormdb.StartTx(rc.DB)
+	if err != nil {
+		return 0, err
+	}
+	defer finishTx(&retErr)
+
+	var analysisIssues []models.Issue
+	err = models.NewIssueQuerySet(tx).
+		AnalysisTypeEq(models.IssueAnalysisTypeRepo).
+		AnalysisIDEq(analysis.ID).
+		All(&analysisIssues)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to fetch issues of analysis %d", analysis.ID)
+	}
+
+	var suppressions []models.IssueSuppression
+	if err = models.NewIssueSuppressionQuerySet(tx).RepoIDEq(repo.ID).All(&suppressions); err != nil {
+		return 0, errors.Wrapf(err, "failed to fetch issue suppressions of repo %d", repo.ID)
+	}
+
+	suppressed := map[string]bool{}
+	for _, sup := range suppressions {
+		suppressed[sup.Fingerprint] = true
+	}
+
+	var newSuppressions []models.IssueSuppression
+	now := time.Now()
+	for _, issue := range analysisIssues {
+		if suppressed[issue.Fingerprint] {
+			continue
+		}
+
+		newSuppressions = append(newSuppressions, models.IssueSuppression{
+			CreatedAt:    now,
+			UpdatedAt:    now,
+			RepoID:       repo.ID,
+			Fingerprint:  issue.Fingerprint,
+			Source:       models.IssueSuppressionSourceBaseline,
+			AuthorUserID: rc.User.ID,
+		})
+		suppressed[issue.Fingerprint] = true
+	}
+
+	if err = createSuppressions(tx, newSuppressions); err != nil {
+		return 0, errors.Wrapf(err, "failed to create baseline suppressions of repo %d", repo.ID)
+	}
+
+	return len(newSuppressions), nil
+}
+
+var insertColumns = []string{"created_at", "updated_at", "repo_id", "fingerprint", "source", "reason", "author_user_id"}
+
+// insertBatchSize keeps the count of query parameters below the postgres limit of 65535
+const insertBatchSize = 1000
+
+func createSuppressions(db *gorm.DB, suppressions []models.IssueSuppression) error {
+	for start := 0; start < len(suppressions); start += insertBatchSize {
+		end := start + insertBatchSize
+		if end > len(suppressions) {
+			end = len(suppressions)
+		}
+
+		if err := insert(db, suppressions[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insert creates suppressions by one query: a baseline can have thousands of issues
+func insert(db *gorm.DB, suppressions []models.IssueSuppression) error {
+	rowPlaceholder := "(?" + strings.Repeat(", ?", len(insertColumns)-1) + ")"
+	rows := make([]string, 0, len(suppressions))
+	args := make([]interface{}, 0, len(suppressions)*len(insertColumns))
+	for _, sup := range suppressions {
+		rows = append(rows, rowPlaceholder)
+		args = append(args, sup.CreatedAt, sup.UpdatedAt, sup.RepoID, sup.Fingerprint, sup.Source, sup.Reason,
+			sup.AuthorUserID)
+	}
+
+	query := fmt.Sprintf("INSERT INTO issue_suppressions (%s) VALUES %s",
+		strings.Join(insertColumns, ", "), strings.Join(rows, ", "))
+	return db.Exec(query, args...).Error
+}
+
+// DeleteBaseline removes suppressions made by baseline, manual suppressions are kept
+func (s BasicService) DeleteBaseline(rc *request.AuthorizedContext, reqRepo *request.Repo) error {
+	repo, err := s.getRepo(rc, reqRepo, true)
+	if err != nil {
+		return err
+	}
+
+	n, err := models.NewIssueSuppressionQuerySet(rc.DB).
+		RepoIDEq(repo.ID).
+		SourceEq(models.IssueSuppressionSourceBaseline).
+		DeleteNum()
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete baseline of repo %d", repo.ID)
+	}
+
+	rc.Log.Infof("Deleted baseline of repo %d: %d suppressions", repo.ID, n)
+	return nil
+}
@@ -0,0 +1,268 @@
+package suppression
+
+import (
+	"context"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/golangci/golangci-api/internal/api/apierrors"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb/gormdbtest"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/providers"
+	"github.com/golangci/golangci-api/internal/shared/providers/provider"
+	"github.com/golangci/golangci-api/pkg/api/auth"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/api/request"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testFingerprint  = "0123456789abcdef0123456789abcdef"
+	otherFingerprint = "fedcba9876543210fedcba9876543210"
+)
+
+type fakeProvider struct {
+	provider.Provider
+	isAdmin bool
+}
+
+func (p fakeProvider) GetRepoByName(ctx context.Context, owner, repo string) (*provider.Repo, error) {
+	return &provider.Repo{FullName: owner + "/" + repo, IsAdmin: p.isAdmin}, nil
+}
+
+type fakeFactory struct {
+	providers.Factory
+	isAdmin bool
+}
+
+func (f fakeFactory) Build(auth *models.Auth) (provider.Provider, error) {
+	return fakeProvider{isAdmin: f.isAdmin}, nil
+}
+
+var testRepo = &request.Repo{Provider: "github.com", Owner: "golangci", Name: "golangci-api"}
+
+func newTestContext(db *gorm.DB) *request.AuthorizedContext {
+	user := &models.User{}
+	user.ID = 7
+	return &request.AuthorizedContext{
+		BaseContext: request.BaseContext{
+			Ctx: context.Background(),
+			Log: logutil.NewStderrLog("test"),
+			DB:  db,
+		},
+		AuthenticatedUser: auth.AuthenticatedUser{
+			Auth: &models.Auth{},
+			User: user,
+		},
+	}
+}
+
+func expectRepo(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(`SELECT \* FROM "repos" WHERE .*\(provider = \$1\) AND \(name = \$2\)`).
+		WithArgs("github.com", "golangci/golangci-api").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(10, "golangci/golangci-api"))
+}
+
+func expectSuppressionsOf(mock sqlmock.Sqlmock, rows *sqlmock.Rows) {
+	mock.ExpectQuery(`SELECT \* FROM "issue_suppressions" WHERE .*\(repo_id = \$1\) AND \(fingerprint = \$2\)`).
+		WithArgs(10, testFingerprint).
+		WillReturnRows(rows)
+}
+
+func suppressionRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "repo_id", "fingerprint", "source", "reason", "author_user_id"})
+}
+
+func TestIsValidFingerprint(t *testing.T) {
+	assert.True(t, isValidFingerprint(testFingerprint))
+	assert.False(t, isValidFingerprint(""))
+	assert.False(t, isValidFingerprint("0123456789ABCDEF0123456789ABCDEF"))
+	assert.False(t, isValidFingerprint("0123456789abcdef"))
+	assert.False(t, isValidFingerprint("x123456789abcdef0123456789abcdef"))
+}
+
+func TestAcceptValidatesPayload(t *testing.T) {
+	s := BasicService{ProviderFactory: fakeFactory{isAdmin: true}}
+	rc := newTestContext(nil)
+
+	for _, payload := range []AcceptPayload{
+		{Fingerprint: "bad", Reason: "false positive"},
+		{Fingerprint: testFingerprint, Reason: "  "},
+	} {
+		_, err := s.Accept(rc, testRepo, &payload)
+		assert.Equal(t, apierrors.ErrBadRequest, errors.Cause(err))
+	}
+}
+
+func TestAcceptNeedsAdmin(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	expectRepo(mock)
+
+	s := BasicService{ProviderFactory: fakeFactory{isAdmin: false}}
+	_, err := s.Accept(newTestContext(db), testRepo, &AcceptPayload{Fingerprint: testFingerprint, Reason: "ok"})
+	assert.Error(t, err)
+}
+
+func TestAccept(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	expectRepo(mock)
+	expectSuppressionsOf(mock, suppressionRows())
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "issue_suppressions"`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, 10, testFingerprint,
+			models.IssueSuppressionSourceManual, "false positive", 7).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+
+	s := BasicService{ProviderFactory: fakeFactory{isAdmin: true}}
+	sup, err := s.Accept(newTestContext(db), testRepo,
+		&AcceptPayload{Fingerprint: testFingerprint, Reason: " false positive "})
+	require.NoError(t, err)
+	assert.Equal(t, models.IssueSuppressionSourceManual, sup.Source)
+	assert.Equal(t, "false positive", sup.Reason)
+}
+
+func TestAcceptConvertsBaselineToManual(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	expectRepo(mock)
+	expectSuppressionsOf(mock, suppressionRows().
+		AddRow(3, 10, testFingerprint, models.IssueSuppressionSourceBaseline, "", 1))
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "issue_suppressions" SET "author_user_id" = \$1, "reason" = \$2, "source" = \$3, "updated_at" = \$4 `+
+		`WHERE .*\(id = \$5\) AND \(source = \$6\)`).
+		WithArgs(7, "false positive", models.IssueSuppressionSourceManual, sqlmock.AnyArg(), 3,
+			models.IssueSuppressionSourceBaseline).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	s := BasicService{ProviderFactory: fakeFactory{isAdmin: true}}
+	sup, err := s.Accept(newTestContext(db), testRepo,
+		&AcceptPayload{Fingerprint: testFingerprint, Reason: "false positive"})
+	require.NoError(t, err)
+	assert.Equal(t, uint(3), sup.ID)
+	assert.Equal(t, models.IssueSuppressionSourceManual, sup.Source)
+	assert.Equal(t, "false positive", sup.Reason)
+	assert.Equal(t, uint(7), sup.AuthorUserID)
+}
+
+func TestAcceptKeepsManual(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	expectRepo(mock)
+	expectSuppressionsOf(mock, suppressionRows().
+		AddRow(3, 10, testFingerprint, models.IssueSuppressionSourceManual, "generated code", 1))
+
+	s := BasicService{ProviderFactory: fakeFactory{isAdmin: true}}
+	sup, err := s.Accept(newTestContext(db), testRepo,
+		&AcceptPayload{Fingerprint: testFingerprint, Reason: "false positive"})
+	require.NoError(t, err)
+	assert.Equal(t, "generated code", sup.Reason)
+	assert.Equal(t, uint(1), sup.AuthorUserID)
+}
+
+func TestUnacceptOfUnknownFingerprint(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	expectRepo(mock)
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "issue_suppressions" SET "deleted_at"=\$1 WHERE .*\(repo_id = \$2\) AND \(fingerprint = \$3\)`).
+		WithArgs(sqlmock.AnyArg(), 10, testFingerprint).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	s := BasicService{ProviderFactory: fakeFactory{isAdmin: true}}
+	err := s.Unaccept(newTestContext(db), &Request{Repo: *testRepo, Fingerprint: testFingerprint})
+	assert.Equal(t, apierrors.ErrNotFound, errors.Cause(err))
+}
+
+func TestCreateBaselineSkipsSuppressedIssues(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	expectRepo(mock)
+	mock.ExpectQuery(`SELECT \* FROM "repo_analysis_statuses" WHERE .*\(repo_id = \$1\)`).
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(20))
+	mock.ExpectQuery(`SELECT \* FROM "repo_analyzes" WHERE .*\(repo_analysis_status_id = \$1\) AND \(status = \$2\)\) `+
+		`ORDER BY id DESC LIMIT 1`).
+		WithArgs(20, "processed").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "analysis_guid", "issues_stored"}).AddRow(30, "guid", true))
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "issues" WHERE \(analysis_type = \$1\) AND \(analysis_id = \$2\)`).
+		WithArgs(models.IssueAnalysisTypeRepo, 30).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "fingerprint"}).
+			AddRow(1, testFingerprint).
+			AddRow(2, otherFingerprint).
+			AddRow(3, otherFingerprint))
+	mock.ExpectQuery(`SELECT \* FROM "issue_suppressions" WHERE .*\(repo_id = \$1\)`).
+		WithArgs(10).
+		WillReturnRows(suppressionRows().AddRow(3, 10, testFingerprint, models.IssueSuppressionSourceManual, "x", 1))
+	// the duplicated issue is suppressed once, suppressions are inserted by one query
+	mock.ExpectExec(`INSERT INTO issue_suppressions \(created_at, updated_at, repo_id, fingerprint, source, reason, author_user_id\) `+
+		`VALUES \(\$1, \$2, \$3, \$4, \$5, \$6, \$7\)$`).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), 10, otherFingerprint,
+			models.IssueSuppressionSourceBaseline, "", 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	s := BasicService{ProviderFactory: fakeFactory{isAdmin: true}}
+	baseline, err := s.CreateBaseline(newTestContext(db), testRepo)
+	require.NoError(t, err)
+	assert.Equal(t, &Baseline{RepoAnalysisGUID: "guid", SuppressedCount: 1}, baseline)
+}
+
+func TestCreateBaselineWithoutAnalyzes(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	expectRepo(mock)
+	mock.ExpectQuery(`SELECT \* FROM "repo_analysis_statuses"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(20))
+	mock.ExpectQuery(`SELECT \* FROM "repo_analyzes"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	s := BasicService{ProviderFactory: fakeFactory{isAdmin: true}}
+	_, err := s.CreateBaseline(newTestContext(db), testRepo)
+	assert.Equal(t, apierrors.ErrBadRequest, errors.Cause(err))
+}
+
+func TestCreateBaselineNeedsStoredIssues(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	expectRepo(mock)
+	mock.ExpectQuery(`SELECT \* FROM "repo_analysis_statuses"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(20))
+	mock.ExpectQuery(`SELECT \* FROM "repo_analyzes"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "analysis_guid", "issues_stored"}).AddRow(30, "guid", false))
+
+	s := BasicService{ProviderFactory: fakeFactory{isAdmin: true}}
+	_, err := s.CreateBaseline(newTestContext(db), testRepo)
+	assert.Equal(t, apierrors.ErrBadRequest, errors.Cause(err))
+}
+
+func TestDeleteBaselineKeepsManual(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	expectRepo(mock)
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "issue_suppressions" SET "deleted_at"=\$1 WHERE .*\(repo_id = \$2\) AND \(source = \$3\)`).
+		WithArgs(sqlmock.AnyArg(), 10, models.IssueSuppressionSourceBaseline).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	s := BasicService{ProviderFactory: fakeFactory{isAdmin: true}}
+	require.NoError(t, s.DeleteBaseline(newTestContext(db), testRepo))
+}
@@ -0,0 +1,131 @@
+package codescanning
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/providers"
+	"github.com/golangci/golangci-api/internal/shared/providers/provider"
+	"github.com/golangci/golangci-api/internal/shared/queue/consumers"
+	"github.com/golangci/golangci-api/internal/shared/queue/producers"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/api/sarif"
+	"github.com/golangci/golangci-api/pkg/api/workers/primaryqueue"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	redsync "gopkg.in/redsync.v1"
+)
+
+const uploadQueueID = "codescanning/upload"
+
+type uploadMessage struct {
+	RepoAnalysisID uint
+}
+
+func (m uploadMessage) LockID() string {
+	return fmt.Sprintf("%s/%d", uploadQueueID, m.RepoAnalysisID)
+}
+
+type UploaderProducer struct {
+	producers.Base
+}
+
+func (p *UploaderProducer) Register(m *producers.Multiplexer) error {
+	return p.Base.Register(m, uploadQueueID)
+}
+
+func (p UploaderProducer) Put(repoAnalysisID uint) error {
+	return p.Base.Put(uploadMessage{
+		RepoAnalysisID: repoAnalysisID,
+	})
+}
+
+// UploaderConsumer uploads results of default branch analyzes to the provider's code scanning
+type UploaderConsumer struct {
+	log logutil.Log
+	db  *sql.DB
+	pf  providers.Factory
+}
+
+func NewUploaderConsumer(log logutil.Log, db *sql.DB, pf providers.Factory) *UploaderConsumer {
+	return &UploaderConsumer{
+		log: log,
+		db:  db,
+		pf:  pf,
+	}
+}
+
+func (c UploaderConsumer) Register(m *consumers.Multiplexer, df *redsync.Redsync) error {
+	return primaryqueue.RegisterConsumer(c.consumeMessage, uploadQueueID, m, df)
+}
+
+func (c UploaderConsumer) consumeMessage(ctx context.Context, m *uploadMessage) error {
+	gormDB, err := gormdb.FromSQL(ctx, c.db)
+	if err != nil {
+		return errors.Wrap(err, "failed to get gorm db")
+	}
+
+	if err = c.run(ctx, m, gormDB); err != nil {
+		return errors.Wrapf(err, "failed to upload sarif of repo analysis %d", m.RepoAnalysisID)
+	}
+
+	return nil
+}
+
+func (c UploaderConsumer) run(ctx context.Context, m *uploadMessage, db *gorm.DB) error {
+	var analysis models.RepoAnalysis
+	if err := models.NewRepoAnalysisQuerySet(db).IDEq(m.RepoAnalysisID).One(&analysis); err != nil {
+		return errors.Wrapf(err, "failed to fetch repo analysis %d", m.RepoAnalysisID)
+	}
+
+	var as models.RepoAnalysisStatus
+	if err := models.NewRepoAnalysisStatusQuerySet(db).IDEq(analysis.RepoAnalysisStatusID).One(&as); err != nil {
+		return errors.Wrapf(err, "failed to fetch repo analysis status %d", analysis.RepoAnalysisStatusID)
+	}
+
+	var repo models.Repo
+	if err := models.NewRepoQuerySet(db).IDEq(as.RepoID).One(&repo); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			c.log.Infof("Repo %d was deleted, don't upload sarif", as.RepoID)
+			return nil
+		}
+		return errors.Wrapf(err, "failed to fetch repo %d", as.RepoID)
+	}
+
+	sarifLog, err := sarif.BuildFromResultJSON(db, analysis.ResultJSON, repo.ID, analysis.LintersVersion)
+	if err != nil {
+		return errors.Wrapf(consumers.ErrPermanent, "failed to build sarif: %s", err)
+	}
+
+	sarifJSON, err := json.Marshal(sarifLog)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal sarif")
+	}
+
+	p, err := c.pf.BuildForRepo(db, &repo)
+	if err != nil {
+		return errors.Wrap(err, "failed to build provider")
+	}
+
+	err = p.UploadCodeScanningSARIF(ctx, repo.Owner(), repo.Repo(), &provider.CodeScanningUpload{
+		CommitSHA: analysis.CommitSHA,
+		Ref:       "refs/heads/" + as.DefaultBranch,
+		ToolName:  "golangci-lint",
+		SARIF:     sarifJSON,
+	})
+	if err != nil {
+		if err == provider.ErrNotFound || err == provider.ErrUnauthorized {
+			// code scanning isn't available for the repo or we have no access to it
+			c.log.Warnf("Can't upload sarif for repo %s: %s", repo.FullName, err)
+			return nil
+		}
+		return errors.Wrap(err, "failed to upload sarif to provider")
+	}
+
+	c.log.Infof("Uploaded sarif of repo analysis %s for %s", analysis.AnalysisGUID, repo.FullName)
+	return nil
+}
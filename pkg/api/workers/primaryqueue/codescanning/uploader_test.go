@@ -0,0 +1,167 @@
+package codescanning
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb/gormdbtest"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/providers"
+	"github.com/golangci/golangci-api/internal/shared/providers/provider"
+	"github.com/golangci/golangci-api/internal/shared/queue/consumers"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/api/sarif"
+	lintersResult "github.com/golangci/golangci-api/pkg/worker/analyze/linters/result"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testResultJSON = `{"GolangciLintRes":{"Issues":[
+	{"FromLinter":"govet","Text":"x","Pos":{"Filename":"main.go","Line":3}},
+	{"FromLinter":"errcheck","Text":"y","Pos":{"Filename":"main.go","Line":5}}
+]}}`
+
+type fakeProvider struct {
+	provider.Provider
+	err     error
+	uploads []provider.CodeScanningUpload
+}
+
+func (p *fakeProvider) UploadCodeScanningSARIF(ctx context.Context, owner, repo string,
+	upload *provider.CodeScanningUpload) error {
+
+	if p.err != nil {
+		return p.err
+	}
+
+	p.uploads = append(p.uploads, *upload)
+	return nil
+}
+
+type fakeFactory struct {
+	providers.Factory
+	p *fakeProvider
+}
+
+func (f fakeFactory) BuildForRepo(db *gorm.DB, repo *models.Repo) (provider.Provider, error) {
+	return f.p, nil
+}
+
+func expectAnalysis(mock sqlmock.Sqlmock, resultJSON string) {
+	mock.ExpectQuery(`SELECT \* FROM "repo_analyzes" WHERE .*\(id = \$1\)`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "repo_analysis_status_id", "commit_sha", "result_json"}).
+			AddRow(1, 2, "sha", []byte(resultJSON)))
+	mock.ExpectQuery(`SELECT \* FROM "repo_analysis_statuses" WHERE .*\(id = \$1\)`).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "repo_id", "default_branch"}).AddRow(2, 3, "master"))
+}
+
+func expectRepo(mock sqlmock.Sqlmock, rows *sqlmock.Rows) {
+	mock.ExpectQuery(`SELECT \* FROM "repos" WHERE .*\(id = \$1\)`).
+		WithArgs(3).
+		WillReturnRows(rows)
+}
+
+func repoRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "name"}).AddRow(3, "golangci/golangci-api")
+}
+
+func expectSuppressions(mock sqlmock.Sqlmock, fingerprints ...string) {
+	rows := sqlmock.NewRows([]string{"id", "fingerprint"})
+	for i, fp := range fingerprints {
+		rows.AddRow(i+1, fp)
+	}
+	mock.ExpectQuery(`SELECT \* FROM "issue_suppressions" WHERE .*\(repo_id = \$1\)`).
+		WithArgs(3).
+		WillReturnRows(rows)
+}
+
+func runUploader(db *gorm.DB, p *fakeProvider) error {
+	c := NewUploaderConsumer(logutil.NewStderrLog("test"), nil, fakeFactory{p: p})
+	return c.run(context.Background(), &uploadMessage{RepoAnalysisID: 1}, db)
+}
+
+func TestUpload(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	expectAnalysis(mock, testResultJSON)
+	expectRepo(mock, repoRows())
+	expectSuppressions(mock, lintersResult.Fingerprint("errcheck", "main.go", "y"))
+
+	p := &fakeProvider{}
+	require.NoError(t, runUploader(db, p))
+	require.Len(t, p.uploads, 1)
+
+	upload := p.uploads[0]
+	assert.Equal(t, "sha", upload.CommitSHA)
+	assert.Equal(t, "refs/heads/master", upload.Ref)
+	assert.Equal(t, "golangci-lint", upload.ToolName)
+
+	var log sarif.Log
+	require.NoError(t, json.Unmarshal(upload.SARIF, &log))
+	require.Len(t, log.Runs, 1)
+	results := log.Runs[0].Results
+	require.Len(t, results, 2)
+	assert.Empty(t, results[0].Suppressions)
+	assert.Equal(t, []sarif.Suppression{{Kind: "external"}}, results[1].Suppressions)
+}
+
+func TestUploadOfDeletedRepo(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	expectAnalysis(mock, testResultJSON)
+	expectRepo(mock, sqlmock.NewRows([]string{"id"}))
+
+	p := &fakeProvider{}
+	require.NoError(t, runUploader(db, p))
+	assert.Empty(t, p.uploads)
+}
+
+func TestUploadOfBrokenResult(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	expectAnalysis(mock, "{")
+	expectRepo(mock, repoRows())
+
+	err := runUploader(db, &fakeProvider{})
+	assert.Equal(t, consumers.ErrPermanent, errors.Cause(err))
+}
+
+func TestUploadProviderErrors(t *testing.T) {
+	cases := []struct {
+		name  string
+		err   error
+		isErr bool
+	}{
+		{name: "code scanning is disabled", err: provider.ErrNotFound},
+		{name: "no access", err: provider.ErrUnauthorized},
+		{name: "provider failure", err: errors.New("timeout"), isErr: true},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock, finish := gormdbtest.NewMockDB(t)
+			defer finish()
+
+			expectAnalysis(mock, testResultJSON)
+			expectRepo(mock, repoRows())
+			expectSuppressions(mock)
+
+			err := runUploader(db, &fakeProvider{err: tc.err})
+			if tc.isErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
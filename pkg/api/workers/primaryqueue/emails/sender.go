@@ -0,0 +1,156 @@
+package emails
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/golangci/golangci-api/internal/shared/cache"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb"
+	"github.com/golangci/golangci-api/internal/shared/email"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/queue/consumers"
+	"github.com/golangci/golangci-api/internal/shared/queue/producers"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/api/notifications"
+	"github.com/golangci/golangci-api/pkg/api/workers/primaryqueue"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	redsync "gopkg.in/redsync.v1"
+)
+
+const sendQueueID = "emails/send"
+
+type sendMessage struct {
+	Event  models.NotificationEvent
+	UserID uint
+	Data   map[string]string
+
+	// the same notification with the same key isn't sent twice to the user within a day
+	DedupKey string
+}
+
+func (m sendMessage) LockID() string {
+	return fmt.Sprintf("%s/%s/%d", sendQueueID, m.Event, m.UserID)
+}
+
+type SenderProducer struct {
+	producers.Base
+}
+
+func (p *SenderProducer) Register(m *producers.Multiplexer) error {
+	return p.Base.Register(m, sendQueueID)
+}
+
+// Put sends the notification to every user separately: the preferences are checked by the consumer
+func (p SenderProducer) Put(event models.NotificationEvent, userIDs []uint, data map[string]string, dedupKey string) error {
+	for _, userID := range userIDs {
+		err := p.Base.Put(sendMessage{
+			Event:    event,
+			UserID:   userID,
+			Data:     data,
+			DedupKey: dedupKey,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to put %s notification for user %d", event, userID)
+		}
+	}
+
+	return nil
+}
+
+type SenderConsumer struct {
+	log    logutil.Log
+	db     *sql.DB
+	sender email.Sender
+	cache  cache.Cache
+}
+
+func NewSenderConsumer(log logutil.Log, db *sql.DB, sender email.Sender, c cache.Cache) *SenderConsumer {
+	return &SenderConsumer{
+		log:    log,
+		db:     db,
+		sender: sender,
+		cache:  c,
+	}
+}
+
+func (c SenderConsumer) Register(m *consumers.Multiplexer, df *redsync.Redsync) error {
+	return primaryqueue.RegisterConsumer(c.consumeMessage, sendQueueID, m, df)
+}
+
+func (c SenderConsumer) consumeMessage(ctx context.Context, m *sendMessage) error {
+	gormDB, err := gormdb.FromSQL(ctx, c.db)
+	if err != nil {
+		return errors.Wrap(err, "failed to get gorm db")
+	}
+
+	if err = c.run(ctx, m, gormDB); err != nil {
+		return errors.Wrapf(err, "sending of %s notification to user %d failed", m.Event, m.UserID)
+	}
+
+	return nil
+}
+
+func (c SenderConsumer) run(ctx context.Context, m *sendMessage, db *gorm.DB) error {
+	ei := notifications.GetEventInfo(m.Event)
+	if ei == nil {
+		return errors.Wrapf(consumers.ErrPermanent, "unknown event %s", m.Event)
+	}
+
+	enabled, err := notifications.IsEnabled(db, m.UserID, m.Event)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		c.log.Infof("User %d disabled %s notifications", m.UserID, m.Event)
+		return nil
+	}
+
+	dedupKey := fmt.Sprintf("notifications/sent/%s/%d/%s", m.Event, m.UserID, m.DedupKey)
+	if m.DedupKey != "" {
+		var sent bool
+		if err = c.cache.Get(dedupKey, &sent); err != nil {
+			c.log.Warnf("Can't get %s from cache: %s", dedupKey, err)
+		} else if sent {
+			c.log.Infof("Notification %s with key %s was already sent to user %d", m.Event, m.DedupKey, m.UserID)
+			return nil
+		}
+	}
+
+	var user models.User
+	if err = models.NewUserQuerySet(db).IDEq(m.UserID).One(&user); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.Wrapf(consumers.ErrPermanent, "no user %d", m.UserID)
+		}
+		return errors.Wrapf(err, "failed to fetch user %d", m.UserID)
+	}
+	if user.Email == "" {
+		c.log.Infof("User %d has no email, don't send %s notification", m.UserID, m.Event)
+		return nil
+	}
+
+	subject, body, err := ei.Render(m.Data)
+	if err != nil {
+		return errors.Wrapf(consumers.ErrPermanent, "failed to render: %s", err)
+	}
+
+	err = c.sender.Send(ctx, &email.Message{
+		To:      []string{user.Email},
+		Subject: subject,
+		Body:    body,
+	})
+	if err != nil {
+		return err
+	}
+
+	if m.DedupKey != "" {
+		if err = c.cache.Set(dedupKey, 24*time.Hour, true); err != nil {
+			c.log.Warnf("Can't save %s to cache: %s", dedupKey, err)
+		}
+	}
+
+	c.log.Infof("Sent %s notification to user %d", m.Event, m.UserID)
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/golangci/golangci-api/internal/shared/queue/consumers"
 	"github.com/golangci/golangci-api/internal/shared/queue/producers"
 	"github.com/golangci/golangci-api/pkg/api/workers/primaryqueue"
+	"github.com/golangci/golangci-api/pkg/api/workers/primaryqueue/emails"
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
@@ -51,18 +52,22 @@ func (cp CreatorProducer) Put(provider, payload string) error {
 }
 
 type CreatorConsumer struct {
-	log logutil.Log
-	db  *sql.DB
-	cfg config.Config
-	pp  paymentproviders.Factory
+	log      logutil.Log
+	db       *sql.DB
+	cfg      config.Config
+	pp       paymentproviders.Factory
+	notifier *emails.SenderProducer
 }
 
-func NewCreatorConsumer(log logutil.Log, db *sql.DB, cfg config.Config, pp paymentproviders.Factory) *CreatorConsumer {
+func NewCreatorConsumer(log logutil.Log, db *sql.DB, cfg config.Config, pp paymentproviders.Factory,
+	notifier *emails.SenderProducer) *CreatorConsumer {
+
 	return &CreatorConsumer{
-		log: log,
-		db:  db,
-		cfg: cfg,
-		pp:  pp,
+		log:      log,
+		db:       db,
+		cfg:      cfg,
+		pp:       pp,
+		notifier: notifier,
 	}
 }
 
@@ -94,8 +99,9 @@ func (cc CreatorConsumer) run(_ context.Context, m *createMessage, db *gorm.DB)
 	switch m.Provider {
 	case paddle.ProviderName:
 		ep = &paddle.EventProcessor{
-			Tx:  tx,
-			Log: cc.log,
+			Tx:       tx,
+			Log:      cc.log,
+			Notifier: cc.notifier,
 		}
 	}
 
@@ -23,7 +23,6 @@ import (
 )
 
 const launchQueueID = "repoanalyzes/launch"
-const lintersVersion = "v1.10.1"
 
 type launchMessage struct {
 	RepoID       uint
@@ -90,21 +89,33 @@ func (c LauncherConsumer) run(m *launchMessage, db *gorm.DB) error {
 		return errors.Wrapf(err, "failed to fetch repo analysis status for repo %d", m.RepoID)
 	}
 
+	// use Unscoped to fetch deleted repos
+	var repo models.Repo
+	if err := models.NewRepoQuerySet(db.Unscoped()).IDEq(m.RepoID).One(&repo); err != nil {
+		return errors.Wrapf(err, "failed to fetch repo with id %d", m.RepoID)
+	}
+
+	// golangci-lint version is saved when the worker reports the version it used:
+	// the repo config can override the default version
 	if err := c.createDBEntries(&as, m, db); err != nil {
 		return errors.Wrapf(err, "failed to create db entries")
 	}
 
-	return c.putAnalysisIntoQueue(m, &as, db)
+	return c.putAnalysisIntoQueue(m, &as, &repo, db)
 }
 
-func (c LauncherConsumer) putAnalysisIntoQueue(m *launchMessage, as *models.RepoAnalysisStatus, db *gorm.DB) error {
-	// use Unscoped to fetch deleted repos
-	var repo models.Repo
-	if err := models.NewRepoQuerySet(db.Unscoped()).IDEq(m.RepoID).One(&repo); err != nil {
-		return errors.Wrapf(err, "failed to fetch repo with id %d", m.RepoID)
+func (c LauncherConsumer) putAnalysisIntoQueue(m *launchMessage, as *models.RepoAnalysisStatus, repo *models.Repo, db *gorm.DB) error {
+	if repo.ProviderInstallationID != 0 {
+		err := c.runProducer.PutForInstallation(repo.FullName, m.AnalysisGUID, as.DefaultBranch,
+			repo.ProviderInstallationID, m.CommitSHA)
+		if err != nil {
+			return errors.Wrap(err, "failed to enqueue repo analysis for running")
+		}
+
+		return nil
 	}
 
-	pat, err := c.getAccessToken(db, &repo)
+	pat, err := c.getAccessToken(db, repo)
 	if err != nil {
 		return errors.Wrap(err, "failed to get private access token")
 	}
@@ -181,7 +192,6 @@ func (c LauncherConsumer) createRepoAnalysis(tx *gorm.DB, as *models.RepoAnalysi
 		SetPendingCommitSHA("").
 		SetVersion(as.Version + 1).
 		SetLastAnalyzedAt(time.Now().UTC()).
-		SetLastAnalyzedLintersVersion(lintersVersion).
 		UpdateNum()
 	if err != nil {
 		return errors.Wrap(err, "can't update repo analysis status after processing")
@@ -206,7 +216,6 @@ func (c LauncherConsumer) createNewAnalysis(tx *gorm.DB, as *models.RepoAnalysis
 		CommitSHA:            as.PendingCommitSHA,
 		ResultJSON:           []byte("{}"),
 		AttemptNumber:        1,
-		LintersVersion:       lintersVersion,
 	}
 	if err := a.Create(tx); err != nil {
 		return errors.Wrap(err, "can't create repo analysis")
@@ -0,0 +1,162 @@
+package repoanalyzes
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/queue/consumers"
+	"github.com/golangci/golangci-api/internal/shared/queue/producers"
+	"github.com/golangci/golangci-api/pkg/api/lintversions"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/golangci/golangci-api/pkg/api/workers/primaryqueue"
+	"github.com/golangci/golangci-api/pkg/goenvbuild/config"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	redsync "gopkg.in/redsync.v1"
+)
+
+const reanalyzeQueueID = "repoanalyzes/reanalyze"
+
+type reanalyzeMessage struct {
+	DefaultVersion string
+}
+
+func (m reanalyzeMessage) LockID() string {
+	return reanalyzeQueueID
+}
+
+type ReanalyzerProducer struct {
+	producers.Base
+}
+
+func (p *ReanalyzerProducer) Register(m *producers.Multiplexer) error {
+	return p.Base.Register(m, reanalyzeQueueID)
+}
+
+// Put enqueues reanalysis of repos analyzed not by the current default golangci-lint version
+func (p ReanalyzerProducer) Put(defaultVersion string) error {
+	return p.Base.Put(reanalyzeMessage{
+		DefaultVersion: defaultVersion,
+	})
+}
+
+type ReanalyzerConsumer struct {
+	log      logutil.Log
+	db       *sql.DB
+	launcher *LauncherProducer
+}
+
+func NewReanalyzerConsumer(log logutil.Log, db *sql.DB, launcher *LauncherProducer) *ReanalyzerConsumer {
+	return &ReanalyzerConsumer{
+		log:      log,
+		db:       db,
+		launcher: launcher,
+	}
+}
+
+func (c ReanalyzerConsumer) Register(m *consumers.Multiplexer, df *redsync.Redsync) error {
+	return primaryqueue.RegisterConsumer(c.consumeMessage, reanalyzeQueueID, m, df)
+}
+
+func (c ReanalyzerConsumer) consumeMessage(ctx context.Context, m *reanalyzeMessage) error {
+	gormDB, err := gormdb.FromSQL(ctx, c.db)
+	if err != nil {
+		return errors.Wrap(err, "failed to get gorm db")
+	}
+
+	reanalyzedRepos, err := c.reanalyzeOutdatedRepos(gormDB)
+	if err != nil {
+		return errors.Wrapf(err, "failed to reanalyze repos after changing default version to %s", m.DefaultVersion)
+	}
+
+	c.log.Infof("Launched reanalysis of %d repos after changing default golangci-lint version to %s",
+		len(reanalyzedRepos), m.DefaultVersion)
+	return nil
+}
+
+// versionResolver resolves golangci-lint versions of repos: registries are cached by repo owners
+type versionResolver struct {
+	db         *gorm.DB
+	registries map[string]*config.GolangciLintRegistry
+}
+
+func newVersionResolver(db *gorm.DB) *versionResolver {
+	return &versionResolver{
+		db:         db,
+		registries: map[string]*config.GolangciLintRegistry{},
+	}
+}
+
+// resolve returns the version with the "v" prefix the repo is analyzed by now,
+// versionRange is golangci-lint-version from the repo config, the default version is used if it's empty
+func (r *versionResolver) resolve(repo *models.Repo, versionRange string) (string, error) {
+	key := repo.Provider + "/" + repo.Owner()
+	registry, ok := r.registries[key]
+	if !ok {
+		var err error
+		if registry, err = lintversions.LoadForOrg(r.db, repo.Provider, repo.Owner()); err != nil {
+			return "", err
+		}
+		r.registries[key] = registry
+	}
+
+	release, err := registry.Resolve(versionRange)
+	if err != nil {
+		return "", err
+	}
+
+	return release.VersionWithV(), nil
+}
+
+// reanalyzeOutdatedRepos launches analysis of active repos analyzed by not the version they are analyzed by now:
+// repos pinned to a version aren't reanalyzed after changing the default version
+func (c ReanalyzerConsumer) reanalyzeOutdatedRepos(db *gorm.DB) ([]string, error) {
+	var statuses []models.RepoAnalysisStatus
+	if err := models.NewRepoAnalysisStatusQuerySet(db).ActiveEq(true).All(&statuses); err != nil {
+		return nil, errors.Wrap(err, "failed to fetch repo analysis statuses")
+	}
+
+	versions := newVersionResolver(db)
+	var reanalyzedRepos []string
+	for _, as := range statuses {
+		var repo models.Repo
+		if err := models.NewRepoQuerySet(db).IDEq(as.RepoID).One(&repo); err != nil {
+			if err == gorm.ErrRecordNotFound {
+				continue // repo was deleted
+			}
+			return nil, errors.Wrapf(err, "failed to fetch repo %d", as.RepoID)
+		}
+
+		version, err := versions.resolve(&repo, as.LastAnalyzedLintersVersionRange)
+		if err != nil {
+			// e.g. the pinned range doesn't match available versions: reanalysis would fail the same way
+			c.log.Warnf("Failed to resolve golangci-lint version for repo %s: %s", repo.FullName, err)
+			continue
+		}
+
+		if version == as.LastAnalyzedLintersVersion {
+			continue
+		}
+
+		var lastAnalysis models.RepoAnalysis
+		err = models.NewRepoAnalysisQuerySet(db).RepoAnalysisStatusIDEq(as.ID).OrderDescByID().One(&lastAnalysis)
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				continue // wasn't analyzed yet
+			}
+			return nil, errors.Wrapf(err, "failed to fetch last analysis of repo %s", repo.FullName)
+		}
+
+		if err = c.launcher.Put(repo.ID, lastAnalysis.CommitSHA, uuid.NewV4().String()); err != nil {
+			return nil, errors.Wrapf(err, "failed to launch analysis of repo %s", repo.FullName)
+		}
+
+		c.log.Infof("Launched reanalysis of repo %s: %s -> %s", repo.FullName, as.LastAnalyzedLintersVersion, version)
+		reanalyzedRepos = append(reanalyzedRepos, repo.FullName)
+	}
+
+	return reanalyzedRepos, nil
+}
@@ -0,0 +1,57 @@
+package repoanalyzes
+
+import (
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/golangci/golangci-api/internal/shared/db/gormdb/gormdbtest"
+	"github.com/golangci/golangci-api/pkg/api/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testChecksum = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func expectRegistry(mock sqlmock.Sqlmock, provider, org string) {
+	mock.ExpectQuery(`SELECT \* FROM "golangci_lint_versions"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "version", "checksum", "is_default"}).
+			AddRow(1, "1.22.2", testChecksum, false).
+			AddRow(2, "1.23.7", testChecksum, true).
+			AddRow(3, "1.23.8", testChecksum, false))
+	mock.ExpectQuery(`SELECT \* FROM "orgs" WHERE .*\(provider = \$1\) AND \(name = \$2\)`).
+		WithArgs(provider, org).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+}
+
+func newTestRepo(provider, fullName string) *models.Repo {
+	return &models.Repo{Provider: provider, FullName: fullName}
+}
+
+func TestResolveVersion(t *testing.T) {
+	db, mock, finish := gormdbtest.NewMockDB(t)
+	defer finish()
+
+	expectRegistry(mock, "github.com", "golangci")
+	// the same owner name on another provider is another org
+	expectRegistry(mock, "gitlab.com", "golangci")
+
+	versions := newVersionResolver(db)
+	cases := []struct {
+		repo         *models.Repo
+		versionRange string
+		exp          string
+	}{
+		{repo: newTestRepo("github.com", "golangci/golangci-api"), exp: "v1.23.7"},
+		{repo: newTestRepo("github.com", "golangci/golangci-lint"), versionRange: "1.22.x", exp: "v1.22.2"},
+		{repo: newTestRepo("github.com", "golangci/golangci-web"), versionRange: "^1.21.0", exp: "v1.23.8"},
+		{repo: newTestRepo("gitlab.com", "golangci/golangci-api"), exp: "v1.23.7"},
+	}
+	for _, tc := range cases {
+		version, err := versions.resolve(tc.repo, tc.versionRange)
+		require.NoError(t, err)
+		assert.Equal(t, tc.exp, version, "%s %q", tc.repo.FullName, tc.versionRange)
+	}
+
+	_, err := versions.resolve(newTestRepo("github.com", "golangci/golangci-api"), "1.10.x")
+	assert.Error(t, err)
+}
@@ -125,7 +125,7 @@ func (cc CreatorConsumer) run(ctx context.Context, m *createMessage, gormDB *gor
 		return errors.Wrapf(err, "failed to fetch from db repo with id %d", m.RepoID)
 	}
 
-	provider, err := cc.providerFactory.BuildForUser(gormDB, repo.UserID)
+	provider, err := cc.providerFactory.BuildForRepo(gormDB, &repo)
 	if err != nil {
 		return errors.Wrap(err, "failed to build provider")
 	}
@@ -248,12 +248,15 @@ func (cc CreatorConsumer) createRepoAnalysisStatus(ctx context.Context, r *model
 func (cc CreatorConsumer) createRepo(ctx context.Context, repo *models.Repo,
 	gormDB *gorm.DB, p provider.Provider) error {
 
-	if err := cc.createHook(ctx, repo, p); err != nil {
-		return err
-	}
+	// the app gets events by the app webhook and has access to the repo
+	if repo.ProviderInstallationID == 0 {
+		if err := cc.createHook(ctx, repo, p); err != nil {
+			return err
+		}
 
-	if err := cc.addCollaborator(ctx, repo, p); err != nil {
-		return err
+		if err := cc.addCollaborator(ctx, repo, p); err != nil {
+			return err
+		}
 	}
 
 	nextState := models.RepoCommitStateCreateCreatedRepo
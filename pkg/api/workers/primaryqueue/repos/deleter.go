@@ -81,14 +81,18 @@ func (dc DeleterConsumer) run(ctx context.Context, m *deleteMessage, gormDB *gor
 		dc.log.Warnf("Repo %d is already deleted", m.RepoID)
 	}
 
-	provider, err := dc.providerFactory.BuildForUser(gormDB, m.UserID)
-	if err != nil {
-		return errors.Wrap(err, "failed to build provider")
+	var p provider.Provider
+	if repo.ProviderInstallationID == 0 { // the app has no hook and isn't a collaborator
+		var err error
+		p, err = dc.providerFactory.BuildForUser(gormDB, m.UserID)
+		if err != nil {
+			return errors.Wrap(err, "failed to build provider")
+		}
 	}
 
 	switch repo.CommitState {
 	case models.RepoCommitStateDeleteInit, models.RepoCommitStateDeleteSentToQueue:
-		if err := dc.deleteRepo(ctx, &repo, gormDB, provider); err != nil {
+		if err := dc.deleteRepo(ctx, &repo, gormDB, p); err != nil {
 			return errors.Wrap(err, "failed to delete repo")
 		}
 		return nil
@@ -100,6 +104,31 @@ func (dc DeleterConsumer) run(ctx context.Context, m *deleteMessage, gormDB *gor
 func (dc DeleterConsumer) deleteRepo(ctx context.Context, repo *models.Repo,
 	gormDB *gorm.DB, p provider.Provider) error {
 
+	if repo.ProviderInstallationID == 0 {
+		if err := dc.deleteHookAndCollaborator(ctx, repo, p); err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	n, err := models.NewRepoQuerySet(gormDB).IDEq(repo.ID).
+		CommitStateIn(models.RepoCommitStateDeleteInit, models.RepoCommitStateDeleteSentToQueue).
+		GetUpdater().
+		SetCommitState(models.RepoCommitStateDeleteDone).
+		SetDeletedAt(&now).
+		UpdateNum()
+	if err != nil {
+		return errors.Wrapf(err, "failed to update repo with id %d", repo.ID)
+	}
+	if n != 1 {
+		return fmt.Errorf("race condition during update repo with id %d, n=%d", repo.ID, n)
+	}
+
+	dc.log.Infof("Disconnected repo %s in repo deleter queue", repo.FullNameWithProvider())
+	return nil
+}
+
+func (dc DeleterConsumer) deleteHookAndCollaborator(ctx context.Context, repo *models.Repo, p provider.Provider) error {
 	if err := p.DeleteRepoHook(ctx, repo.Owner(), repo.Repo(), repo.ProviderHookID); err != nil {
 		if err == provider.ErrNotFound {
 			dc.log.Warnf("Repo %s hook id %s was already deleted by previous run or manually by user",
@@ -119,21 +148,6 @@ func (dc DeleterConsumer) deleteRepo(ctx context.Context, repo *models.Repo,
 		}
 	}
 
-	now := time.Now()
-	n, err := models.NewRepoQuerySet(gormDB).IDEq(repo.ID).
-		CommitStateIn(models.RepoCommitStateDeleteInit, models.RepoCommitStateDeleteSentToQueue).
-		GetUpdater().
-		SetCommitState(models.RepoCommitStateDeleteDone).
-		SetDeletedAt(&now).
-		UpdateNum()
-	if err != nil {
-		return errors.Wrapf(err, "failed to update repo with id %d", repo.ID)
-	}
-	if n != 1 {
-		return fmt.Errorf("race condition during update repo with id %d, n=%d", repo.ID, n)
-	}
-
-	dc.log.Infof("Disconnected repo %s in repo deleter queue", repo.FullNameWithProvider())
 	return nil
 }
 
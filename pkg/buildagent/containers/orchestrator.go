@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/tracing"
 	"github.com/golangci/golangci-api/pkg/buildagent/build"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
@@ -79,9 +80,12 @@ func (o Orchestrator) Run(port int) error {
 	srv := &http.Server{Addr: addr}
 	o.log.Infof("Listening on %s...", addr)
 
-	http.HandleFunc("/setup", o.handleSetupRequest)
-	http.HandleFunc("/shutdown", o.handleShutdownRequest)
-	http.HandleFunc("/buildcommand", o.handleBuildCommandRequest)
+	http.Handle("/setup", tracing.InstrumentHandler("Orchestrator.Setup",
+		http.HandlerFunc(o.handleSetupRequest)))
+	http.Handle("/shutdown", tracing.InstrumentHandler("Orchestrator.Shutdown",
+		http.HandlerFunc(o.handleShutdownRequest)))
+	http.Handle("/buildcommand", tracing.InstrumentHandler("Orchestrator.BuildCommand",
+		http.HandlerFunc(o.handleBuildCommandRequest)))
 	return srv.ListenAndServe()
 }
 
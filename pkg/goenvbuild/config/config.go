@@ -14,12 +14,53 @@ type Service struct {
 	GolangciLintVersion string `mapstructure:"golangci-lint-version"`
 	Prepare             []string
 	SuggestedChanges    SuggestedChangesConfig `mapstructure:"suggested-changes"`
+	Clone               CloneConfig
+
+	// Monorepo enables analysis of every Go module in the repo separately
+	Monorepo bool
+
+	// Modules are relative dirs of Go modules found by goenvbuild in the monorepo mode
+	Modules []string `mapstructure:"-"`
 }
 
 type SuggestedChangesConfig struct {
 	Disabled bool
 }
 
+const (
+	CloneStrategyFull     = "full"
+	CloneStrategyShallow  = "shallow"
+	CloneStrategyPartial  = "partial"
+	CloneStrategyMergeRef = "merge-ref"
+)
+
+var CloneStrategies = []string{CloneStrategyFull, CloneStrategyShallow, CloneStrategyPartial, CloneStrategyMergeRef}
+
+type CloneConfig struct {
+	Strategy   string
+	Submodules bool
+}
+
+func (cfg CloneConfig) GetStrategy() string {
+	if cfg.Strategy == "" {
+		return CloneStrategyFull
+	}
+
+	return cfg.Strategy
+}
+
+func (cfg CloneConfig) Validate() error {
+	strategy := cfg.GetStrategy()
+	for _, s := range CloneStrategies {
+		if s == strategy {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid clone strategy %q, allowed strategies: %s",
+		cfg.Strategy, strings.Join(CloneStrategies, ", "))
+}
+
 type FullConfig struct {
 	Service Service
 }
@@ -0,0 +1,263 @@
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// GolangciLintRelease is a released version of golangci-lint available for analysis
+type GolangciLintRelease struct {
+	Version  string // without the "v" prefix, e.g. 1.23.8 or 1.10 for old releases without a patch version
+	Checksum string `json:",omitempty"` // sha256 of the linux-amd64 release archive
+}
+
+func (r GolangciLintRelease) Validate() error {
+	if strings.HasPrefix(r.Version, "v") {
+		return fmt.Errorf("version %q must be without the \"v\" prefix", r.Version)
+	}
+
+	if _, err := parseSemver(r.Version); err != nil {
+		return err
+	}
+
+	// releases are downloaded during analysis: never install them without verification
+	if len(r.Checksum) != sha256.Size*2 {
+		return fmt.Errorf("checksum %q must be a hex-encoded sha256", r.Checksum)
+	}
+	if _, err := hex.DecodeString(r.Checksum); err != nil {
+		return fmt.Errorf("checksum %q must be a hex-encoded sha256: %s", r.Checksum, err)
+	}
+
+	return nil
+}
+
+func (r GolangciLintRelease) VersionWithV() string {
+	return "v" + r.Version
+}
+
+func (r GolangciLintRelease) ArchiveName() string {
+	return fmt.Sprintf("golangci-lint-%s-linux-amd64", r.Version)
+}
+
+func (r GolangciLintRelease) ArchiveURL() string {
+	return fmt.Sprintf("https://github.com/golangci/golangci-lint/releases/download/%s/%s.tar.gz",
+		r.VersionWithV(), r.ArchiveName())
+}
+
+// GolangciLintRegistry is a list of golangci-lint releases: it's stored by API
+// and is passed into goenvbuild by the worker.
+type GolangciLintRegistry struct {
+	Releases []GolangciLintRelease
+
+	// DefaultVersion is used if there is no golangci-lint-version in the config
+	DefaultVersion string
+}
+
+func ParseGolangciLintRegistry(data string) (*GolangciLintRegistry, error) {
+	var r GolangciLintRegistry
+	if err := json.Unmarshal([]byte(data), &r); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal golangci-lint registry")
+	}
+
+	if len(r.Releases) == 0 {
+		return nil, errors.New("no releases in golangci-lint registry")
+	}
+
+	return &r, nil
+}
+
+// Latest returns the release with the max version
+func (r GolangciLintRegistry) Latest() *GolangciLintRelease {
+	var latest *GolangciLintRelease
+	var latestVersion *semver
+	for i := range r.Releases {
+		v, err := parseSemver(r.Releases[i].Version)
+		if err != nil {
+			continue
+		}
+
+		if latestVersion == nil || latestVersion.less(*v) {
+			latest, latestVersion = &r.Releases[i], v
+		}
+	}
+
+	return latest
+}
+
+// Resolve finds the max release matching the version range, the default release is used for an empty range
+func (r GolangciLintRegistry) Resolve(versionRange string) (*GolangciLintRelease, error) {
+	if versionRange == "" {
+		if r.DefaultVersion == "" {
+			return nil, errors.New("no default golangci-lint version in registry")
+		}
+		versionRange = r.DefaultVersion
+	}
+
+	vr, err := ParseGolangciLintVersionRange(versionRange)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []GolangciLintRelease
+	for _, rel := range r.Releases {
+		v, err := parseSemver(rel.Version)
+		if err != nil {
+			continue
+		}
+
+		if vr.matches(*v) {
+			matched = append(matched, rel)
+		}
+	}
+
+	if len(matched) == 0 {
+		return nil, fmt.Errorf("no available golangci-lint version matching %q", versionRange)
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		vi, _ := parseSemver(matched[i].Version)
+		vj, _ := parseSemver(matched[j].Version)
+		return vi.less(*vj)
+	})
+	return &matched[len(matched)-1], nil
+}
+
+type semver struct {
+	major, minor, patch int
+}
+
+func (v semver) compare(other semver) int {
+	switch {
+	case v.major != other.major:
+		return v.major - other.major
+	case v.minor != other.minor:
+		return v.minor - other.minor
+	default:
+		return v.patch - other.patch
+	}
+}
+
+func (v semver) less(other semver) bool {
+	return v.compare(other) < 0
+}
+
+// parseSemver parses versions like 1.23.8, v1.23.8 and 1.10 (the same as 1.10.0)
+func parseSemver(s string) (*semver, error) {
+	parts := strings.Split(strings.TrimPrefix(s, "v"), ".")
+	if len(parts) != 2 && len(parts) != 3 {
+		return nil, fmt.Errorf("bad count of dots in version %q", s)
+	}
+
+	var nums [3]int
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid version %q: bad number %q", s, p)
+		}
+		nums[i] = n
+	}
+
+	return &semver{major: nums[0], minor: nums[1], patch: nums[2]}, nil
+}
+
+type versionConstraint struct {
+	op string
+	v  semver
+}
+
+func (c versionConstraint) matches(v semver) bool {
+	cmp := v.compare(c.v)
+	switch c.op {
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	default:
+		return cmp == 0
+	}
+}
+
+// GolangciLintVersionRange is a set of constraints, all of them must be matched
+type GolangciLintVersionRange struct {
+	constraints []versionConstraint
+}
+
+func (vr GolangciLintVersionRange) matches(v semver) bool {
+	for _, c := range vr.constraints {
+		if !c.matches(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ParseGolangciLintVersionRange parses ranges like:
+// 1.23.8 or 1.23 (exact version), 1.23.x, ^1.21.0, ~1.23.1, >=1.20 <1.24 or >=1.20, <1.24
+func ParseGolangciLintVersionRange(s string) (*GolangciLintVersionRange, error) {
+	fields := strings.Fields(strings.Replace(s, ",", " ", -1))
+	if len(fields) == 0 {
+		return nil, errors.New("empty version range")
+	}
+
+	var vr GolangciLintVersionRange
+	for _, f := range fields {
+		constraints, err := parseVersionConstraints(f)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid version range %q", s)
+		}
+		vr.constraints = append(vr.constraints, constraints...)
+	}
+
+	return &vr, nil
+}
+
+func parseVersionConstraints(s string) ([]versionConstraint, error) {
+	for _, op := range []string{">=", "<=", ">", "<", "="} {
+		if strings.HasPrefix(s, op) {
+			v, err := parseSemver(strings.TrimPrefix(s, op))
+			if err != nil {
+				return nil, err
+			}
+			return []versionConstraint{{op: op, v: *v}}, nil
+		}
+	}
+
+	if strings.HasPrefix(s, "^") || strings.HasPrefix(s, "~") {
+		v, err := parseSemver(s[1:])
+		if err != nil {
+			return nil, err
+		}
+
+		upper := semver{major: v.major + 1}
+		if s[0] == '~' || v.major == 0 {
+			upper = semver{major: v.major, minor: v.minor + 1}
+		}
+		return []versionConstraint{{op: ">=", v: *v}, {op: "<", v: upper}}, nil
+	}
+
+	if strings.HasSuffix(s, ".x") || strings.HasSuffix(s, ".*") {
+		v, err := parseSemver(s[:len(s)-2])
+		if err != nil {
+			return nil, err
+		}
+		return []versionConstraint{{op: ">=", v: *v}, {op: "<", v: semver{major: v.major, minor: v.minor + 1}}}, nil
+	}
+
+	v, err := parseSemver(s)
+	if err != nil {
+		return nil, err
+	}
+	return []versionConstraint{{op: "=", v: *v}}, nil
+}
@@ -0,0 +1,70 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryResolve(t *testing.T) {
+	r := GolangciLintRegistry{
+		Releases: []GolangciLintRelease{
+			{Version: "1.10"},
+			{Version: "1.10.2"},
+			{Version: "1.21.0"},
+			{Version: "1.22.2"},
+			{Version: "1.23.0"},
+			{Version: "1.23.8"},
+			{Version: "2.0.1"},
+		},
+		DefaultVersion: "1.23.x",
+	}
+
+	cases := []struct {
+		versionRange string
+		expected     string
+	}{
+		{"", "1.23.8"},
+		{"1.10", "1.10"},
+		{"v1.10.2", "1.10.2"},
+		{"1.23", "1.23.0"},
+		{"1.23.x", "1.23.8"},
+		{"1.22.*", "1.22.2"},
+		{"^1.21.0", "1.23.8"},
+		{"~1.22.0", "1.22.2"},
+		{">=1.21 <1.23", "1.22.2"},
+		{">=1.21, <=1.23.0", "1.23.0"},
+		{">1.23.8", "2.0.1"},
+	}
+
+	for _, c := range cases {
+		rel, err := r.Resolve(c.versionRange)
+		require.NoError(t, err, c.versionRange)
+		assert.Equal(t, c.expected, rel.Version, c.versionRange)
+	}
+
+	for _, versionRange := range []string{"1.11.x", "3", ">=1.x", "^a.b"} {
+		_, err := r.Resolve(versionRange)
+		assert.Error(t, err, versionRange)
+	}
+
+	assert.Equal(t, "2.0.1", r.Latest().Version)
+}
+
+func TestReleaseValidate(t *testing.T) {
+	const checksum = "a4c2e5e3f9c5bbd2e6b8e93d4c1e7d1b6e0b7e3f2c9a8d5e4f3a2b1c0d9e8f7a"
+	assert.NoError(t, GolangciLintRelease{Version: "1.23.8", Checksum: checksum}.Validate())
+
+	invalid := []GolangciLintRelease{
+		{Version: "v1.23.8", Checksum: checksum},
+		{Version: "1.23.x", Checksum: checksum},
+		{Version: "1.23.8"},
+		{Version: "1.23.8", Checksum: "abc"},
+		{Version: "1.23.8", Checksum: strings.Repeat("z", 64)},
+	}
+	for _, r := range invalid {
+		assert.Error(t, r.Validate(), r)
+	}
+}
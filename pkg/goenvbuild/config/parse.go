@@ -0,0 +1,28 @@
+package config
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+// FileNames are config file names in the order of lookup
+var FileNames = []string{".golangci.yml", ".golangci.yaml", ".golangci.toml", ".golangci.json"}
+
+func Parse(fileName string, content []byte) (*FullConfig, error) {
+	v := viper.New()
+	v.SetConfigType(strings.TrimPrefix(filepath.Ext(fileName), "."))
+	if err := v.ReadConfig(bytes.NewReader(content)); err != nil {
+		return nil, errors.Wrapf(err, "can't read config %s", fileName)
+	}
+
+	var cfg FullConfig
+	if err := v.Unmarshal(&cfg); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal config by viper")
+	}
+
+	return &cfg, nil
+}
@@ -0,0 +1,302 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+type ValidationIssue struct {
+	Key       string
+	Line      int `json:",omitempty"`
+	Text      string
+	IsWarning bool `json:",omitempty"`
+}
+
+func (i ValidationIssue) String() string {
+	level := "ERROR"
+	if i.IsWarning {
+		level = "WARNING"
+	}
+
+	return fmt.Sprintf("[%s] %s: %s", level, i.Key, i.Text)
+}
+
+type ValidationError struct {
+	FileName string
+	Issues   []ValidationIssue
+}
+
+func (e ValidationError) Error() string {
+	var texts []string
+	for _, i := range e.Issues {
+		if !i.IsWarning {
+			texts = append(texts, fmt.Sprintf("%s: %s", i.Key, i.Text))
+		}
+	}
+
+	return fmt.Sprintf("invalid config %s: %s", e.FileName, strings.Join(texts, "; "))
+}
+
+func HasValidationErrors(issues []ValidationIssue) bool {
+	for _, i := range issues {
+		if !i.IsWarning {
+			return true
+		}
+	}
+
+	return false
+}
+
+type unsafeCommandRule struct {
+	re        *regexp.Regexp
+	text      string
+	isWarning bool
+}
+
+var unsafeCommandRules = []unsafeCommandRule{
+	{regexp.MustCompile(`(^|[\s;&|])sudo\s`), "sudo isn't allowed", false},
+	{regexp.MustCompile(`PRIVATE_ACCESS_TOKEN`), "access to the private access token isn't allowed", false},
+	{regexp.MustCompile(`\.git-credentials`), "access to git credentials isn't allowed", false},
+	{regexp.MustCompile(`(^|[\s;&|])rm\s+(-\w+\s+)*/(\s|$|\*)`), "removing of the root dir isn't allowed", false},
+	{regexp.MustCompile(`:\(\)\s*\{.*:\s*\|\s*:`), "fork bombs aren't allowed", false},
+	{regexp.MustCompile(`(curl|wget)\s[^|]*\|\s*(sudo\s+)?(ba|z)?sh\b`), "piping of downloaded scripts into a shell is unsafe: " +
+		"pin the script version or use go get", true},
+}
+
+type Validator struct {
+	rootDir  string
+	registry *GolangciLintRegistry
+}
+
+// NewValidator makes a validator checking analyzed paths existence in rootDir
+// and golangci-lint version availability in registry.
+// Path existence isn't checked if rootDir is empty, version availability isn't checked if registry is nil.
+func NewValidator(rootDir string, registry *GolangciLintRegistry) *Validator {
+	return &Validator{
+		rootDir:  rootDir,
+		registry: registry,
+	}
+}
+
+func (v Validator) Validate(fileName string, content []byte) ([]ValidationIssue, error) {
+	vp := viper.New()
+	vp.SetConfigType(strings.TrimPrefix(filepath.Ext(fileName), "."))
+	if err := vp.ReadConfig(bytes.NewReader(content)); err != nil {
+		return []ValidationIssue{{Key: "", Text: fmt.Sprintf("can't parse config: %s", err)}}, nil
+	}
+
+	var cfg FullConfig
+	if err := vp.Unmarshal(&cfg); err != nil {
+		return []ValidationIssue{{Key: "service", Text: fmt.Sprintf("can't decode config: %s", err)}}, nil
+	}
+
+	var issues []ValidationIssue
+	schema := buildSchema(reflect.TypeOf(Service{}))
+	if raw, ok := vp.Get("service").(map[string]interface{}); ok {
+		issues = append(issues, findUnknownKeys("service", raw, schema)...)
+	}
+
+	issues = append(issues, v.validateService(&cfg.Service)...)
+
+	lines := newKeyLineFinder(fileName, content)
+	for i := range issues {
+		issues[i].Line = lines.find(issues[i].Key)
+	}
+
+	// unknown keys are found in the map iteration order: make the output stable
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Line != issues[j].Line {
+			return issues[i].Line < issues[j].Line
+		}
+		return issues[i].Key < issues[j].Key
+	})
+
+	return issues, nil
+}
+
+func (v Validator) validateService(cfg *Service) []ValidationIssue {
+	var issues []ValidationIssue
+	addErr := func(key, format string, args ...interface{}) {
+		issues = append(issues, ValidationIssue{Key: key, Text: fmt.Sprintf(format, args...)})
+	}
+
+	if cfg.GolangciLintVersion != "" {
+		if _, err := ParseGolangciLintVersionRange(cfg.GolangciLintVersion); err != nil {
+			addErr("service.golangci-lint-version", "%s", err)
+		} else if v.registry != nil {
+			if _, err = v.registry.Resolve(cfg.GolangciLintVersion); err != nil {
+				addErr("service.golangci-lint-version", "%s", err)
+			}
+		}
+	}
+
+	for _, cmd := range cfg.Prepare {
+		if strings.TrimSpace(cmd) == "" {
+			addErr("service.prepare", "prepare command %q is empty", cmd)
+			continue
+		}
+
+		for _, rule := range unsafeCommandRules {
+			if rule.re.MatchString(cmd) {
+				issues = append(issues, ValidationIssue{
+					Key:       "service.prepare",
+					Text:      fmt.Sprintf("command %q: %s", cmd, rule.text),
+					IsWarning: rule.isWarning,
+				})
+			}
+		}
+	}
+
+	if err := cfg.Clone.Validate(); err != nil {
+		addErr("service.clone.strategy", "%s", err)
+	}
+
+	if err := cfg.validateAnalyzedPaths(); err != nil {
+		addErr("service.analyzed-paths", "%s", err)
+	} else if v.rootDir != "" {
+		for _, path := range cfg.AnalyzedPaths {
+			dir := strings.TrimSuffix(strings.TrimSuffix(path, "..."), "/")
+			if dir == "" || dir == "." {
+				continue
+			}
+
+			if _, err := os.Stat(filepath.Join(v.rootDir, dir)); err != nil {
+				addErr("service.analyzed-paths", "path %q doesn't exist in the repo", path)
+			}
+		}
+	}
+
+	return issues
+}
+
+// schema maps lowercased config keys to nested schemas, nil for leaf keys
+type schema map[string]schema
+
+func buildSchema(t reflect.Type) schema {
+	ret := schema{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := f.Tag.Get("mapstructure")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		var nested schema
+		if f.Type.Kind() == reflect.Struct {
+			nested = buildSchema(f.Type)
+		}
+		ret[strings.ToLower(name)] = nested
+	}
+
+	return ret
+}
+
+func findUnknownKeys(prefix string, raw map[string]interface{}, s schema) []ValidationIssue {
+	var issues []ValidationIssue
+	for k, v := range raw {
+		key := prefix + "." + k
+		nested, ok := s[strings.ToLower(k)]
+		if !ok {
+			issues = append(issues, ValidationIssue{
+				Key:       key,
+				Text:      "unknown key",
+				IsWarning: true,
+			})
+			continue
+		}
+
+		if nested == nil {
+			continue
+		}
+
+		if m, ok := toStringMap(v); ok {
+			issues = append(issues, findUnknownKeys(key, m, nested)...)
+		}
+	}
+
+	return issues
+}
+
+func toStringMap(v interface{}) (map[string]interface{}, bool) {
+	switch m := v.(type) {
+	case map[string]interface{}:
+		return m, true
+	case map[interface{}]interface{}:
+		ret := map[string]interface{}{}
+		for k, v := range m {
+			ret[fmt.Sprint(k)] = v
+		}
+		return ret, true
+	}
+
+	return nil, false
+}
+
+// keyLineFinder approximately finds a line of the key in the config content:
+// it's enough for pointing users at the problem.
+type keyLineFinder struct {
+	ext   string
+	lines []string
+}
+
+func newKeyLineFinder(fileName string, content []byte) *keyLineFinder {
+	return &keyLineFinder{
+		ext:   strings.TrimPrefix(filepath.Ext(fileName), "."),
+		lines: strings.Split(string(content), "\n"),
+	}
+}
+
+func (f keyLineFinder) matches(line, key string) bool {
+	line = strings.TrimSpace(line)
+	switch f.ext {
+	case "json":
+		return strings.HasPrefix(line, fmt.Sprintf("%q", key))
+	case "toml":
+		return line == fmt.Sprintf("[%s]", key) || strings.HasSuffix(line, "."+key+"]") ||
+			regexp.MustCompile(`^"?`+regexp.QuoteMeta(key)+`"?\s*=`).MatchString(line)
+	default:
+		return regexp.MustCompile(`^"?` + regexp.QuoteMeta(key) + `"?\s*:`).MatchString(line)
+	}
+}
+
+func (f keyLineFinder) find(key string) int {
+	if key == "" {
+		return 0
+	}
+
+	// search every next key part after the line of the previous one
+	line := -1
+	for _, part := range strings.Split(key, ".") {
+		for i := line + 1; i < len(f.lines); i++ {
+			if f.matches(f.lines[i], part) {
+				line = i
+				break
+			}
+		}
+	}
+
+	return line + 1 // 0 if not found
+}
+
+// ValidateFile validates the config file fileName in the dir rootDir
+func ValidateFile(rootDir, fileName string, registry *GolangciLintRegistry) ([]ValidationIssue, error) {
+	content, err := ioutil.ReadFile(filepath.Join(rootDir, fileName))
+	if err != nil {
+		return nil, errors.Wrapf(err, "can't read config file %s", fileName)
+	}
+
+	return NewValidator(rootDir, registry).Validate(fileName, content)
+}
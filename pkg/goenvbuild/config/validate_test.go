@@ -0,0 +1,101 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testRegistry = &GolangciLintRegistry{
+	Releases: []GolangciLintRelease{
+		{Version: "1.22.2"},
+		{Version: "1.23.7"},
+		{Version: "1.23.8"},
+	},
+	DefaultVersion: "1.23.8",
+}
+
+func TestValidateYAML(t *testing.T) {
+	const cfg = `linters:
+  enable-all: true
+service:
+  golangci-lint-version: 0.99.1
+  prepare:
+    - sudo apt-get install -y libpcap-dev
+    - curl -sSfL https://example.com/install.sh | sh
+  clone:
+    strategy: sparse
+  unknown-key: 1
+  analyzed-paths:
+    - ./cmd/...
+    - ./missing/...
+`
+
+	root, err := ioutil.TempDir("", "validate")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "cmd"), os.ModePerm))
+
+	issues, err := NewValidator(root, testRegistry).Validate(".golangci.yml", []byte(cfg))
+	require.NoError(t, err)
+
+	byKey := map[string][]ValidationIssue{}
+	for _, i := range issues {
+		byKey[i.Key] = append(byKey[i.Key], i)
+	}
+
+	require.Len(t, byKey["service.golangci-lint-version"], 1)
+	assert.Equal(t, 4, byKey["service.golangci-lint-version"][0].Line)
+	assert.False(t, byKey["service.golangci-lint-version"][0].IsWarning)
+
+	require.Len(t, byKey["service.prepare"], 2)
+	assert.False(t, byKey["service.prepare"][0].IsWarning)
+	assert.True(t, byKey["service.prepare"][1].IsWarning)
+
+	require.Len(t, byKey["service.clone.strategy"], 1)
+	assert.Equal(t, 9, byKey["service.clone.strategy"][0].Line)
+
+	require.Len(t, byKey["service.unknown-key"], 1)
+	assert.True(t, byKey["service.unknown-key"][0].IsWarning)
+	assert.Equal(t, 10, byKey["service.unknown-key"][0].Line)
+
+	require.Len(t, byKey["service.analyzed-paths"], 1)
+	assert.Contains(t, byKey["service.analyzed-paths"][0].Text, "./missing/...")
+
+	assert.True(t, HasValidationErrors(issues))
+}
+
+func TestValidateValidJSON(t *testing.T) {
+	const cfg = `{"service": {"golangci-lint-version": "1.23.x", "prepare": ["make deps"]}}`
+
+	issues, err := NewValidator("", testRegistry).Validate(".golangci.json", []byte(cfg))
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+}
+
+func TestValidateSortsIssues(t *testing.T) {
+	const cfg = `service:
+  prepare:
+    - sudo make deps
+  z-unknown: 1
+  b-unknown: 2
+  project-path: github.com/golangci/golangci-api
+  a-unknown:
+    nested: 3
+`
+
+	for i := 0; i < 10; i++ { // map iteration order is random
+		issues, err := NewValidator("", nil).Validate(".golangci.yml", []byte(cfg))
+		require.NoError(t, err)
+
+		var keys []string
+		for _, issue := range issues {
+			keys = append(keys, issue.Key)
+		}
+		assert.Equal(t, []string{"service.prepare", "service.z-unknown", "service.b-unknown", "service.a-unknown"}, keys)
+	}
+}
@@ -0,0 +1,51 @@
+package modules
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+func isSkippedDir(name string) bool {
+	return name == "vendor" || name == "testdata" || name == "node_modules" ||
+		strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")
+}
+
+// Discover returns slash-separated dirs of all Go modules relative to the root,
+// the root module is returned as "."
+func Discover(root string) ([]string, error) {
+	var ret []string
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if path != root && isSkippedDir(info.Name()) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if info.Name() != "go.mod" {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+
+		ret = append(ret, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to walk %s", root)
+	}
+
+	sort.Strings(ret)
+	return ret, nil
+}
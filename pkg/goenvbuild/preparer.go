@@ -6,8 +6,8 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path"
 	"path/filepath"
-	"strconv"
 	"strings"
 	"time"
 
@@ -19,33 +19,13 @@ import (
 	"github.com/golangci/golangci-api/internal/shared/logutil"
 	goenvconfig "github.com/golangci/golangci-api/pkg/goenvbuild/config"
 	"github.com/golangci/golangci-api/pkg/goenvbuild/logger"
+	"github.com/golangci/golangci-api/pkg/goenvbuild/modules"
 	"github.com/golangci/golangci-api/pkg/goenvbuild/repoinfo"
 	"github.com/golangci/golangci-api/pkg/goenvbuild/result"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 )
 
-var availableGolangciLintVersions = map[int]map[int][]int{
-	1: {
-		10: {-1, 1, 2},
-		11: {-1, 1, 2, 3},
-		12: {-1, 1, 2, 3, 4, 5},
-		13: {-1, 1, 2},
-		14: {0},
-		15: {0},
-		16: {0},
-		17: {0, 1},
-		18: {0},
-		19: {0, 1},
-		20: {0, 1},
-		21: {0},
-		22: {0, 1, 2},
-		23: {0, 1, 2, 3, 4, 5, 6, 7, 8},
-	},
-}
-
-const defaultGolangciLintVersion = "1.23.x"
-
 type Preparer struct {
 	cfg config.Config
 }
@@ -107,18 +87,36 @@ func (p Preparer) run(needStreamToOutput bool) *result.Result {
 	}
 
 	// load config
+	var registry *goenvconfig.GolangciLintRegistry
 	err := runStepGroup(res.Log, "load config", func(sg *result.StepGroup, log logutil.Log) error {
+		var err error
+		if registry, err = p.loadGolangciLintRegistry(sg, log); err != nil {
+			return err
+		}
+
 		cfg, err := p.tryLoadConfig(sg, log)
 		if err != nil {
 			return err
 		}
 		res.ServiceConfig = *cfg
+		if used := viper.ConfigFileUsed(); used != "" {
+			res.ConfigFileName = filepath.Base(used)
+		}
 		return nil
 	})
 	if err != nil {
 		return saveErr(err)
 	}
 
+	if res.ConfigFileName != "" {
+		err = runStepGroup(res.Log, "validate config", func(sg *result.StepGroup, log logutil.Log) error {
+			return p.validateConfig(sg, res, registry)
+		})
+		if err != nil {
+			return saveErr(err)
+		}
+	}
+
 	// find the project path
 	projectPath := strings.ToLower(p.cfg.GetString("REPO"))
 	err = runStepGroup(res.Log, "find the project path", func(sg *result.StepGroup, log logutil.Log) error {
@@ -189,7 +187,7 @@ func (p Preparer) run(needStreamToOutput bool) *result.Result {
 
 	// setup golangci-lint - do it after preparation to disallow overwriting golangci-lint version by user-defined commands
 	err = runStepGroup(res.Log, "setup golangci-lint", func(sg *result.StepGroup, log logutil.Log) error {
-		version, setupErr := p.setupGolangciLint(ctx, sg, log, &res.ServiceConfig, runner)
+		version, setupErr := p.setupGolangciLint(ctx, sg, log, &res.ServiceConfig, registry, runner)
 		if setupErr != nil {
 			return setupErr
 		}
@@ -201,12 +199,18 @@ func (p Preparer) run(needStreamToOutput bool) *result.Result {
 		return saveErr(err)
 	}
 
-	// prepare repo
-	err = runStepGroup(res.Log, "prepare repo", func(sg *result.StepGroup, log logutil.Log) error {
-		return p.runPreparation(ctx, sg, log, &res.ServiceConfig, projectPath, runner)
-	})
-	if err != nil {
-		return saveErr(err)
+	if res.ServiceConfig.Monorepo {
+		if err = p.prepareMonorepo(ctx, res, projectPath, runner); err != nil {
+			return saveErr(err)
+		}
+	} else {
+		// prepare repo
+		err = runStepGroup(res.Log, "prepare repo", func(sg *result.StepGroup, log logutil.Log) error {
+			return p.runPreparation(ctx, sg, log, &res.ServiceConfig, projectPath, runner)
+		})
+		if err != nil {
+			return saveErr(err)
+		}
 	}
 
 	if !p.cfg.GetBool("RUN", false) { // the option RUN is enabled only for manual testing
@@ -225,64 +229,6 @@ func (p Preparer) run(needStreamToOutput bool) *result.Result {
 	return res
 }
 
-type version struct {
-	major int
-	minor int
-	patch *int // nil - any, -1 - major.minor, 0/1/2/... - major.minor.patch
-}
-
-func (v version) String() string {
-	s := fmt.Sprintf("%d.%d", v.major, v.minor)
-	if v.patch == nil {
-		return s + ".x"
-	}
-	if *v.patch == -1 {
-		return s
-	}
-
-	return fmt.Sprintf("%s.%d", s, *v.patch)
-}
-
-func (v version) StringWithV() string {
-	return "v" + v.String()
-}
-
-func parseVersion(v string) (*version, error) {
-	parts := strings.Split(v, ".")
-	if len(parts) != 2 && len(parts) != 3 {
-		return nil, fmt.Errorf("bad count of dots in version %q", v)
-	}
-
-	major, err := strconv.Atoi(parts[0])
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to parse version's major part %q", parts[0])
-	}
-	minor, err := strconv.Atoi(parts[1])
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to parse version's minor elem %q", parts[1])
-	}
-
-	var patch *int
-	if len(parts) == 3 {
-		if parts[2] != "x" {
-			patch = new(int)
-			*patch, err = strconv.Atoi(parts[2])
-			if err != nil {
-				return nil, errors.Wrapf(err, "failed to parse version's patch elem %q", parts[2])
-			}
-		}
-	} else {
-		patch = new(int)
-		*patch = -1
-	}
-
-	return &version{
-		major: major,
-		minor: minor,
-		patch: patch,
-	}, nil
-}
-
 func (p Preparer) runGolangciLint(ctx context.Context, sg *result.StepGroup, runner *command.StreamingRunner, cfg *goenvconfig.Service) error {
 	cmd := "golangci-lint"
 
@@ -318,25 +264,44 @@ func (p Preparer) printEnvironment(ctx context.Context, sg *result.StepGroup, ru
 	return nil
 }
 
-func (p Preparer) setupGolangciLint(ctx context.Context, sg *result.StepGroup, log logutil.Log,
-	cfg *goenvconfig.Service, r *command.StreamingRunner) (string, error) {
+func (p Preparer) loadGolangciLintRegistry(sg *result.StepGroup, log logutil.Log) (*goenvconfig.GolangciLintRegistry, error) {
+	sg.AddStep("load golangci-lint versions registry")
+
+	data := p.cfg.GetString("GOLANGCI_LINT_REGISTRY")
+	if data == "" {
+		return nil, errors.New("must set GOLANGCI_LINT_REGISTRY environment variable to json of golangci-lint releases")
+	}
 
-	parsedVersion, err := p.parseGolangciLintVersion(sg, log, cfg)
+	registry, err := goenvconfig.ParseGolangciLintRegistry(data)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	log.Infof("Loaded %d golangci-lint releases, default version is %q", len(registry.Releases), registry.DefaultVersion)
+	return registry, nil
+}
+
+func (p Preparer) setupGolangciLint(ctx context.Context, sg *result.StepGroup, log logutil.Log,
+	cfg *goenvconfig.Service, registry *goenvconfig.GolangciLintRegistry, r *command.StreamingRunner) (string, error) {
+
+	sg.AddStep("find golangci-lint version")
+	if cfg.GolangciLintVersion != "" {
+		log.Infof("Using version %q from config", cfg.GolangciLintVersion)
+	} else {
+		log.Infof("No golangci-lint version in config, use default: %q", registry.DefaultVersion)
 	}
 
-	neededVersion, err := p.findGolangciLintVersion(parsedVersion, sg)
+	release, err := registry.Resolve(cfg.GolangciLintVersion)
 	if err != nil {
 		return "", err
 	}
-	log.Infof("Using golangci-lint v%s", neededVersion.String())
+	log.Infof("Using golangci-lint %s", release.VersionWithV())
 
-	if err = p.installGolangciLint(ctx, neededVersion, sg, log, r); err != nil {
+	if err = p.installGolangciLint(ctx, release, sg, log, r); err != nil {
 		return "", err
 	}
 
-	return neededVersion.String(), nil
+	return release.Version, nil
 }
 
 func (p Preparer) findInstalledGolangciLintVersion(ctx context.Context, sg *result.StepGroup, r *command.StreamingRunner) (string, error) {
@@ -357,82 +322,43 @@ func (p Preparer) findInstalledGolangciLintVersion(ctx context.Context, sg *resu
 	return version, nil
 }
 
-func (p Preparer) installGolangciLint(ctx context.Context, v *version, sg *result.StepGroup,
+func (p Preparer) installGolangciLint(ctx context.Context, release *goenvconfig.GolangciLintRelease, sg *result.StepGroup,
 	log logutil.Log, r *command.StreamingRunner) error {
 	installedVersion, err := p.findInstalledGolangciLintVersion(ctx, sg, r)
 	if err != nil {
 		log.Warnf("Failed to find installed golangci-lint version, downloading needed version: %s", err)
 	}
 
-	if installedVersion == v.String() {
-		sg.AddStep("installing golangci-lint " + v.StringWithV())
+	if installedVersion == release.Version {
+		sg.AddStep("installing golangci-lint " + release.VersionWithV())
 		log.Infof("golangci-lint of needed version is installed by default, no need to download")
 		return nil
 	}
 
-	// TODO: should we use script from master?
-	// TODO: install by wget-ing release archive
-	const shellCmdFmt = "curl -sfL https://raw.githubusercontent.com/golangci/golangci-lint/master/install.sh | " +
-		"sh -s -- -b $GOPATH/bin %s"
-	shellCmd := fmt.Sprintf(shellCmdFmt, v.StringWithV())
-	sg.AddStep(shellCmd)
-
-	_, err = r.Run(ctx, "sh", "-c", shellCmd)
-	if err != nil {
-		return err
+	if release.Checksum == "" {
+		return fmt.Errorf("no checksum for golangci-lint %s in registry, refusing to install it", release.VersionWithV())
 	}
 
-	return nil
-}
-
-func (p Preparer) findGolangciLintVersion(parsedVersion *version, sg *result.StepGroup) (*version, error) {
-	sg.AddStep("find golangci-lint version")
-
-	minorVersions := availableGolangciLintVersions[parsedVersion.major]
-	if minorVersions == nil {
-		return nil, fmt.Errorf("no available major version %d", parsedVersion.major)
-	}
-
-	patchVersions := minorVersions[parsedVersion.minor]
-	if patchVersions == nil {
-		return nil, fmt.Errorf("no available minor version %d", parsedVersion.minor)
-	}
-
-	ret := *parsedVersion
-	if parsedVersion.patch == nil { // major.minor.x, use the latest patch version
-		ret.patch = new(int)
-		*ret.patch = patchVersions[len(patchVersions)-1]
-		return &ret, nil
-	}
-
-	for _, v := range patchVersions {
-		if v == *parsedVersion.patch {
-			return &ret, nil
-		}
+	archivePath := fmt.Sprintf("/tmp/%s.tar.gz", release.ArchiveName())
+	sg.AddStepCmd("curl", "-sSfL", "-o", archivePath, release.ArchiveURL())
+	if _, err = r.Run(ctx, "curl", "-sSfL", "-o", archivePath, release.ArchiveURL()); err != nil {
+		return errors.Wrapf(err, "failed to download golangci-lint %s", release.VersionWithV())
 	}
 
-	return nil, fmt.Errorf("no available patch version %d", *parsedVersion.patch)
-}
-
-func (p Preparer) parseGolangciLintVersion(sg *result.StepGroup, log logutil.Log, cfg *goenvconfig.Service) (*version, error) {
-	sg.AddStep("parse golangci-lint version from config")
-
-	if cfg.GolangciLintVersion != "" {
-		v, err := parseVersion(cfg.GolangciLintVersion)
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to parse version %q", cfg.GolangciLintVersion)
-		}
-		log.Infof("Parsed version %q from config", cfg.GolangciLintVersion)
-		return v, nil
+	checkCmd := fmt.Sprintf("echo '%s  %s' | sha256sum -c -", release.Checksum, archivePath)
+	sg.AddStep(checkCmd)
+	if _, err = r.Run(ctx, "sh", "-c", checkCmd); err != nil {
+		return errors.Wrapf(err, "checksum mismatch for golangci-lint %s archive", release.VersionWithV())
 	}
 
-	v, err := parseVersion(defaultGolangciLintVersion)
+	binDir := path.Join(p.gopath(), "bin")
+	sg.AddStepCmd("tar", "-xzf", archivePath, "-C", binDir, "--strip-components=1", release.ArchiveName()+"/golangci-lint")
+	_, err = r.Run(ctx, "tar", "-xzf", archivePath, "-C", binDir, "--strip-components=1", release.ArchiveName()+"/golangci-lint")
 	if err != nil {
-		panic(err)
+		return errors.Wrap(err, "failed to unpack golangci-lint archive")
 	}
 
-	log.Infof("No golangci-lint version in config, use default: %q", defaultGolangciLintVersion)
-	return v, nil
+	return nil
 }
 
 func (p Preparer) setupGit(ctx context.Context, sg *result.StepGroup,
@@ -461,6 +387,59 @@ func (p Preparer) runPreparation(ctx context.Context, sg *result.StepGroup, log
 	return p.runUserDefinedPreparation(ctx, sg, cfg.Prepare, runner)
 }
 
+func (p Preparer) prepareMonorepo(ctx context.Context, res *result.Result, projectPath string, runner *command.StreamingRunner) error {
+	err := runStepGroup(res.Log, "discover Go modules", func(sg *result.StepGroup, log logutil.Log) error {
+		sg.AddStep("find go.mod files")
+		foundModules, err := modules.Discover(res.WorkDir)
+		if err != nil {
+			return err
+		}
+		if len(foundModules) == 0 {
+			return errors.New("no go.mod files were found in the monorepo mode")
+		}
+
+		log.Infof("Found %d modules: %s", len(foundModules), strings.Join(foundModules, ", "))
+		res.ServiceConfig.Modules = foundModules
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(res.ServiceConfig.Prepare) != 0 {
+		// user-defined preparation is run once for the whole repo, modules still need their dependencies
+		err = runStepGroup(res.Log, "prepare repo", func(sg *result.StepGroup, log logutil.Log) error {
+			return p.runUserDefinedPreparation(ctx, sg, res.ServiceConfig.Prepare, runner)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, module := range res.ServiceConfig.Modules {
+		moduleDir := filepath.Join(res.WorkDir, filepath.FromSlash(module))
+		modulePath := path.Join(projectPath, module)
+		err = runStepGroup(res.Log, fmt.Sprintf("prepare module %s", module), func(sg *result.StepGroup, log logutil.Log) error {
+			sg.AddStepCmd("cd", moduleDir)
+			if err := os.Chdir(moduleDir); err != nil {
+				return err
+			}
+			defer func() {
+				if err := os.Chdir(res.WorkDir); err != nil {
+					log.Warnf("Failed to chdir back to %s: %s", res.WorkDir, err)
+				}
+			}()
+
+			return p.runDefaultPreparation(ctx, sg, log, modulePath, runner.WithWD(moduleDir))
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (p Preparer) runDefaultPreparation(ctx context.Context, sg *result.StepGroup, log logutil.Log, projectPath string, r *command.StreamingRunner) error {
 	sg.AddStep("fetch dependencies")
 	runner := ensuredeps.NewRunner(log, r)
@@ -575,20 +554,30 @@ func (p Preparer) tryLoadConfig(sg *result.StepGroup, log logutil.Log) (*goenvco
 		return nil, errors.Wrap(err, "failed to unmarshal config by viper")
 	}
 
-	sg.AddStep("validate config")
-	if err := p.validateConfig(&cfg.Service); err != nil {
-		return nil, err
-	}
-
 	return &cfg.Service, nil
 }
 
-func (p Preparer) validateConfig(cfg *goenvconfig.Service) error {
-	for _, cmd := range cfg.Prepare {
-		if strings.TrimSpace(cmd) == "" {
-			return fmt.Errorf("prepare command %q is empty", cmd)
+func (p Preparer) validateConfig(sg *result.StepGroup, res *result.Result, registry *goenvconfig.GolangciLintRegistry) error {
+	step := sg.AddStep(fmt.Sprintf("validate %s", res.ConfigFileName))
+	issues, err := goenvconfig.ValidateFile(".", res.ConfigFileName, registry)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range issues {
+		step.AddOutputLine("%s:%d: %s", res.ConfigFileName, i.Line, i)
+	}
+	res.ConfigIssues = issues
+
+	if goenvconfig.HasValidationErrors(issues) {
+		return &goenvconfig.ValidationError{
+			FileName: res.ConfigFileName,
+			Issues:   issues,
 		}
 	}
 
+	if len(issues) == 0 {
+		step.AddOutputLine("Config is valid")
+	}
 	return nil
 }
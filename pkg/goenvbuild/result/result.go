@@ -115,7 +115,9 @@ func (lg *Log) LastStepGroup() *StepGroup {
 }
 
 type Result struct {
-	ServiceConfig goenvconfig.Service
+	ServiceConfig  goenvconfig.Service
+	ConfigFileName string                        `json:",omitempty"`
+	ConfigIssues   []goenvconfig.ValidationIssue `json:",omitempty"`
 
 	WorkDir             string
 	Environment         map[string]string
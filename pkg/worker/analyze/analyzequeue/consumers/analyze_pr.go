@@ -11,7 +11,9 @@ import (
 	"github.com/golangci/golangci-api/internal/shared/config"
 
 	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/tracing"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/golangci/golangci-api/pkg/worker/analytics"
 	"github.com/golangci/golangci-api/pkg/worker/analyze/processors"
@@ -41,7 +43,7 @@ func NewAnalyzePR(pf processors.PullProcessorFactory, log logutil.Log, errTracke
 }
 
 func (c AnalyzePR) Consume(ctx context.Context, repoOwner, repoName string,
-	isPrivateRepo bool, githubAccessToken string, pullRequestNumber int,
+	isPrivateRepo bool, githubAccessToken string, githubInstallationID int, pullRequestNumber int,
 	apiRequestID string, userID uint, analysisGUID, commitSHA string) error {
 
 	repo := github.Repo{
@@ -65,7 +67,14 @@ func (c AnalyzePR) Consume(ctx context.Context, repoOwner, repoName string,
 	log := logutil.WrapLogWithContext(c.log, lctx)
 	log = apperrors.WrapLogWithTracker(log, lctx, c.errTracker)
 
-	return c.wrapConsuming(ctx, log, repo.FullName(), func() error {
+	ctx, span := tracing.StartSpan(ctx, "AnalyzePR.Consume",
+		attribute.String("analysis_guid", analysisGUID),
+		attribute.String("repo", repo.FullName()),
+		attribute.Int("pr_number", pullRequestNumber))
+	var err error
+	defer func() { tracing.EndSpan(span, err) }()
+
+	err = c.wrapConsuming(ctx, log, repo.FullName(), func() error {
 		var cancel context.CancelFunc
 		// If you change timeout value don't forget to change it
 		// in golangci-api stale analyzes checker
@@ -79,9 +88,10 @@ func (c AnalyzePR) Consume(ctx context.Context, repoOwner, repoName string,
 			AnalysisGUID: analysisGUID,
 			CommitSHA:    commitSHA,
 			ProviderCtx: &github.Context{
-				Repo:              repo,
-				GithubAccessToken: githubAccessToken,
-				PullRequestNumber: pullRequestNumber,
+				Repo:                 repo,
+				GithubAccessToken:    githubAccessToken,
+				PullRequestNumber:    pullRequestNumber,
+				GithubInstallationID: githubInstallationID,
 			},
 			LogCtx: lctx,
 			Log:    log,
@@ -101,4 +111,5 @@ func (c AnalyzePR) Consume(ctx context.Context, repoOwner, repoName string,
 
 		return nil
 	})
+	return err
 }
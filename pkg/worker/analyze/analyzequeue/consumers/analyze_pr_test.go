@@ -46,6 +46,6 @@ func TestAnalyzeRepo(t *testing.T) {
 	ec := experiments.NewChecker(cfg, log)
 
 	err := NewAnalyzePR(pf, log, errTracker, cfg, ec).Consume(context.Background(), repoOwner, repoName,
-		false, cfg.GetString("TEST_GITHUB_TOKEN"), prNumber, "", userID, "test-guid", "commit-sha")
+		false, cfg.GetString("TEST_GITHUB_TOKEN"), 0, prNumber, "", userID, "test-guid", "commit-sha")
 	assert.NoError(t, err)
 }
@@ -4,3 +4,6 @@ import "time"
 
 const VisibilityTimeoutSec = 600          // must be in sync with cloudformation.yml
 const ConsumerTimeout = 530 * time.Second // reserve 30 sec
+
+// Provider is the provider of repos in analyzes messages, it's used to build scheduler keys
+const Provider = "github.com"
@@ -1,6 +1,10 @@
 package analyzesqueue
 
 import (
+	"context"
+
+	"github.com/golangci/golangci-api/internal/shared/providers/githubapp"
+	"github.com/golangci/golangci-api/internal/shared/providers/provider"
 	"github.com/golangci/golangci-api/internal/shared/queue/consumers"
 	"github.com/pkg/errors"
 	redsync "gopkg.in/redsync.v1"
@@ -14,3 +18,28 @@ func RegisterConsumer(consumeFunc interface{}, queueID string, m *consumers.Mult
 
 	return m.RegisterConsumer(queueID, consumer)
 }
+
+// IsHandled returns true if the message consuming finished with the error
+// and the message won't be retried
+func IsHandled(err error) bool {
+	return err == nil || errors.Cause(err) == consumers.ErrPermanent
+}
+
+// GetInstallationToken exchanges GitHub App installation id from the message for the access token:
+// the token isn't put into the message because it can expire while the analysis waits in the queue
+func GetInstallationToken(ctx context.Context, app *githubapp.App, installationID int) (string, error) {
+	if app == nil {
+		return "", errors.Errorf("no GitHub App configured for installation %d", installationID)
+	}
+
+	token, err := app.InstallationToken(ctx, installationID)
+	if err != nil {
+		if errors.Cause(err) == provider.ErrUnauthorized {
+			// the app was uninstalled, retries won't help
+			return "", errors.Wrapf(consumers.ErrPermanent, "failed to get installation token: %s", err)
+		}
+		return "", errors.Wrap(err, "failed to get installation token")
+	}
+
+	return token, nil
+}
@@ -1,6 +1,9 @@
 package pullanalyzesqueue
 
-import "github.com/golangci/golangci-api/pkg/worker/lib/github"
+import (
+	"github.com/golangci/golangci-api/internal/shared/tracing"
+	"github.com/golangci/golangci-api/pkg/worker/lib/github"
+)
 
 const runQueueID = "analyzes/pull/run"
 
@@ -10,6 +13,7 @@ type RunMessage struct {
 	UserID       uint
 	AnalysisGUID string
 	CommitSHA    string
+	TraceContext tracing.Carrier `json:",omitempty"`
 }
 
 func (m RunMessage) LockID() string {
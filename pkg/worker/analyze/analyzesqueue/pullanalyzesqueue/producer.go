@@ -1,18 +1,59 @@
 package pullanalyzesqueue
 
 import (
-	"github.com/golangci/golangci-api/internal/shared/queue"
+	"encoding/json"
+
 	"github.com/golangci/golangci-api/internal/shared/queue/producers"
+	"github.com/golangci/golangci-api/pkg/worker/analyze/analyzesqueue"
+	"github.com/golangci/golangci-api/pkg/worker/analyze/analyzesqueue/scheduler"
+	"github.com/pkg/errors"
 )
 
 type Producer struct {
 	producers.Base
+	scheduler *scheduler.Scheduler
 }
 
 func (p *Producer) Register(m *producers.Multiplexer) error {
 	return p.Base.Register(m, runQueueID)
 }
 
-func (p Producer) Put(m queue.Message) error {
-	return p.Base.Put(m)
+// SetScheduler makes the producer schedule analyzes instead of putting them directly into the queue
+func (p *Producer) SetScheduler(s *scheduler.Scheduler) {
+	p.scheduler = s
+	s.RegisterQueue(runQueueID, &p.Base)
+}
+
+func (p Producer) Put(m *RunMessage) error {
+	return p.put(m, true)
+}
+
+// PutRestarted puts the rerun of an older analysis of the pull request: unlike Put
+// it doesn't make the analysis the latest one and doesn't cancel newer queued analyzes
+func (p Producer) PutRestarted(m *RunMessage) error {
+	return p.put(m, false)
+}
+
+func (p Producer) put(m *RunMessage, isLatest bool) error {
+	if p.scheduler == nil {
+		return p.Base.Put(m)
+	}
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal message")
+	}
+
+	t := scheduler.Task{
+		ID:      m.AnalysisGUID,
+		Org:     scheduler.OrgKey(analyzesqueue.Provider, m.Repo.Owner),
+		Repo:    scheduler.RepoKey(analyzesqueue.Provider, m.Repo.FullName()),
+		QueueID: runQueueID,
+		Message: data,
+	}
+	if isLatest {
+		t.PullKey = scheduler.PullKey(analyzesqueue.Provider, m.Repo.FullName(), m.PullRequestNumber)
+	}
+
+	return p.scheduler.Schedule(&t)
 }
@@ -8,6 +8,9 @@ type runMessage struct {
 	Branch             string
 	PrivateAccessToken string
 	CommitSHA          string
+
+	// GithubInstallationID is exchanged for the access token by the consumer
+	GithubInstallationID int `json:",omitempty"`
 }
 
 func (m runMessage) LockID() string {
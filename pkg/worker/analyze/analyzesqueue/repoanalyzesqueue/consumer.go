@@ -3,14 +3,20 @@ package repoanalyzesqueue
 import (
 	"context"
 
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/providers/githubapp"
 	"github.com/golangci/golangci-api/internal/shared/queue/consumers"
 	analyzesConsumers "github.com/golangci/golangci-api/pkg/worker/analyze/analyzequeue/consumers"
 	"github.com/golangci/golangci-api/pkg/worker/analyze/analyzesqueue"
+	"github.com/golangci/golangci-api/pkg/worker/analyze/analyzesqueue/scheduler"
 	redsync "gopkg.in/redsync.v1"
 )
 
 type Consumer struct {
 	subConsumer *analyzesConsumers.AnalyzeRepo
+	scheduler   *scheduler.Scheduler
+	log         logutil.Log
+	githubApp   *githubapp.App
 }
 
 func NewConsumer(subConsumer *analyzesConsumers.AnalyzeRepo) *Consumer {
@@ -19,10 +25,42 @@ func NewConsumer(subConsumer *analyzesConsumers.AnalyzeRepo) *Consumer {
 	}
 }
 
+// SetScheduler makes the consumer release scheduler concurrency slots after running analyzes
+func (c *Consumer) SetScheduler(s *scheduler.Scheduler, log logutil.Log) {
+	c.scheduler = s
+	c.log = log
+}
+
+// SetGithubApp makes the consumer able to run analyzes of repos connected through GitHub App
+func (c *Consumer) SetGithubApp(app *githubapp.App) {
+	c.githubApp = app
+}
+
 func (c Consumer) Register(m *consumers.Multiplexer, df *redsync.Redsync) error {
 	return analyzesqueue.RegisterConsumer(c.consumeMessage, runQueueID, m, df)
 }
 
-func (c Consumer) consumeMessage(ctx context.Context, m *runMessage) error {
+func (c Consumer) consumeMessage(ctx context.Context, m *runMessage) (err error) {
+	if c.scheduler != nil {
+		defer func() {
+			if !analyzesqueue.IsHandled(err) {
+				return // keep slots while the message waits for a retry, they expire otherwise
+			}
+			org := scheduler.OrgKey(analyzesqueue.Provider, repoOwner(m.RepoName))
+			repo := scheduler.RepoKey(analyzesqueue.Provider, m.RepoName)
+			if releaseErr := c.scheduler.Release(m.AnalysisGUID, org, repo); releaseErr != nil {
+				c.log.Warnf("Failed to release scheduler slots of analysis %s: %s", m.AnalysisGUID, releaseErr)
+			}
+		}()
+	}
+
+	if m.GithubInstallationID != 0 {
+		token, tokenErr := analyzesqueue.GetInstallationToken(ctx, c.githubApp, m.GithubInstallationID)
+		if tokenErr != nil {
+			return tokenErr
+		}
+		m.PrivateAccessToken = token
+	}
+
 	return c.subConsumer.Consume(ctx, m.RepoName, m.AnalysisGUID, m.Branch, m.PrivateAccessToken, m.CommitSHA)
 }
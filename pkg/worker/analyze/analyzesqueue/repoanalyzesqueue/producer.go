@@ -1,19 +1,32 @@
 package repoanalyzesqueue
 
 import (
+	"encoding/json"
+	"strings"
+
 	"github.com/golangci/golangci-api/internal/shared/queue/producers"
+	"github.com/golangci/golangci-api/pkg/worker/analyze/analyzesqueue"
+	"github.com/golangci/golangci-api/pkg/worker/analyze/analyzesqueue/scheduler"
+	"github.com/pkg/errors"
 )
 
 type Producer struct {
 	producers.Base
+	scheduler *scheduler.Scheduler
 }
 
 func (p *Producer) Register(m *producers.Multiplexer) error {
 	return p.Base.Register(m, runQueueID)
 }
 
+// SetScheduler makes the producer schedule analyzes instead of putting them directly into the queue
+func (p *Producer) SetScheduler(s *scheduler.Scheduler) {
+	p.scheduler = s
+	s.RegisterQueue(runQueueID, &p.Base)
+}
+
 func (p Producer) Put(repoName, analysisGUID, branch, privateAccessToken, commitSHA string) error {
-	return p.Base.Put(runMessage{
+	return p.put(runMessage{
 		RepoName:           repoName,
 		AnalysisGUID:       analysisGUID,
 		Branch:             branch,
@@ -21,3 +34,37 @@ func (p Producer) Put(repoName, analysisGUID, branch, privateAccessToken, commit
 		CommitSHA:          commitSHA,
 	})
 }
+
+// PutForInstallation enqueues analysis of the repo connected through GitHub App installation
+func (p Producer) PutForInstallation(repoName, analysisGUID, branch string, installationID int, commitSHA string) error {
+	return p.put(runMessage{
+		RepoName:             repoName,
+		AnalysisGUID:         analysisGUID,
+		Branch:               branch,
+		CommitSHA:            commitSHA,
+		GithubInstallationID: installationID,
+	})
+}
+
+func (p Producer) put(m runMessage) error {
+	if p.scheduler == nil {
+		return p.Base.Put(m)
+	}
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal message")
+	}
+
+	return p.scheduler.Schedule(&scheduler.Task{
+		ID:      m.AnalysisGUID,
+		Org:     scheduler.OrgKey(analyzesqueue.Provider, repoOwner(m.RepoName)),
+		Repo:    scheduler.RepoKey(analyzesqueue.Provider, m.RepoName),
+		QueueID: runQueueID,
+		Message: data,
+	})
+}
+
+func repoOwner(repoName string) string {
+	return strings.Split(repoName, "/")[0]
+}
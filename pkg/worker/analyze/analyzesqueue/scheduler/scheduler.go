@@ -0,0 +1,402 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/queue/producers"
+	"github.com/pkg/errors"
+)
+
+// Scheduler is a layer between analyzes producers and the analyzes queue:
+// producers schedule tasks into the per-org queues in redis and the dispatcher
+// moves them into the analyzes queue respecting per-org and per-repo concurrency limits
+// and round-robin across orgs. Workers release concurrency slots after running a task.
+type Scheduler struct {
+	pool        *redis.Pool
+	log         logutil.Log
+	slotTimeout time.Duration
+	queues      map[string]producers.Queue
+}
+
+// Task is an analysis waiting in the scheduler for a free concurrency slot
+type Task struct {
+	ID      string // analysis guid
+	Org     string // e.g. github.com/golangci
+	Repo    string // e.g. github.com/golangci/golangci-api
+	PullKey string // only for pull analyzes: a newer task with the same key cancels the queued one
+
+	QueueID     string
+	Message     json.RawMessage
+	ScheduledAt time.Time
+}
+
+// Limits are the maximum numbers of concurrently running analyzes
+type Limits struct {
+	Org  int
+	Repo int
+}
+
+type LimitsProvider interface {
+	GetLimits(org string) (*Limits, error)
+}
+
+// CancelFunc is called for the queued pull analysis task which was superseded by a newer one
+type CancelFunc func(t *Task)
+
+// DefaultSlotTimeout is the time after which a concurrency slot of a not released task expires:
+// the task could wait in the analyzes queue and run up to analyzesqueue.ConsumerTimeout
+const DefaultSlotTimeout = 30 * time.Minute
+
+const (
+	keyPrefix      = "scheduler/"
+	orgsKey        = keyPrefix + "orgs"
+	pullKeyTTLSec  = 7 * 24 * 3600
+	maxScanPerOrg  = 20
+	maxDispatchRun = 1000
+)
+
+func orgTasksKey(org string) string {
+	return keyPrefix + "tasks/" + org
+}
+
+func pullKey(key string) string {
+	return keyPrefix + "pulls/" + key
+}
+
+func runningOrgKey(org string) string {
+	return keyPrefix + "running/org/" + org
+}
+
+func runningRepoKey(repo string) string {
+	return keyPrefix + "running/repo/" + repo
+}
+
+// Org and repo lists must be consistent: the org is in the orgs list iff it has scheduled tasks.
+var scheduleScript = redis.NewScript(2, `
+if redis.call("RPUSH", KEYS[1], ARGV[1]) == 1 then
+	redis.call("LPUSH", KEYS[2], ARGV[2])
+end
+return 1
+`)
+
+var removeScript = redis.NewScript(2, `
+local n = redis.call("LREM", KEYS[1], 1, ARGV[1])
+if n == 1 and redis.call("LLEN", KEYS[1]) == 0 then
+	redis.call("LREM", KEYS[2], 0, ARGV[2])
+end
+return n
+`)
+
+func NewScheduler(pool *redis.Pool, log logutil.Log, slotTimeout time.Duration) *Scheduler {
+	return &Scheduler{
+		pool:        pool,
+		log:         log,
+		slotTimeout: slotTimeout,
+		queues:      map[string]producers.Queue{},
+	}
+}
+
+// RegisterQueue registers the queue to dispatch tasks with the queueID into
+func (s *Scheduler) RegisterQueue(queueID string, q producers.Queue) {
+	s.queues[queueID] = q
+}
+
+// OrgKey builds the org identifier for tasks, e.g. github.com/golangci
+func OrgKey(provider, owner string) string {
+	return strings.ToLower(fmt.Sprintf("%s/%s", provider, owner))
+}
+
+// RepoKey builds the repo identifier for tasks, e.g. github.com/golangci/golangci-api
+func RepoKey(provider, fullName string) string {
+	return strings.ToLower(fmt.Sprintf("%s/%s", provider, fullName))
+}
+
+// PullKey builds the pull request identifier for tasks
+func PullKey(provider, fullName string, pullRequestNumber int) string {
+	return fmt.Sprintf("%s#%d", RepoKey(provider, fullName), pullRequestNumber)
+}
+
+func (s Scheduler) Schedule(t *Task) error {
+	if s.queues[t.QueueID] == nil {
+		return fmt.Errorf("no registered queue %s", t.QueueID)
+	}
+
+	t.ScheduledAt = time.Now()
+	data, err := json.Marshal(t)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal task")
+	}
+
+	conn := s.pool.Get()
+	defer conn.Close()
+
+	if t.PullKey != "" {
+		// dispatcher cancels all queued tasks of the pull request except the latest one
+		if _, err = conn.Do("SET", pullKey(t.PullKey), t.ID, "EX", pullKeyTTLSec); err != nil {
+			return errors.Wrapf(err, "failed to set latest task of pull request %s", t.PullKey)
+		}
+	}
+
+	if _, err = scheduleScript.Do(conn, orgTasksKey(t.Org), orgsKey, string(data), t.Org); err != nil {
+		return errors.Wrapf(err, "failed to schedule task %s", t.ID)
+	}
+
+	s.log.Infof("Scheduled task %s of %s to queue %s", t.ID, t.Repo, t.QueueID)
+	return nil
+}
+
+// Release frees concurrency slots of the task, it's safe to call it for a not dispatched task
+func (s Scheduler) Release(id, org, repo string) error {
+	conn := s.pool.Get()
+	defer conn.Close()
+
+	if err := conn.Send("MULTI"); err != nil {
+		return errors.Wrap(err, "failed to start transaction")
+	}
+	if err := conn.Send("ZREM", runningOrgKey(org), id); err != nil {
+		return errors.Wrap(err, "failed to release org slot")
+	}
+	if err := conn.Send("ZREM", runningRepoKey(repo), id); err != nil {
+		return errors.Wrap(err, "failed to release repo slot")
+	}
+	if _, err := conn.Do("EXEC"); err != nil {
+		return errors.Wrapf(err, "failed to release slots of task %s", id)
+	}
+
+	return nil
+}
+
+func (s Scheduler) countRunning(conn redis.Conn, key string) (int, error) {
+	if _, err := conn.Do("ZREMRANGEBYSCORE", key, "-inf", time.Now().Unix()); err != nil {
+		return 0, errors.Wrapf(err, "failed to remove expired slots from %s", key)
+	}
+
+	n, err := redis.Int(conn.Do("ZCARD", key))
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to count slots in %s", key)
+	}
+
+	return n, nil
+}
+
+type dispatchRun struct {
+	conn     redis.Conn
+	lp       LimitsProvider
+	onCancel CancelFunc
+	limits   map[string]*Limits
+}
+
+func (s Scheduler) getLimits(dr *dispatchRun, org string) (*Limits, error) {
+	if l := dr.limits[org]; l != nil {
+		return l, nil
+	}
+
+	l, err := dr.lp.GetLimits(org)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get limits for org %s", org)
+	}
+
+	dr.limits[org] = l
+	return l, nil
+}
+
+func (s Scheduler) isSuperseded(dr *dispatchRun, t *Task) (bool, error) {
+	if t.PullKey == "" {
+		return false, nil
+	}
+
+	latestID, err := redis.String(dr.conn.Do("GET", pullKey(t.PullKey)))
+	if err == redis.ErrNil {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get latest task of pull request %s", t.PullKey)
+	}
+
+	return latestID != t.ID, nil
+}
+
+func (s Scheduler) removeTask(dr *dispatchRun, org, data string) (bool, error) {
+	n, err := redis.Int(removeScript.Do(dr.conn, orgTasksKey(org), orgsKey, data, org))
+	if err != nil {
+		return false, errors.Wrap(err, "failed to remove task")
+	}
+
+	return n == 1, nil
+}
+
+func (s Scheduler) takeSlots(dr *dispatchRun, t *Task) error {
+	expireAt := time.Now().Add(s.slotTimeout).Unix()
+	if _, err := dr.conn.Do("ZADD", runningOrgKey(t.Org), expireAt, t.ID); err != nil {
+		return errors.Wrap(err, "failed to take org slot")
+	}
+	if _, err := dr.conn.Do("ZADD", runningRepoKey(t.Repo), expireAt, t.ID); err != nil {
+		return errors.Wrap(err, "failed to take repo slot")
+	}
+
+	return nil
+}
+
+func (s Scheduler) dispatch(dr *dispatchRun, t *Task, data string) error {
+	q := s.queues[t.QueueID]
+	if q == nil {
+		return fmt.Errorf("no registered queue %s for task %s", t.QueueID, t.ID)
+	}
+
+	removed, err := s.removeTask(dr, t.Org, data)
+	if err != nil {
+		return err
+	}
+	if !removed { // was removed in parallel
+		return nil
+	}
+
+	if err = s.takeSlots(dr, t); err != nil {
+		s.log.Warnf("Failed to take slots for task %s, dispatch it anyway: %s", t.ID, err)
+	}
+
+	if err = q.Put(rawMessage{id: t.ID, body: t.Message}); err != nil {
+		if releaseErr := s.Release(t.ID, t.Org, t.Repo); releaseErr != nil {
+			s.log.Warnf("Failed to release slots of task %s: %s", t.ID, releaseErr)
+		}
+		if _, scheduleErr := scheduleScript.Do(dr.conn, orgTasksKey(t.Org), orgsKey, data, t.Org); scheduleErr != nil {
+			s.log.Errorf("Failed to return task %s back to scheduler, it's lost: %s", t.ID, scheduleErr)
+		}
+		return errors.Wrapf(err, "failed to put task %s into queue %s", t.ID, t.QueueID)
+	}
+
+	s.log.Infof("Dispatched task %s of %s to queue %s after %s in scheduler",
+		t.ID, t.Repo, t.QueueID, time.Since(t.ScheduledAt))
+	return nil
+}
+
+// dispatchOrg dispatches at most one task of the org, it returns true if the org queue has changed
+func (s Scheduler) dispatchOrg(dr *dispatchRun, org string) (bool, error) {
+	limits, err := s.getLimits(dr, org)
+	if err != nil {
+		return false, err
+	}
+
+	runningInOrg, err := s.countRunning(dr.conn, runningOrgKey(org))
+	if err != nil {
+		return false, err
+	}
+
+	tasks, err := redis.Strings(dr.conn.Do("LRANGE", orgTasksKey(org), 0, maxScanPerOrg-1))
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to fetch tasks of org %s", org)
+	}
+
+	for _, data := range tasks {
+		var t Task
+		if err = json.Unmarshal([]byte(data), &t); err != nil {
+			s.log.Warnf("Failed to unmarshal task %s, drop it: %s", data, err)
+			_, err = s.removeTask(dr, org, data)
+			return true, err
+		}
+
+		superseded, err := s.isSuperseded(dr, &t)
+		if err != nil {
+			return false, err
+		}
+		if superseded {
+			removed, err := s.removeTask(dr, org, data)
+			if err != nil {
+				return false, err
+			}
+			if removed {
+				s.log.Infof("Canceled task %s of %s: superseded by a newer task", t.ID, t.PullKey)
+				if dr.onCancel != nil {
+					dr.onCancel(&t)
+				}
+			}
+			return true, nil
+		}
+
+		if runningInOrg >= limits.Org {
+			return false, nil
+		}
+
+		runningInRepo, err := s.countRunning(dr.conn, runningRepoKey(t.Repo))
+		if err != nil {
+			return false, err
+		}
+		if runningInRepo >= limits.Repo {
+			continue // give a chance to other repos of the org
+		}
+
+		return true, s.dispatch(dr, &t, data)
+	}
+
+	return false, nil
+}
+
+// DispatchOnce dispatches all tasks it can: one task per org in a round,
+// rounds are repeated while tasks are dispatched or canceled. It returns the count of such tasks.
+// It must not be called concurrently.
+func (s Scheduler) DispatchOnce(lp LimitsProvider, onCancel CancelFunc) (int, error) {
+	conn := s.pool.Get()
+	defer conn.Close()
+
+	dr := dispatchRun{
+		conn:     conn,
+		lp:       lp,
+		onCancel: onCancel,
+		limits:   map[string]*Limits{},
+	}
+
+	processed := 0
+	for processed < maxDispatchRun {
+		orgsCount, err := redis.Int(conn.Do("LLEN", orgsKey))
+		if err != nil {
+			return processed, errors.Wrap(err, "failed to count orgs")
+		}
+
+		changed := false
+		for i := 0; i < orgsCount; i++ {
+			// rotate the orgs list to dispatch tasks of different orgs in turn
+			org, err := redis.String(conn.Do("RPOPLPUSH", orgsKey, orgsKey))
+			if err == redis.ErrNil {
+				break
+			}
+			if err != nil {
+				return processed, errors.Wrap(err, "failed to rotate orgs")
+			}
+
+			orgChanged, err := s.dispatchOrg(&dr, org)
+			if err != nil {
+				s.log.Warnf("Failed to dispatch tasks of org %s: %s", org, err)
+				continue
+			}
+			if orgChanged {
+				changed = true
+				processed++
+			}
+		}
+
+		if !changed {
+			break
+		}
+	}
+
+	return processed, nil
+}
+
+// rawMessage is an already marshaled queue message
+type rawMessage struct {
+	id   string
+	body json.RawMessage
+}
+
+func (m rawMessage) LockID() string {
+	return m.id
+}
+
+func (m rawMessage) MarshalJSON() ([]byte, error) {
+	return m.body, nil
+}
@@ -0,0 +1,224 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/garyburd/redigo/redis"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/queue"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testQueueID = "analyzes/test"
+
+type fakeQueue struct {
+	ids []string
+	err error
+}
+
+func (q *fakeQueue) Put(m queue.Message) error {
+	if q.err != nil {
+		return q.err
+	}
+
+	q.ids = append(q.ids, m.LockID())
+	return nil
+}
+
+type staticLimits Limits
+
+func (l staticLimits) GetLimits(org string) (*Limits, error) {
+	limits := Limits(l)
+	return &limits, nil
+}
+
+func newTestScheduler(t *testing.T, slotTimeout time.Duration) (*Scheduler, *fakeQueue, func()) {
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+
+	pool := &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", mr.Addr())
+		},
+	}
+
+	q := &fakeQueue{}
+	s := NewScheduler(pool, logutil.NewStderrLog("test"), slotTimeout)
+	s.RegisterQueue(testQueueID, q)
+
+	return s, q, func() {
+		pool.Close()
+		mr.Close()
+	}
+}
+
+func makeTask(id, owner, repo string) *Task {
+	return &Task{
+		ID:      id,
+		Org:     OrgKey("github.com", owner),
+		Repo:    RepoKey("github.com", fmt.Sprintf("%s/%s", owner, repo)),
+		QueueID: testQueueID,
+		Message: json.RawMessage(fmt.Sprintf(`{"ID":%q}`, id)),
+	}
+}
+
+func makePullTask(id, owner, repo string, pullRequestNumber int) *Task {
+	t := makeTask(id, owner, repo)
+	t.PullKey = PullKey("github.com", fmt.Sprintf("%s/%s", owner, repo), pullRequestNumber)
+	return t
+}
+
+func TestScheduleToUnknownQueue(t *testing.T) {
+	s, _, cleanup := newTestScheduler(t, DefaultSlotTimeout)
+	defer cleanup()
+
+	task := makeTask("1", "golangci", "golangci-api")
+	task.QueueID = "unknown"
+	assert.Error(t, s.Schedule(task))
+}
+
+func TestDispatchRespectsLimits(t *testing.T) {
+	s, q, cleanup := newTestScheduler(t, DefaultSlotTimeout)
+	defer cleanup()
+
+	for _, task := range []*Task{
+		makeTask("1", "golangci", "golangci-api"),
+		makeTask("2", "golangci", "golangci-api"),
+		makeTask("3", "golangci", "golangci-lint"),
+		makeTask("4", "golangci", "golangci-web"),
+	} {
+		require.NoError(t, s.Schedule(task))
+	}
+
+	n, err := s.DispatchOnce(staticLimits{Org: 2, Repo: 1}, nil)
+	require.NoError(t, err)
+
+	// the second task of golangci-api waits for the repo slot, golangci-web waits for the org slot
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []string{"1", "3"}, q.ids)
+
+	n, err = s.DispatchOnce(staticLimits{Org: 2, Repo: 1}, nil)
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
+
+func TestDispatchRoundRobinsOrgs(t *testing.T) {
+	s, q, cleanup := newTestScheduler(t, DefaultSlotTimeout)
+	defer cleanup()
+
+	for _, task := range []*Task{
+		makeTask("a1", "a", "r1"),
+		makeTask("a2", "a", "r2"),
+		makeTask("a3", "a", "r3"),
+		makeTask("b1", "b", "r1"),
+	} {
+		require.NoError(t, s.Schedule(task))
+	}
+
+	n, err := s.DispatchOnce(staticLimits{Org: 1, Repo: 1}, nil)
+	require.NoError(t, err)
+
+	// org b isn't starved by the earlier tasks of org a
+	assert.Equal(t, 2, n)
+	assert.ElementsMatch(t, []string{"a1", "b1"}, q.ids)
+}
+
+func TestReleaseFreesSlots(t *testing.T) {
+	s, q, cleanup := newTestScheduler(t, DefaultSlotTimeout)
+	defer cleanup()
+
+	first, second := makeTask("1", "golangci", "golangci-api"), makeTask("2", "golangci", "golangci-api")
+	require.NoError(t, s.Schedule(first))
+	require.NoError(t, s.Schedule(second))
+
+	limits := staticLimits{Org: 1, Repo: 1}
+	_, err := s.DispatchOnce(limits, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, q.ids)
+
+	require.NoError(t, s.Release(first.ID, first.Org, first.Repo))
+	// releasing twice or releasing a not dispatched task is safe
+	require.NoError(t, s.Release(first.ID, first.Org, first.Repo))
+
+	n, err := s.DispatchOnce(limits, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []string{"1", "2"}, q.ids)
+}
+
+func TestExpiredSlotsAreFreed(t *testing.T) {
+	// slots of not released tasks expire immediately
+	s, q, cleanup := newTestScheduler(t, -time.Minute)
+	defer cleanup()
+
+	require.NoError(t, s.Schedule(makeTask("1", "golangci", "golangci-api")))
+	require.NoError(t, s.Schedule(makeTask("2", "golangci", "golangci-api")))
+
+	n, err := s.DispatchOnce(staticLimits{Org: 1, Repo: 1}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []string{"1", "2"}, q.ids)
+}
+
+func TestSupersededPullTaskIsCanceled(t *testing.T) {
+	s, q, cleanup := newTestScheduler(t, DefaultSlotTimeout)
+	defer cleanup()
+
+	require.NoError(t, s.Schedule(makePullTask("old", "golangci", "golangci-api", 1)))
+	require.NoError(t, s.Schedule(makePullTask("other", "golangci", "golangci-api", 2)))
+	require.NoError(t, s.Schedule(makePullTask("new", "golangci", "golangci-api", 1)))
+
+	var canceled []string
+	n, err := s.DispatchOnce(staticLimits{Org: 3, Repo: 3}, func(t *Task) {
+		canceled = append(canceled, t.ID)
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, n)
+	assert.Equal(t, []string{"old"}, canceled)
+	assert.Equal(t, []string{"other", "new"}, q.ids)
+}
+
+func TestTaskWithoutPullKeyIsNotCanceled(t *testing.T) {
+	s, q, cleanup := newTestScheduler(t, DefaultSlotTimeout)
+	defer cleanup()
+
+	// a restarted older analysis doesn't become the latest one of the pull request
+	require.NoError(t, s.Schedule(makePullTask("new", "golangci", "golangci-api", 1)))
+	require.NoError(t, s.Schedule(makeTask("restarted", "golangci", "golangci-api")))
+
+	var canceled []string
+	_, err := s.DispatchOnce(staticLimits{Org: 2, Repo: 2}, func(t *Task) {
+		canceled = append(canceled, t.ID)
+	})
+	require.NoError(t, err)
+
+	assert.Empty(t, canceled)
+	assert.Equal(t, []string{"new", "restarted"}, q.ids)
+}
+
+func TestFailedPutReturnsTaskBack(t *testing.T) {
+	s, q, cleanup := newTestScheduler(t, DefaultSlotTimeout)
+	defer cleanup()
+
+	require.NoError(t, s.Schedule(makeTask("1", "golangci", "golangci-api")))
+
+	limits := staticLimits{Org: 1, Repo: 1}
+	q.err = errors.New("sqs is down")
+	_, err := s.DispatchOnce(limits, nil)
+	require.NoError(t, err)
+	assert.Empty(t, q.ids)
+
+	// the slots were released and the task is dispatched after the queue recovers
+	q.err = nil
+	n, err := s.DispatchOnce(limits, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, []string{"1"}, q.ids)
+}
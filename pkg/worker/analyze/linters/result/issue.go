@@ -1,6 +1,12 @@
 package result
 
-import golangciLintResult "github.com/golangci/golangci-lint/pkg/result"
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"strings"
+
+	golangciLintResult "github.com/golangci/golangci-lint/pkg/result"
+)
 
 type Issue struct {
 	FromLinter string
@@ -22,3 +28,14 @@ func NewIssue(fromLinter, text, file string, lineNumber, hunkPos int) Issue {
 		HunkPos:    hunkPos,
 	}
 }
+
+// Fingerprint identifies the issue regardless of its position in the file:
+// the API suppresses issues by it, the same value is calculated from the issues JSON there
+func Fingerprint(fromLinter, file, text string) string {
+	h := md5.Sum([]byte(strings.Join([]string{fromLinter, file, text}, "|")))
+	return hex.EncodeToString(h[:])
+}
+
+func (i Issue) Fingerprint() string {
+	return Fingerprint(i.FromLinter, i.File, i.Text)
+}
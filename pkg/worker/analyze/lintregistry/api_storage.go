@@ -0,0 +1,48 @@
+package lintregistry
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/golangci/golangci-api/pkg/worker/lib/httputils"
+	"github.com/pkg/errors"
+)
+
+// Storage returns golangci-lint versions registry json for the repo owner:
+// the default version depends on the owner org channel.
+type Storage interface {
+	Get(ctx context.Context, provider, owner string) (string, error)
+}
+
+type APIStorage struct {
+	host   string
+	client httputils.Client
+}
+
+var _ Storage = &APIStorage{}
+
+func NewAPIStorage(client httputils.Client) *APIStorage {
+	return &APIStorage{
+		client: client,
+		host:   os.Getenv("API_URL"),
+	}
+}
+
+func (s APIStorage) Get(ctx context.Context, provider, owner string) (string, error) {
+	url := fmt.Sprintf("%s/v1/golangci-lint/registry/%s/%s", s.host, provider, owner)
+	bodyReader, err := s.client.Get(ctx, url)
+	if err != nil {
+		return "", err
+	}
+
+	defer bodyReader.Close()
+
+	body, err := ioutil.ReadAll(bodyReader)
+	if err != nil {
+		return "", errors.Wrap(err, "can't read body")
+	}
+
+	return string(body), nil
+}
@@ -0,0 +1,196 @@
+package monorepo
+
+import (
+	"encoding/json"
+	"path"
+	"strings"
+
+	"github.com/golangci/golangci-api/pkg/worker/analyze/linters/result"
+	"github.com/golangci/golangci-lint/pkg/printers"
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/pkg/errors"
+)
+
+// ModuleOf returns the most nested module containing the file or "" if there is no such module
+func ModuleOf(modules []string, file string) string {
+	ret := ""
+	retDepth := -1
+	for _, m := range modules {
+		if m != "." && file != m && !strings.HasPrefix(file, m+"/") {
+			continue
+		}
+
+		depth := 0
+		if m != "." {
+			depth = strings.Count(m, "/") + 1
+		}
+		if depth > retDepth {
+			ret = m
+			retDepth = depth
+		}
+	}
+
+	return ret
+}
+
+func relToModule(module, file string) string {
+	if module == "." {
+		return file
+	}
+
+	return strings.TrimPrefix(file, module+"/")
+}
+
+type filePatch struct {
+	file  string
+	lines []string
+}
+
+func splitPatchByFiles(patch string) []filePatch {
+	var ret []filePatch
+	for _, line := range strings.SplitAfter(patch, "\n") {
+		if strings.HasPrefix(line, "diff --git ") {
+			ret = append(ret, filePatch{file: parseDiffGitFile(line)})
+		}
+		if len(ret) == 0 || line == "" {
+			continue // preamble
+		}
+
+		cur := &ret[len(ret)-1]
+		cur.lines = append(cur.lines, line)
+	}
+
+	return ret
+}
+
+// parseDiffGitFile parses the new file path from the line "diff --git a/x b/x"
+func parseDiffGitFile(line string) string {
+	line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+	if i := strings.LastIndex(line, " b/"); i != -1 {
+		return line[i+len(" b/"):]
+	}
+
+	return ""
+}
+
+func rewritePatchLine(line, module string) string {
+	if module == "." {
+		return line
+	}
+
+	prefix := module + "/"
+	if strings.HasPrefix(line, "diff --git ") {
+		rest := strings.TrimPrefix(line, "diff --git ")
+		if i := strings.Index(rest, " b/"+prefix); i != -1 {
+			rest = strings.TrimPrefix(rest[:i], "a/"+prefix) + " b/" + rest[i+len(" b/"+prefix):]
+			return "diff --git a/" + rest
+		}
+		return line
+	}
+
+	for _, header := range []string{"--- a/", "+++ b/", "rename from ", "rename to ", "copy from ", "copy to "} {
+		if strings.HasPrefix(line, header+prefix) {
+			return header + strings.TrimPrefix(line, header+prefix)
+		}
+	}
+
+	return line
+}
+
+// SplitPatch splits the patch of the whole repo into patches per module with
+// module-relative paths. Only modules touched by the patch are returned.
+func SplitPatch(patch string, modules []string) map[string]string {
+	builders := map[string]*strings.Builder{}
+	for _, fp := range splitPatchByFiles(patch) {
+		module := ModuleOf(modules, fp.file)
+		if module == "" {
+			continue
+		}
+
+		b := builders[module]
+		if b == nil {
+			b = &strings.Builder{}
+			builders[module] = b
+		}
+		for _, line := range fp.lines {
+			b.WriteString(rewritePatchLine(line, module))
+		}
+	}
+
+	ret := map[string]string{}
+	for module, b := range builders {
+		ret[module] = b.String()
+	}
+	return ret
+}
+
+// MergeResults merges results of modules analysis and makes file paths relative to the repo root
+func MergeResults(modules []string, results map[string]*result.Result) (*result.Result, error) {
+	ret := &result.Result{}
+	var mergedJSON printers.JSONResult
+	var reportErrors []string
+	seenLinters := map[string]bool{}
+
+	for _, module := range modules {
+		res := results[module]
+		if res == nil {
+			continue
+		}
+
+		for _, issue := range res.Issues {
+			issue.File = path.Join(module, issue.File)
+			ret.Issues = append(ret.Issues, issue)
+		}
+		if res.MaxIssuesPerFile > ret.MaxIssuesPerFile {
+			ret.MaxIssuesPerFile = res.MaxIssuesPerFile
+		}
+
+		if res.ResultJSON == nil {
+			continue
+		}
+
+		rawJSON, err := json.Marshal(res.ResultJSON)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal result json of module %s", module)
+		}
+
+		var moduleJSON printers.JSONResult
+		if err = json.Unmarshal(rawJSON, &moduleJSON); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal result json of module %s", module)
+		}
+
+		for _, issue := range moduleJSON.Issues {
+			issue.Pos.Filename = path.Join(module, issue.Pos.Filename)
+			mergedJSON.Issues = append(mergedJSON.Issues, issue)
+		}
+
+		if moduleJSON.Report == nil {
+			continue
+		}
+		if mergedJSON.Report == nil {
+			mergedJSON.Report = &report.Data{}
+		}
+		mergedJSON.Report.Warnings = append(mergedJSON.Report.Warnings, moduleJSON.Report.Warnings...)
+		for _, ld := range moduleJSON.Report.Linters {
+			if !seenLinters[ld.Name] {
+				seenLinters[ld.Name] = true
+				mergedJSON.Report.Linters = append(mergedJSON.Report.Linters, ld)
+			}
+		}
+		if moduleJSON.Report.Error != "" {
+			reportErrors = append(reportErrors, module+": "+moduleJSON.Report.Error)
+		}
+	}
+
+	if len(reportErrors) != 0 {
+		mergedJSON.Report.Error = strings.Join(reportErrors, "; ")
+	}
+
+	rawJSON, err := json.Marshal(mergedJSON)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal merged result json")
+	}
+	ret.ResultJSON = json.RawMessage(rawJSON)
+
+	return ret, nil
+}
@@ -0,0 +1,81 @@
+package monorepo
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/golangci/golangci-api/pkg/worker/analyze/linters/result"
+	"github.com/golangci/golangci-lint/pkg/printers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModuleOf(t *testing.T) {
+	modules := []string{".", "a", "a/b", "c"}
+	assert.Equal(t, "a/b", ModuleOf(modules, "a/b/x.go"))
+	assert.Equal(t, "a", ModuleOf(modules, "a/bb/x.go"))
+	assert.Equal(t, ".", ModuleOf(modules, "d/x.go"))
+	assert.Equal(t, "", ModuleOf([]string{"a"}, "d/x.go"))
+}
+
+const testPatch = `From 1 Mon Sep 17 00:00:00 2001
+Subject: [PATCH] test
+
+diff --git a/a/b/x.go b/a/b/x.go
+index 1..2 100644
+--- a/a/b/x.go
++++ b/a/b/x.go
+@@ -1 +1,2 @@
+ package x
++var data = 1
+diff --git a/main.go b/main.go
+index 1..2 100644
+--- a/main.go
++++ b/main.go
+@@ -1 +1,2 @@
+ package main
++var a = 1
+`
+
+func TestSplitPatch(t *testing.T) {
+	patches := SplitPatch(testPatch, []string{".", "a/b", "c"})
+	assert.Len(t, patches, 2)
+	assert.Equal(t, `diff --git a/x.go b/x.go
+index 1..2 100644
+--- a/x.go
++++ b/x.go
+@@ -1 +1,2 @@
+ package x
++var data = 1
+`, patches["a/b"])
+	assert.Equal(t, `diff --git a/main.go b/main.go
+index 1..2 100644
+--- a/main.go
++++ b/main.go
+@@ -1 +1,2 @@
+ package main
++var a = 1
+`, patches["."])
+}
+
+func TestMergeResults(t *testing.T) {
+	results := map[string]*result.Result{
+		".": {
+			Issues:     []result.Issue{{File: "main.go", LineNumber: 1}},
+			ResultJSON: json.RawMessage(`{"Issues":[{"Pos":{"Filename":"main.go","Line":1}}],"Report":{"Linters":[{"Name":"govet"}]}}`),
+		},
+		"a/b": {
+			Issues:     []result.Issue{{File: "x.go", LineNumber: 2}},
+			ResultJSON: json.RawMessage(`{"Issues":[{"Pos":{"Filename":"x.go","Line":2}}],"Report":{"Linters":[{"Name":"govet"}]}}`),
+		},
+	}
+
+	res, err := MergeResults([]string{".", "a/b", "c"}, results)
+	assert.NoError(t, err)
+	assert.Equal(t, []result.Issue{{File: "main.go", LineNumber: 1}, {File: "a/b/x.go", LineNumber: 2}}, res.Issues)
+
+	var merged printers.JSONResult
+	assert.NoError(t, json.Unmarshal(res.ResultJSON.(json.RawMessage), &merged))
+	assert.Len(t, merged.Issues, 2)
+	assert.Equal(t, "a/b/x.go", merged.Issues[1].Pos.Filename)
+	assert.Len(t, merged.Report.Linters, 1)
+}
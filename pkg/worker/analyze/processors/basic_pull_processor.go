@@ -5,17 +5,22 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path"
+	"path/filepath"
 	"runtime/debug"
 	"strings"
 	"time"
 
 	"github.com/golangci/golangci-api/pkg/worker/analyze/resources"
+	gh "github.com/google/go-github/github"
 
 	"github.com/aws/aws-sdk-go/aws/session"
 
 	"github.com/golangci/golangci-api/pkg/worker/analyze/linters"
+	"github.com/golangci/golangci-api/pkg/worker/analyze/linters/golinters"
 	"github.com/golangci/golangci-api/pkg/worker/analyze/linters/result"
 	"github.com/golangci/golangci-api/pkg/worker/analyze/logger"
+	"github.com/golangci/golangci-api/pkg/worker/analyze/monorepo"
 	"github.com/golangci/golangci-api/pkg/worker/analyze/prstate"
 	"github.com/golangci/golangci-api/pkg/worker/analyze/reporters"
 	"github.com/golangci/golangci-api/pkg/worker/lib/errorutils"
@@ -24,15 +29,21 @@ import (
 	"github.com/golangci/golangci-api/pkg/worker/lib/github"
 	"github.com/golangci/golangci-api/pkg/worker/lib/goutils/workspaces"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 	redsync "gopkg.in/redsync.v1"
 
 	"github.com/golangci/golangci-api/internal/shared/config"
+	"github.com/golangci/golangci-api/internal/shared/tracing"
+	goenvconfig "github.com/golangci/golangci-api/pkg/goenvbuild/config"
 	envbuildresult "github.com/golangci/golangci-api/pkg/goenvbuild/result"
 	"github.com/golangci/golangci-api/pkg/worker/lib/experiments"
 )
 
 const (
 	patchPath = "../changes.patch"
+
+	// configValidationErrorMarker is an invisible mark of the config validation error comment
+	configValidationErrorMarker = "<!-- golangci: config validation error -->"
 )
 
 type StaticBasicPullConfig struct {
@@ -65,7 +76,7 @@ func NewBasicPull(cfg *BasicPullConfig) *BasicPull {
 	}
 }
 
-func storePatch(ctx context.Context, cfg config.Config, patch string, exec executors.Executor) error {
+func storePatch(ctx context.Context, cfg config.Config, patch, dst string, exec executors.Executor) error {
 	dir := cfg.GetString("PATCH_STORE_DIR")
 	if dir == "" {
 		dir = "/app"
@@ -80,13 +91,38 @@ func storePatch(ctx context.Context, cfg config.Config, patch string, exec execu
 		return errors.Wrapf(err, "can't write patch to temp file %s", f.Name())
 	}
 
-	if err = exec.CopyFile(ctx, patchPath, f.Name()); err != nil {
+	if err = exec.CopyFile(ctx, dst, f.Name()); err != nil {
 		return errors.Wrap(err, "can't copy patch file")
 	}
 
 	return nil
 }
 
+// withPatchPath returns linters analyzing only changes from the patch at patchPath
+func withPatchPath(lints []linters.Linter, patchPath string) []linters.Linter {
+	ret := make([]linters.Linter, 0, len(lints))
+	for _, l := range lints {
+		if gl, ok := l.(golinters.GolangciLint); ok {
+			gl.PatchPath = patchPath
+			l = gl
+		}
+		ret = append(ret, l)
+	}
+
+	return ret
+}
+
+// isForkPull returns true if the head of the pull request isn't in the base repo,
+// the head repo is nil if the fork was deleted
+func isForkPull(pull *gh.PullRequest) bool {
+	headRepo := pull.GetHead().GetRepo()
+	if headRepo == nil {
+		return true
+	}
+
+	return headRepo.GetFork() || !strings.EqualFold(headRepo.GetFullName(), pull.GetBase().GetRepo().GetFullName())
+}
+
 func (p BasicPull) getRepo(ctx *PullContext) *fetchers.Repo {
 	repo := ctx.ProviderCtx.Repo
 	return &fetchers.Repo{
@@ -94,10 +130,17 @@ func (p BasicPull) getRepo(ctx *PullContext) *fetchers.Repo {
 		Ref:       ctx.pull.GetHead().GetRef(),
 		CommitSHA: ctx.CommitSHA,
 		FullPath:  fmt.Sprintf("github.com/%s/%s", repo.Owner, repo.Name),
+
+		MergeRef:         fmt.Sprintf("refs/pull/%d/merge", ctx.pull.GetNumber()),
+		MergeRefCloneURL: ctx.ProviderCtx.GetCloneURL(ctx.pull.GetBase().GetRepo()),
 	}
 }
 
 func (p BasicPull) updateAnalysisState(ctx *PullContext, res *result.Result, status github.Status, publicError string) {
+	p.saveAnalysisState(ctx, res, "processed/"+string(status), publicError)
+}
+
+func (p BasicPull) saveAnalysisState(ctx *PullContext, res *result.Result, status, publicError string) {
 	publicError = escapeText(publicError, ctx)
 
 	if ctx.res.buildLog != nil {
@@ -120,7 +163,7 @@ func (p BasicPull) updateAnalysisState(ctx *PullContext, res *result.Result, sta
 		issuesCount = len(res.Issues)
 	}
 	s := &prstate.State{
-		Status:              "processed/" + string(status),
+		Status:              status,
 		ReportedIssuesCount: issuesCount,
 		ResultJSON:          resJSON,
 	}
@@ -142,6 +185,9 @@ func (p *BasicPull) processWithGuaranteedGithubStatus(ctx *PullContext) error {
 		// temporary error, don't show it to user
 		return err
 	}
+	if errors.Cause(err) == errSuperseded {
+		return err
+	}
 
 	ctx.Ctx = context.Background() // no timeout for state and status saving: it must be durable
 
@@ -183,14 +229,24 @@ func (p *BasicPull) analyze(ctx *PullContext) (*result.Result, error) {
 	var res *result.Result
 	var err error
 	ctx.res.trackTiming("Analysis", func() {
-		ctx.res.buildLog.RunNewGroupVoid("analyze", func(sg *envbuildresult.StepGroup) {
-			res, err = p.Runner.Run(ctx.Ctx, sg, p.Linters, p.Exec, ctx.buildConfig)
-		})
+		res, err = p.runLinters(ctx)
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	// forks run untrusted prepare commands: don't let them poison
+	// the build cache shared with analyzes of the default branch
+	if !isForkPull(ctx.pull) {
+		p.Wi.SaveCache(ctx.Ctx, ctx.res.buildLog)
+	}
+
+	// don't post stale review comments
+	if err = p.checkSuperseded(ctx); err != nil {
+		return nil, err
+	}
+
+	res.Issues = p.filterSuppressedIssues(ctx, res.Issues)
 	issues := res.Issues
 	ctx.LogCtx["reportedIssues"] = len(issues)
 
@@ -226,6 +282,83 @@ func (p *BasicPull) analyze(ctx *PullContext) (*result.Result, error) {
 	return res, nil
 }
 
+// filterSuppressedIssues removes issues suppressed in the repo by baseline or manually
+func (p BasicPull) filterSuppressedIssues(ctx *PullContext, issues []result.Issue) []result.Issue {
+	fingerprints, err := p.State.GetSuppressedFingerprints(ctx.Ctx, ctx.repo().Owner, ctx.repo().Name, ctx.AnalysisGUID)
+	if err != nil {
+		ctx.Log.Warnf("Can't get suppressed fingerprints to filter suppressed issues: %s", err)
+		return issues
+	}
+
+	if len(fingerprints) == 0 {
+		return issues
+	}
+
+	suppressed := map[string]bool{}
+	for _, fp := range fingerprints {
+		suppressed[fp] = true
+	}
+
+	var ret []result.Issue
+	for _, issue := range issues {
+		if !suppressed[issue.Fingerprint()] {
+			ret = append(ret, issue)
+		}
+	}
+
+	ctx.LogCtx["suppressedIssues"] = len(issues) - len(ret)
+	return ret
+}
+
+func (p *BasicPull) runLinters(ctx *PullContext) (*result.Result, error) {
+	var res *result.Result
+	var err error
+
+	modules := ctx.buildConfig.Modules
+	if len(modules) == 0 {
+		ctx.res.buildLog.RunNewGroupVoid("analyze", func(sg *envbuildresult.StepGroup) {
+			res, err = p.Runner.Run(ctx.Ctx, sg, p.Linters, p.Exec, ctx.buildConfig)
+		})
+		return res, err
+	}
+
+	// analyze only modules touched by the pull request
+	modulePatches := monorepo.SplitPatch(ctx.patch, modules)
+	results := map[string]*result.Result{}
+	for i, module := range modules {
+		modulePatch, ok := modulePatches[module]
+		if !ok {
+			continue
+		}
+
+		moduleDir := path.Join(p.Exec.WorkDir(), module)
+		moduleExec := p.Exec.WithWorkDir(moduleDir)
+		ctx.res.buildLog.RunNewGroupVoid(fmt.Sprintf("analyze module %s", module), func(sg *envbuildresult.StepGroup) {
+			// store the module patch next to the pull request patch: the repo tree must stay unchanged
+			var modulePatchPath string
+			modulePatchPath, err = filepath.Rel(moduleDir, path.Join(p.Exec.WorkDir(), "..", fmt.Sprintf("changes.%d.patch", i)))
+			if err != nil {
+				err = errors.Wrapf(err, "can't build patch path of module %s", module)
+				return
+			}
+
+			sg.AddStep("store module patch")
+			if err = storePatch(ctx.Ctx, p.Cfg, modulePatch, modulePatchPath, moduleExec); err != nil {
+				err = errors.Wrapf(err, "can't store patch of module %s", module)
+				return
+			}
+
+			moduleLinters := withPatchPath(p.Linters, modulePatchPath)
+			results[module], err = p.Runner.Run(ctx.Ctx, sg, moduleLinters, moduleExec, ctx.buildConfig)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return monorepo.MergeResults(modules, results)
+}
+
 func (p BasicPull) setCommitStatus(ctx *PullContext, status github.Status, desc string) {
 	desc = escapeText(desc, ctx)
 
@@ -252,10 +385,11 @@ func (p BasicPull) preparePatch(ctx *PullContext) error {
 		}
 
 		sg.AddStep("copy patch to /tmp/golangci.diff")
-		if err = storePatch(ctx.Ctx, p.Cfg, patch, p.Exec); err != nil {
+		if err = storePatch(ctx.Ctx, p.Cfg, patch, patchPath, p.Exec); err != nil {
 			return errors.Wrap(err, "can't store patch")
 		}
 
+		ctx.patch = patch
 		return nil
 	})
 }
@@ -275,7 +409,12 @@ func (p *BasicPull) setupWorkspace(ctx *PullContext) error {
 
 		publicError := fmt.Sprintf("failed to setup workspace: %s", err)
 		p.updateAnalysisState(ctx, nil, github.StatusError, publicError)
-		p.setCommitStatus(ctx, github.StatusError, "failed to setup")
+		if verr, ok := errors.Cause(err).(*goenvconfig.ValidationError); ok {
+			p.setCommitStatus(ctx, github.StatusError, "invalid config")
+			p.commentConfigValidationError(ctx, verr)
+		} else {
+			p.setCommitStatus(ctx, github.StatusError, "failed to setup")
+		}
 		return errors.Wrapf(err, "failed to setup workspace")
 	}
 
@@ -284,6 +423,50 @@ func (p *BasicPull) setupWorkspace(ctx *PullContext) error {
 	return nil
 }
 
+func (p *BasicPull) commentConfigValidationError(ctx *PullContext, verr *goenvconfig.ValidationError) {
+	head := ctx.pull.GetHead()
+	fileURL := fmt.Sprintf("%s/blob/%s/%s", head.GetRepo().GetHTMLURL(), ctx.CommitSHA, verr.FileName)
+
+	var b strings.Builder
+	b.WriteString(configValidationErrorMarker + "\n")
+	fmt.Fprintf(&b, "GolangCI can't analyze this pull request: config [%s](%s) is invalid.\n\n", verr.FileName, fileURL)
+	for _, i := range verr.Issues {
+		if i.Line != 0 {
+			fmt.Fprintf(&b, "- [%s:%d](%s#L%d): ", verr.FileName, i.Line, fileURL, i.Line)
+		} else {
+			b.WriteString("- ")
+		}
+		fmt.Fprintf(&b, "%s\n", i)
+	}
+
+	if err := p.upsertConfigValidationComment(ctx, b.String()); err != nil {
+		ctx.Log.Warnf("Failed to comment config validation error: %s", err)
+	}
+}
+
+// upsertConfigValidationComment keeps one config validation comment per pull request:
+// every failed analysis would add a new comment otherwise.
+func (p *BasicPull) upsertConfigValidationComment(ctx *PullContext, body string) error {
+	comments, err := p.ProviderClient.GetIssueComments(ctx.Ctx, ctx.ProviderCtx)
+	if err != nil {
+		return errors.Wrap(err, "can't get comments")
+	}
+
+	for _, c := range comments {
+		if !strings.HasPrefix(c.GetBody(), configValidationErrorMarker) {
+			continue
+		}
+
+		if c.GetBody() == body {
+			return nil // the same error was already commented
+		}
+
+		return p.ProviderClient.EditIssueComment(ctx.Ctx, ctx.ProviderCtx, c.GetID(), body)
+	}
+
+	return p.ProviderClient.CreateIssueComment(ctx.Ctx, ctx.ProviderCtx, body)
+}
+
 func (p *BasicPull) fetchProviderPullRequest(ctx *PullContext) error {
 	pull, err := p.ProviderClient.GetPullRequest(ctx.Ctx, ctx.ProviderCtx)
 	if err != nil {
@@ -316,9 +499,37 @@ func (p *BasicPull) updateStatusToProcessing(ctx *PullContext) {
 	}
 }
 
+var errSuperseded = errors.New("analysis was superseded by a newer one")
+
+// checkSuperseded returns errSuperseded if a newer commit of the pull request was pushed:
+// results of this analysis would be stale
+func (p BasicPull) checkSuperseded(ctx *PullContext) error {
+	curState, err := p.State.GetState(ctx.Ctx, ctx.repo().Owner, ctx.repo().Name, ctx.AnalysisGUID)
+	if err != nil {
+		ctx.Log.Warnf("Can't get current state to check for newer analyzes: %s", err)
+		return nil
+	}
+
+	if curState.SupersededBy == "" {
+		return nil
+	}
+
+	ctx.Log.Infof("Analysis was superseded by analysis %s, abort it", curState.SupersededBy)
+	return errSuperseded
+}
+
+func (p BasicPull) finishSuperseded(ctx *PullContext) {
+	ctx.Ctx = context.Background() // no timeout for state and status saving: it must be durable
+
+	p.saveAnalysisState(ctx, nil, StatusSuperseded, "")
+	// the commit wasn't analyzed: it must neither fail the pull request checks nor stay pending
+	p.setCommitStatus(ctx, github.StatusSuccess, "Skipped: superseded by a newer commit")
+}
+
 func (p BasicPull) Process(ctx *PullContext) error {
 	ctx.res = &analysisResult{
-		buildLog: envbuildresult.NewLog(nil),
+		resultCollector: resultCollector{analysisType: "pull"},
+		buildLog:        envbuildresult.NewLog(nil),
 	}
 
 	savedLog := ctx.Log
@@ -326,6 +537,12 @@ func (p BasicPull) Process(ctx *PullContext) error {
 	ctx.Log = logger.NewBuildLogger(ctx.res.buildLog, ctx.Log)
 
 	if err := p.processPanicSafe(ctx); err != nil {
+		if errors.Cause(err) == errSuperseded {
+			ctx.Log = savedLog
+			p.finishSuperseded(ctx)
+			return nil
+		}
+
 		if ctx.pull != nil {
 			pullTitle := strings.ToLower(ctx.pull.GetTitle())
 			if strings.HasPrefix(pullTitle, "wip ") || strings.HasPrefix(pullTitle, "wip:") {
@@ -340,6 +557,12 @@ func (p BasicPull) Process(ctx *PullContext) error {
 }
 
 func (p BasicPull) processPanicSafe(ctx *PullContext) (retErr error) {
+	spanCtx, span := tracing.StartSpan(ctx.Ctx, "BasicPull.Process",
+		attribute.String("analysis_guid", ctx.AnalysisGUID))
+	defer func() { tracing.EndSpan(span, retErr) }()
+	ctx.Ctx = spanCtx
+	ctx.res.traceCtx = spanCtx
+
 	defer func() {
 		if rerr := recover(); rerr != nil {
 			retErr = &errorutils.InternalError{
@@ -360,6 +583,11 @@ func (p BasicPull) processPanicSafe(ctx *PullContext) (retErr error) {
 			ctx.CommitSHA = ctx.pull.GetHead().GetSHA()
 		}
 
+		sg.AddStep(stepCheckSuperseded)
+		if err := p.checkSuperseded(ctx); err != nil {
+			return err
+		}
+
 		sg.AddStep(stepUpdateStatusToProcessing)
 		p.updateStatusToProcessing(ctx)
 		return nil
@@ -393,5 +621,10 @@ func (p BasicPull) processPanicSafe(ctx *PullContext) (retErr error) {
 
 	ctx.res.addTimingFrom("Prepare", startedAt)
 
+	// preparing can take a long time: check it before analysis
+	if err := p.checkSuperseded(ctx); err != nil {
+		return err
+	}
+
 	return p.processWithGuaranteedGithubStatus(ctx)
 }
@@ -0,0 +1,75 @@
+package processors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golangci/golangci-api/pkg/worker/lib/github"
+	gh "github.com/google/go-github/github"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpsertConfigValidationComment(t *testing.T) {
+	const body = configValidationErrorMarker + "\nconfig is invalid"
+	otherComment := &gh.IssueComment{ID: gh.Int(1), Body: gh.String("LGTM")}
+
+	cases := []struct {
+		name     string
+		comments []*gh.IssueComment
+		expect   func(c *github.MockClientMockRecorder)
+	}{
+		{
+			name:     "no comment yet",
+			comments: []*gh.IssueComment{otherComment},
+			expect: func(c *github.MockClientMockRecorder) {
+				c.CreateIssueComment(gomock.Any(), gomock.Any(), body).Return(nil)
+			},
+		},
+		{
+			name:     "same error was commented",
+			comments: []*gh.IssueComment{otherComment, {ID: gh.Int(2), Body: gh.String(body)}},
+			expect:   func(c *github.MockClientMockRecorder) {},
+		},
+		{
+			name: "another error was commented",
+			comments: []*gh.IssueComment{
+				otherComment,
+				{ID: gh.Int(2), Body: gh.String(configValidationErrorMarker + "\nold error")},
+			},
+			expect: func(c *github.MockClientMockRecorder) {
+				c.EditIssueComment(gomock.Any(), gomock.Any(), 2, body).Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			client := github.NewMockClient(ctrl)
+			client.EXPECT().GetIssueComments(gomock.Any(), gomock.Any()).Return(tc.comments, nil)
+			tc.expect(client.EXPECT())
+
+			p := BasicPull{BasicPullConfig{StaticBasicPullConfig: StaticBasicPullConfig{ProviderClient: client}}}
+			ctx := &PullContext{Ctx: context.Background(), ProviderCtx: &github.Context{}}
+			assert.NoError(t, p.upsertConfigValidationComment(ctx, body))
+		})
+	}
+}
+
+func TestIsForkPull(t *testing.T) {
+	pull := func(head *gh.Repository) *gh.PullRequest {
+		return &gh.PullRequest{
+			Head: &gh.PullRequestBranch{Repo: head},
+			Base: &gh.PullRequestBranch{Repo: &gh.Repository{FullName: gh.String("golangci/golangci-api")}},
+		}
+	}
+
+	assert.False(t, isForkPull(pull(&gh.Repository{FullName: gh.String("GolangCI/golangci-api")})))
+	assert.True(t, isForkPull(pull(&gh.Repository{FullName: gh.String("user/golangci-api"), Fork: gh.Bool(true)})))
+	assert.True(t, isForkPull(pull(&gh.Repository{FullName: gh.String("user/golangci-api")})))
+	assert.True(t, isForkPull(pull(nil)), "deleted fork")
+}
@@ -3,16 +3,20 @@ package processors
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	redigo "github.com/garyburd/redigo/redis"
 	"github.com/golangci/golangci-api/internal/shared/config"
 	"github.com/golangci/golangci-api/internal/shared/db/redis"
+	"github.com/golangci/golangci-api/internal/shared/providers/ratelimit"
 	redsync "gopkg.in/redsync.v1"
 
 	"github.com/pkg/errors"
 
 	"github.com/golangci/golangci-api/pkg/worker/analyze/linters"
 	"github.com/golangci/golangci-api/pkg/worker/analyze/linters/golinters"
+	"github.com/golangci/golangci-api/pkg/worker/analyze/lintregistry"
 	"github.com/golangci/golangci-api/pkg/worker/analyze/prstate"
 	"github.com/golangci/golangci-api/pkg/worker/analyze/reporters"
+	"github.com/golangci/golangci-api/pkg/worker/lib/buildcache"
 	"github.com/golangci/golangci-api/pkg/worker/lib/experiments"
 	"github.com/golangci/golangci-api/pkg/worker/lib/fetchers"
 	"github.com/golangci/golangci-api/pkg/worker/lib/github"
@@ -21,7 +25,8 @@ import (
 )
 
 type BasicPullProcessorFactory struct {
-	cfg *BasicPullConfig
+	cfg       *BasicPullConfig
+	redisPool *redigo.Pool
 }
 
 func NewBasicPullProcessorFactory(cfg *BasicPullConfig) *BasicPullProcessorFactory {
@@ -30,6 +35,19 @@ func NewBasicPullProcessorFactory(cfg *BasicPullConfig) *BasicPullProcessorFacto
 	}
 }
 
+// SetRedisPool makes processors share the redis pool instead of making a new one for every analysis
+func (pf *BasicPullProcessorFactory) SetRedisPool(p *redigo.Pool) {
+	pf.redisPool = p
+}
+
+func (pf BasicPullProcessorFactory) getRedisPool(cfg config.Config) (*redigo.Pool, error) {
+	if pf.redisPool != nil {
+		return pf.redisPool, nil
+	}
+
+	return redis.GetPool(cfg)
+}
+
 //nolint:gocyclo
 func (pf BasicPullProcessorFactory) BuildProcessor(ctx *PullContext) (PullProcessor, func(), error) {
 	cfg := *pf.cfg
@@ -39,7 +57,15 @@ func (pf BasicPullProcessorFactory) BuildProcessor(ctx *PullContext) (PullProces
 	}
 
 	if cfg.ProviderClient == nil {
-		cfg.ProviderClient = github.NewMyClient()
+		client := github.NewMyClient()
+		if cfg.Cfg.GetBool("PROVIDER_RATE_LIMIT_ENABLED", true) {
+			redisPool, err := pf.getRedisPool(cfg.Cfg)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "can't get redis pool")
+			}
+			client.SetRateLimiter(ratelimit.NewManager(redisPool, ctx.Log, cfg.Cfg))
+		}
+		cfg.ProviderClient = client
 	}
 
 	if cfg.AwsSess == nil {
@@ -77,7 +103,14 @@ func (pf BasicPullProcessorFactory) BuildProcessor(ctx *PullContext) (PullProces
 	}
 
 	if cfg.Wi == nil {
-		cfg.Wi = workspaces.NewGo(cfg.Exec, ctx.Log, cfg.RepoFetcher)
+		buildCache, err := buildcache.NewFromConfig(cfg.Cfg, cfg.AwsSess, ctx.Log)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "can't make build cache")
+		}
+		registry := lintregistry.NewAPIStorage(httputils.NewGrequestsClient(map[string]string{
+			"X-Internal-Access-Token": cfg.Cfg.GetString("INTERNAL_ACCESS_TOKEN"),
+		}))
+		cfg.Wi = workspaces.NewGo(cfg.Exec, ctx.Log, cfg.RepoFetcher, registry, buildCache)
 	}
 
 	if cfg.Linters == nil {
@@ -103,7 +136,7 @@ func (pf BasicPullProcessorFactory) BuildProcessor(ctx *PullContext) (PullProces
 	}
 
 	if cfg.DistLockFactory == nil {
-		redisPool, err := redis.GetPool(cfg.Cfg)
+		redisPool, err := pf.getRedisPool(cfg.Cfg)
 		if err != nil {
 			ctx.Log.Fatalf("Can't get redis pool: %s", err)
 		}
@@ -143,6 +143,7 @@ func getNopState(ctrl *gomock.Controller) prstate.Storage {
 	r.EXPECT().GetState(any, any, any, any).AnyTimes().Return(&prstate.State{
 		Status: processors.StatusSentToQueue,
 	}, nil)
+	r.EXPECT().GetSuppressedFingerprints(any, any, any, any).AnyTimes().Return(nil, nil)
 	return r
 }
 
@@ -289,6 +290,51 @@ func TestSetCommitStatusOnReportingError(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestSupersededAnalysisIsNotReported(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	c := testProviderCtx
+	gc := github.NewMockClient(ctrl)
+	gc.EXPECT().GetPullRequest(testCtxMatcher, c).Return(testPR, nil)
+	gc.EXPECT().GetPullRequestPatch(any, any).AnyTimes().Return(getFakePatch(t), nil)
+
+	test.Init()
+	url := fmt.Sprintf("%s/r/github.com/%s/%s/pulls/%d", os.Getenv("WEB_ROOT"), c.Repo.Owner, c.Repo.Name, testPR.GetNumber())
+	gc.EXPECT().SetCommitStatus(any, c, testHeadSHA, github.StatusSuccess, "Skipped: superseded by a newer commit", url)
+
+	state := prstate.NewMockStorage(ctrl)
+	state.EXPECT().GetState(any, any, any, any).AnyTimes().Return(&prstate.State{
+		Status:       processors.StatusSentToQueue,
+		SupersededBy: "newer-guid",
+	}, nil)
+	state.EXPECT().UpdateState(any, any, any, testAnalysisGUID, stateWithStatus(processors.StatusSuperseded)).Return(nil)
+
+	p := getNopedProcessor(t, ctrl, &processors.BasicPullConfig{
+		Reporter: reporters.NewMockReporter(ctrl), // no reporting is expected
+		Exec:     executors.NewMockExecutor(ctrl),
+		StaticBasicPullConfig: processors.StaticBasicPullConfig{
+			Linters:        []linters.Linter{linters.NewMockLinter(ctrl)},
+			RepoFetcher:    fetchers.NewMockFetcher(ctrl),
+			ProviderClient: gc,
+			State:          state,
+		},
+	})
+
+	assert.NoError(t, p.Process(getTestPullCtx()))
+}
+
+type stateWithStatus string
+
+func (s stateWithStatus) Matches(x interface{}) bool {
+	state, ok := x.(*prstate.State)
+	return ok && state.Status == string(s)
+}
+
+func (s stateWithStatus) String() string {
+	return fmt.Sprintf("has status %s", string(s))
+}
+
 //nolint
 func getRealisticTestProcessor(pullCtx *processors.PullContext, t *testing.T, ctrl *gomock.Controller) processors.PullProcessor {
 	c := pullCtx.ProviderCtx
@@ -8,9 +8,11 @@ const (
 	StatusProcessed   = "processed"
 	StatusNotFound    = "not_found"
 	StatusError       = "error"
+	StatusSuperseded  = "superseded"
 
 	noGoFilesToAnalyzeMessage = "No Go files to analyze"
 	noGoFilesToAnalyzeErr     = "no go files to analyze"
 
 	stepUpdateStatusToProcessing = `set analysis status to "processing"`
+	stepCheckSuperseded          = "check for newer commits"
 )
@@ -28,6 +28,7 @@ type PullContext struct {
 	res         *analysisResult
 	savedLog    logutil.Log
 	buildConfig *config.Service
+	patch       string
 }
 
 func (ctx *PullContext) repo() *github.Repo {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path"
 	"runtime/debug"
 	"time"
 
@@ -19,6 +20,8 @@ import (
 	envconfig "github.com/golangci/golangci-api/pkg/goenvbuild/config"
 	"github.com/golangci/golangci-api/pkg/goenvbuild/result"
 	"github.com/golangci/golangci-api/pkg/worker/analyze/linters"
+	linterresult "github.com/golangci/golangci-api/pkg/worker/analyze/linters/result"
+	"github.com/golangci/golangci-api/pkg/worker/analyze/monorepo"
 	"github.com/golangci/golangci-api/pkg/worker/analyze/repostate"
 	"github.com/golangci/golangci-api/pkg/worker/lib/errorutils"
 	"github.com/golangci/golangci-api/pkg/worker/lib/executors"
@@ -79,7 +82,8 @@ func NewRepo(cfg *RepoConfig) *Repo {
 
 func (r Repo) Process(ctx *RepoContext) error {
 	res := analysisResult{
-		buildLog: result.NewLog(nil),
+		resultCollector: resultCollector{analysisType: "repo"},
+		buildLog:        result.NewLog(nil),
 	}
 
 	savedLogger := ctx.Log
@@ -167,21 +171,61 @@ func (r *Repo) prepare(ctx *RepoContext, res *analysisResult) error {
 
 	r.Exec = exec
 	ctx.BuildConfig = buildConfig
+	res.golangciLintVersion = r.Wi.GolangciLintVersion()
+	res.golangciLintVersionRange = buildConfig.GolangciLintVersion
 	return nil
 }
 
 func (r Repo) analyze(ctx *RepoContext, res *analysisResult) error {
 	defer res.addTimingFrom("Analysis", time.Now())
 
-	return res.buildLog.RunNewGroup("analyze", func(sg *result.StepGroup) error {
-		lintRes, err := r.Runner.Run(ctx.Ctx, sg, r.Linters, r.Exec, ctx.BuildConfig)
+	modules := ctx.BuildConfig.Modules
+	if len(modules) == 0 {
+		err := res.buildLog.RunNewGroup("analyze", func(sg *result.StepGroup) error {
+			lintRes, err := r.Runner.Run(ctx.Ctx, sg, r.Linters, r.Exec, ctx.BuildConfig)
+			if err != nil {
+				return err
+			}
+
+			res.lintRes = lintRes
+			return nil
+		})
 		if err != nil {
 			return err
 		}
+	} else if err := r.analyzeModules(ctx, res, modules); err != nil {
+		return err
+	}
 
-		res.lintRes = lintRes
-		return nil
-	})
+	r.Wi.SaveCache(ctx.Ctx, res.buildLog)
+	return nil
+}
+
+func (r Repo) analyzeModules(ctx *RepoContext, res *analysisResult, modules []string) error {
+	results := map[string]*linterresult.Result{}
+	for _, module := range modules {
+		moduleExec := r.Exec.WithWorkDir(path.Join(r.Exec.WorkDir(), module))
+		err := res.buildLog.RunNewGroup(fmt.Sprintf("analyze module %s", module), func(sg *result.StepGroup) error {
+			lintRes, err := r.Runner.Run(ctx.Ctx, sg, r.Linters, moduleExec, ctx.BuildConfig)
+			if err != nil {
+				return err
+			}
+
+			results[module] = lintRes
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	lintRes, err := monorepo.MergeResults(modules, results)
+	if err != nil {
+		return errors.Wrap(err, "failed to merge results of modules")
+	}
+
+	res.lintRes = lintRes
+	return nil
 }
 
 func buildFetchersRepo(ctx *RepoContext) *fetchers.Repo {
@@ -213,6 +257,9 @@ func (r Repo) submitResult(ctx *RepoContext, res *analysisResult, status, public
 			Timings:  res.timings,
 			Warnings: res.warnings,
 			Error:    publicError,
+
+			GolangciLintVersion:      res.golangciLintVersion,
+			GolangciLintVersionRange: res.golangciLintVersionRange,
 		},
 		BuildLog: res.buildLog,
 	}
@@ -1,15 +1,15 @@
 package processors
 
 import (
-	"os"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/golangci/golangci-api/internal/shared/apperrors"
 	"github.com/golangci/golangci-api/internal/shared/config"
 	"github.com/golangci/golangci-api/pkg/worker/analyze/linters"
 	"github.com/golangci/golangci-api/pkg/worker/analyze/linters/golinters"
+	"github.com/golangci/golangci-api/pkg/worker/analyze/lintregistry"
 	"github.com/golangci/golangci-api/pkg/worker/analyze/repostate"
+	"github.com/golangci/golangci-api/pkg/worker/lib/buildcache"
 	"github.com/golangci/golangci-api/pkg/worker/lib/experiments"
 	"github.com/golangci/golangci-api/pkg/worker/lib/fetchers"
 	"github.com/golangci/golangci-api/pkg/worker/lib/goutils/workspaces"
@@ -31,6 +31,11 @@ func NewRepoProcessorFactory(cfg *StaticRepoConfig) *RepoProcessorFactory {
 func (f RepoProcessorFactory) BuildProcessor(ctx *RepoContext) (*Repo, func(), error) {
 	cfg := *f.cfg
 
+	if cfg.Cfg == nil {
+		envCfg := config.NewEnvConfig(ctx.Log)
+		cfg.Cfg = envCfg
+	}
+
 	if cfg.RepoFetcher == nil {
 		cfg.RepoFetcher = fetchers.NewGit()
 	}
@@ -47,15 +52,10 @@ func (f RepoProcessorFactory) BuildProcessor(ctx *RepoContext) (*Repo, func(), e
 
 	if cfg.State == nil {
 		cfg.State = repostate.NewAPIStorage(httputils.NewGrequestsClient(map[string]string{
-			"X-Internal-Access-Token": os.Getenv("INTERNAL_ACCESS_TOKEN"),
+			"X-Internal-Access-Token": cfg.Cfg.GetString("INTERNAL_ACCESS_TOKEN"),
 		}))
 	}
 
-	if cfg.Cfg == nil {
-		envCfg := config.NewEnvConfig(ctx.Log)
-		cfg.Cfg = envCfg
-	}
-
 	if cfg.Et == nil {
 		cfg.Et = apperrors.GetTracker(cfg.Cfg, ctx.Log, "worker")
 	}
@@ -79,13 +79,23 @@ func (f RepoProcessorFactory) BuildProcessor(ctx *RepoContext) (*Repo, func(), e
 		return nil, nil, errors.Wrap(err, "can't make executor")
 	}
 
+	buildCache, err := buildcache.NewFromConfig(cfg.Cfg, cfg.AwsSess, ctx.Log)
+	if err != nil {
+		exec.Clean()
+		return nil, nil, errors.Wrap(err, "can't make build cache")
+	}
+
+	registry := lintregistry.NewAPIStorage(httputils.NewGrequestsClient(map[string]string{
+		"X-Internal-Access-Token": cfg.Cfg.GetString("INTERNAL_ACCESS_TOKEN"),
+	}))
+
 	cleanup := func() {
 		exec.Clean()
 	}
 	p := NewRepo(&RepoConfig{
 		StaticRepoConfig: cfg,
 		Exec:             exec,
-		Wi:               workspaces.NewGo(exec, ctx.Log, cfg.RepoFetcher),
+		Wi:               workspaces.NewGo(exec, ctx.Log, cfg.RepoFetcher, registry, buildCache),
 		Ec:               ec,
 	})
 
@@ -1,9 +1,12 @@
 package processors
 
 import (
+	"context"
 	"strconv"
 	"time"
 
+	"github.com/golangci/golangci-api/internal/shared/metrics"
+	"github.com/golangci/golangci-api/internal/shared/tracing"
 	"github.com/golangci/golangci-api/pkg/goenvbuild/result"
 	lintersResult "github.com/golangci/golangci-api/pkg/worker/analyze/linters/result"
 )
@@ -12,6 +15,9 @@ type analysisResult struct {
 	resultCollector
 	buildLog *result.Log
 	lintRes  *lintersResult.Result
+
+	golangciLintVersion      string // empty if the workspace wasn't set up
+	golangciLintVersionRange string // empty if the config has no golangci-lint-version
 }
 
 type JSONDuration time.Duration
@@ -37,22 +43,27 @@ type Warning struct {
 type resultCollector struct {
 	timings  []Timing
 	warnings []Warning
+
+	analysisType string          // "pull" or "repo", it's used for metrics
+	traceCtx     context.Context // if set, timings are recorded as spans
 }
 
 func (r *resultCollector) trackTiming(name string, f func()) {
 	startedAt := time.Now()
 	f()
-	r.timings = append(r.timings, Timing{
-		Name:     name,
-		Duration: JSONDuration(time.Since(startedAt)),
-	})
+	r.addTimingFrom(name, startedAt)
 }
 
 func (r *resultCollector) addTimingFrom(name string, from time.Time) {
+	d := time.Since(from)
 	r.timings = append(r.timings, Timing{
 		Name:     name,
-		Duration: JSONDuration(time.Since(from)),
+		Duration: JSONDuration(d),
 	})
+	metrics.ObserveAnalysisStage(r.analysisType, name, d)
+	if r.traceCtx != nil {
+		tracing.RecordSpan(r.traceCtx, name, from)
+	}
 }
 
 func (r *resultCollector) publicWarn(tag string, text string) {
@@ -66,6 +77,9 @@ type workerRes struct {
 	Timings  []Timing  `json:",omitempty"`
 	Warnings []Warning `json:",omitempty"`
 	Error    string    `json:",omitempty"`
+
+	GolangciLintVersion      string `json:",omitempty"` // without the "v" prefix
+	GolangciLintVersionRange string `json:",omitempty"` // from the config, empty for the default version
 }
 
 type resultJSON struct {
@@ -45,3 +45,21 @@ func (s APIStorage) GetState(ctx context.Context, owner, name, analysisID string
 
 	return &state, nil
 }
+
+func (s APIStorage) GetSuppressedFingerprints(ctx context.Context, owner, name, analysisID string) ([]string, error) {
+	bodyReader, err := s.client.Get(ctx, s.getStatusURL(owner, name, analysisID)+"?with_suppressed=1")
+	if err != nil {
+		return nil, err
+	}
+
+	defer bodyReader.Close()
+
+	var state struct {
+		SuppressedFingerprints []string
+	}
+	if err = json.NewDecoder(bodyReader).Decode(&state); err != nil {
+		return nil, fmt.Errorf("can't read json body: %s", err)
+	}
+
+	return state.SuppressedFingerprints, nil
+}
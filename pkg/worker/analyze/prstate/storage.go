@@ -12,9 +12,13 @@ type State struct {
 	Status              string
 	ReportedIssuesCount int
 	ResultJSON          interface{}
+	SupersededBy        string `json:",omitempty"` // guid of a newer analysis of the same pull request
 }
 
 type Storage interface {
 	UpdateState(ctx context.Context, owner, name, analysisID string, state *State) error
 	GetState(ctx context.Context, owner, name, analysisID string) (*State, error)
+
+	// GetSuppressedFingerprints returns fingerprints of issues suppressed in the repo: they aren't reported
+	GetSuppressedFingerprints(ctx context.Context, owner, name, analysisID string) ([]string, error)
 }
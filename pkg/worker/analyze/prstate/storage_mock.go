@@ -61,3 +61,18 @@ func (mr *MockStorageMockRecorder) GetState(ctx, owner, name, analysisID interfa
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetState", reflect.TypeOf((*MockStorage)(nil).GetState), ctx, owner, name, analysisID)
 }
+
+// GetSuppressedFingerprints mocks base method
+func (m *MockStorage) GetSuppressedFingerprints(ctx context.Context, owner, name, analysisID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSuppressedFingerprints", ctx, owner, name, analysisID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSuppressedFingerprints indicates an expected call of GetSuppressedFingerprints
+func (mr *MockStorageMockRecorder) GetSuppressedFingerprints(ctx, owner, name, analysisID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuppressedFingerprints", reflect.TypeOf((*MockStorage)(nil).GetSuppressedFingerprints), ctx, owner, name, analysisID)
+}
@@ -25,7 +25,7 @@ type GithubReviewer struct {
 
 func NewGithubReviewer(c *github.Context, client github.Client, ec *experiments.Checker) *GithubReviewer {
 	accessToken := os.Getenv("GITHUB_REVIEWER_ACCESS_TOKEN")
-	if accessToken != "" { // review as special user
+	if accessToken != "" && c.GithubInstallationID == 0 { // review as special user
 		cCopy := *c
 		cCopy.GithubAccessToken = accessToken
 		c = &cCopy
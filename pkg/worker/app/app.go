@@ -1,8 +1,11 @@
 package app
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"sync"
+	"time"
 
 	"github.com/golangci/golangci-api/pkg/worker/lib/experiments"
 
@@ -10,18 +13,25 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	redigo "github.com/garyburd/redigo/redis"
 	"github.com/golangci/golangci-api/internal/shared/apperrors"
+	"github.com/golangci/golangci-api/internal/shared/cache"
 	"github.com/golangci/golangci-api/internal/shared/config"
 	"github.com/golangci/golangci-api/internal/shared/db/redis"
 	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/metrics"
+	"github.com/golangci/golangci-api/internal/shared/providers/githubapp"
 	"github.com/golangci/golangci-api/internal/shared/queue/aws/consumer"
 	"github.com/golangci/golangci-api/internal/shared/queue/aws/sqs"
 	"github.com/golangci/golangci-api/internal/shared/queue/consumers"
+	"github.com/golangci/golangci-api/internal/shared/queue/pause"
 	"github.com/golangci/golangci-api/internal/shared/queue/producers"
+	"github.com/golangci/golangci-api/internal/shared/tracing"
 	analyzesConsumers "github.com/golangci/golangci-api/pkg/worker/analyze/analyzequeue/consumers"
 	"github.com/golangci/golangci-api/pkg/worker/analyze/analyzesqueue"
 	"github.com/golangci/golangci-api/pkg/worker/analyze/analyzesqueue/pullanalyzesqueue"
 	"github.com/golangci/golangci-api/pkg/worker/analyze/analyzesqueue/repoanalyzesqueue"
+	"github.com/golangci/golangci-api/pkg/worker/analyze/analyzesqueue/scheduler"
 	"github.com/golangci/golangci-api/pkg/worker/analyze/processors"
+	"github.com/golangci/golangci-api/pkg/worker/lib/buildcache"
 	redsync "gopkg.in/redsync.v1"
 )
 
@@ -77,7 +87,9 @@ func (a *App) buildDeps() {
 		a.distLockFactory = redsync.New([]redsync.Pool{a.redisPool})
 	}
 	if a.ppf == nil {
-		a.ppf = processors.NewBasicPullProcessorFactory(&processors.BasicPullConfig{})
+		ppf := processors.NewBasicPullProcessorFactory(&processors.BasicPullConfig{})
+		ppf.SetRedisPool(a.redisPool)
+		a.ppf = ppf
 	}
 	if a.ec == nil {
 		a.ec = experiments.NewChecker(a.cfg, a.trackedLog)
@@ -95,6 +107,28 @@ func (a App) buildMultiplexer(log logutil.Log) *consumers.Multiplexer {
 	pullAnalyzer := analyzesConsumers.NewAnalyzePR(a.ppf, log, a.errTracker, a.cfg, a.ec)
 	pullAnalyzesRunner := pullanalyzesqueue.NewConsumer(pullAnalyzer)
 
+	if a.cfg.GetBool("SCHEDULER_ENABLED", false) {
+		s := scheduler.NewScheduler(a.redisPool, log,
+			a.cfg.GetDuration("SCHEDULER_SLOT_TIMEOUT", scheduler.DefaultSlotTimeout))
+		repoAnalyzesRunner.SetScheduler(s, log)
+		pullAnalyzesRunner.SetScheduler(s, log)
+	}
+
+	if githubapp.IsConfigured(a.cfg) {
+		redisURL, err := redis.GetURL(a.cfg)
+		if err != nil {
+			log.Fatalf("Can't get redis url: %s", err)
+		}
+
+		// share installation tokens cache with api
+		app, err := githubapp.NewFromConfig(a.cfg, cache.NewRedis(redisURL+"/1"), log)
+		if err != nil {
+			log.Fatalf("Can't make GitHub App: %s", err)
+		}
+		repoAnalyzesRunner.SetGithubApp(app)
+		pullAnalyzesRunner.SetGithubApp(app)
+	}
+
 	multiplexer := consumers.NewMultiplexer()
 	multiplexer.SetResultLogger(func(error) {}) // already logged, no double logging
 
@@ -143,7 +177,54 @@ func (a App) BuildTestDeps() *TestDeps {
 	}
 }
 
+func (a App) runMetricsServer() {
+	addr := fmt.Sprintf(":%d", a.cfg.GetInt("METRICS_PORT", 9090))
+	a.log.Infof("Serving metrics on %s/metrics...", addr)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		a.log.Errorf("Can't listen HTTP on %s: %s", addr, err)
+	}
+}
+
+func (a App) runBuildCacheEviction() {
+	buildCache, err := buildcache.NewFromConfig(a.cfg, a.awsSess, a.trackedLog)
+	if err != nil {
+		a.log.Errorf("Can't make build cache: %s", err)
+		return
+	}
+	if buildCache == nil {
+		return
+	}
+
+	interval := a.cfg.GetDuration("BUILD_CACHE_EVICT_INTERVAL", time.Hour)
+	for range time.Tick(interval) {
+		// only one worker evicts archives at a time
+		mutex := a.distLockFactory.NewMutex("locks/buildcache/evict",
+			redsync.SetExpiry(interval), redsync.SetTries(1))
+		if err = mutex.Lock(); err != nil {
+			continue
+		}
+
+		if err = buildCache.Evict(context.Background()); err != nil {
+			a.trackedLog.Warnf("Failed to evict build cache: %s", err)
+		}
+		mutex.Unlock()
+	}
+}
+
 func (a App) Run() {
+	shutdownTracing, err := tracing.Init(a.cfg, "golangci-worker")
+	if err != nil {
+		a.log.Fatalf("Can't init tracing: %s", err)
+	}
+	tracing.ShutdownOnSignal(a.log, shutdownTracing)
+	defer tracing.Shutdown(a.log, shutdownTracing)
+
+	go a.runMetricsServer()
+	go a.runBuildCacheEviction()
+
 	consumersCount := a.cfg.GetInt("CONSUMERS_COUNT", 1)
 	a.log.Infof("Starting %d consumers...", consumersCount)
 
@@ -160,6 +241,7 @@ func (a App) Run() {
 				a.awsSess, trackedLog, analyzesqueue.VisibilityTimeoutSec)
 			consumer := consumer.NewSQS(trackedLog, a.cfg, analyzesSQS,
 				consumerMultiplexer, "analyzes", analyzesqueue.VisibilityTimeoutSec)
+			consumer.SetPauseStorage(pause.NewRedis(a.redisPool))
 
 			consumer.Run()
 		}(i)
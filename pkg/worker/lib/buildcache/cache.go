@@ -0,0 +1,220 @@
+package buildcache
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/golangci/golangci-api/internal/shared/config"
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/pkg/goenvbuild/result"
+	"github.com/golangci/golangci-api/pkg/worker/lib/executors"
+	"github.com/pkg/errors"
+)
+
+const (
+	rootDir     = "/tmp/golangci-build-cache"
+	archivePath = "/tmp/golangci-build-cache.tar.gz"
+	noGoSumHash = "no-go-sum"
+)
+
+// Environment returns env vars pointing all caches into the directory we archive:
+// modules cache, go build cache and golangci-lint cache.
+func Environment() map[string]string {
+	return map[string]string{
+		"GOMODCACHE":          path.Join(rootDir, "mod"),
+		"GOCACHE":             path.Join(rootDir, "go-build"),
+		"GOLANGCI_LINT_CACHE": path.Join(rootDir, "golangci-lint"),
+	}
+}
+
+type Cache struct {
+	storage Storage
+	log     logutil.Log
+
+	maxSize int64
+	maxAge  time.Duration
+
+	// refreshAge is the age after which a restored archive is saved again:
+	// it updates go build caches and keeps hot archives from eviction by age
+	refreshAge time.Duration
+}
+
+func NewCache(storage Storage, log logutil.Log, maxSize int64, maxAge, refreshAge time.Duration) *Cache {
+	return &Cache{
+		storage:    storage,
+		log:        log,
+		maxSize:    maxSize,
+		maxAge:     maxAge,
+		refreshAge: refreshAge,
+	}
+}
+
+// NewFromConfig returns nil cache if the build cache is disabled.
+func NewFromConfig(cfg config.Config, awsSess *session.Session, log logutil.Log) (*Cache, error) {
+	var storage Storage
+	switch storageType := cfg.GetString("BUILD_CACHE_STORAGE"); storageType {
+	case "":
+		return nil, nil
+	case "fs":
+		root := cfg.GetString("BUILD_CACHE_FS_ROOT")
+		if root == "" {
+			return nil, errors.New("no BUILD_CACHE_FS_ROOT for fs build cache storage")
+		}
+		storage = NewFS(root)
+	case "s3":
+		bucket := cfg.GetString("BUILD_CACHE_S3_BUCKET")
+		if bucket == "" {
+			return nil, errors.New("no BUILD_CACHE_S3_BUCKET for s3 build cache storage")
+		}
+		storage = NewS3(awsSess, cfg.GetString("BUILD_CACHE_S3_ENDPOINT"), bucket, cfg.GetString("BUILD_CACHE_S3_PREFIX"))
+	default:
+		return nil, fmt.Errorf("invalid BUILD_CACHE_STORAGE %q", storageType)
+	}
+
+	const defaultMaxSizeMB = 50 * 1024
+	maxSize := int64(cfg.GetInt("BUILD_CACHE_MAX_SIZE_MB", defaultMaxSizeMB)) * 1024 * 1024
+	maxAge := cfg.GetDuration("BUILD_CACHE_MAX_AGE", 7*24*time.Hour)
+	refreshAge := cfg.GetDuration("BUILD_CACHE_REFRESH_AGE", 24*time.Hour)
+	return NewCache(storage, log, maxSize, maxAge, refreshAge), nil
+}
+
+// Key builds cache key by repo and hash of all go.sum files of the repo
+// including nested modules, exec must be in the repo root.
+func (c Cache) Key(ctx context.Context, sg *result.StepGroup, exec executors.Executor, repo string) string {
+	return path.Join(strings.ToLower(repo), c.goSumsHash(ctx, sg, exec)) + ".tar.gz"
+}
+
+func (c Cache) goSumsHash(ctx context.Context, sg *result.StepGroup, exec executors.Executor) string {
+	findArgs := []string{".", "-name", "go.sum", "-not", "-path", "*/vendor/*"}
+	sg.AddStepCmd("find", findArgs...)
+	out, err := exec.Run(ctx, "find", findArgs...)
+	if err != nil {
+		sg.LastStep().AddOutputLine(fmt.Sprintf("Failed to find go.sum files: %s", err))
+		return noGoSumHash
+	}
+
+	goSums := strings.Fields(out.StdOut)
+	if len(goSums) == 0 {
+		sg.LastStep().AddOutputLine("No go.sum was found")
+		return noGoSumHash
+	}
+	sort.Strings(goSums) // find doesn't guarantee the order
+
+	sg.AddStepCmd("sha256sum", goSums...)
+	out, err = exec.Run(ctx, "sha256sum", goSums...)
+	if err != nil {
+		sg.LastStep().AddOutputLine(fmt.Sprintf("Failed to hash go.sum files: %s", err))
+		return noGoSumHash
+	}
+
+	// output contains paths of go.sum files: moving of modules changes the key too
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(out.StdOut)))
+}
+
+// Restore returns true if the cache should be saved after the build:
+// there is no cache for the key or it's older than the refresh age.
+func (c Cache) Restore(ctx context.Context, sg *result.StepGroup, exec executors.Executor, key string) (bool, error) {
+	sg.AddStep(fmt.Sprintf("find cache %s", key))
+	o, err := c.storage.Stat(ctx, key)
+	if err != nil {
+		if err == ErrNotFound {
+			sg.LastStep().AddOutputLine("Cache wasn't found")
+			return true, nil
+		}
+		return false, errors.Wrap(err, "failed to stat cache")
+	}
+
+	url, err := c.storage.DownloadURL(ctx, key)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get cache download url")
+	}
+
+	// don't print url: it can contain credentials
+	sg.AddStep("download cache")
+	if _, err = exec.Run(ctx, "curl", "-sSfL", "-o", archivePath, url); err != nil {
+		return false, errors.Wrap(err, "failed to download cache")
+	}
+
+	if err = c.run(ctx, sg, exec, "mkdir", "-p", rootDir); err != nil {
+		return false, err
+	}
+	if err = c.run(ctx, sg, exec, "tar", "-xzf", archivePath, "-C", rootDir); err != nil {
+		return false, err
+	}
+	if err = c.run(ctx, sg, exec, "rm", "-f", archivePath); err != nil {
+		return false, err
+	}
+
+	return time.Since(o.ModifiedAt) > c.refreshAge, nil
+}
+
+func (c Cache) Save(ctx context.Context, sg *result.StepGroup, exec executors.Executor, key string) error {
+	if err := c.run(ctx, sg, exec, "tar", "-czf", archivePath, "-C", rootDir, "."); err != nil {
+		return err
+	}
+
+	url, err := c.storage.UploadURL(ctx, key)
+	if err != nil {
+		return errors.Wrap(err, "failed to get cache upload url")
+	}
+
+	sg.AddStep(fmt.Sprintf("upload cache %s", key))
+	if _, err = exec.Run(ctx, "curl", "-sSf", "-T", archivePath, url); err != nil {
+		return errors.Wrap(err, "failed to upload cache")
+	}
+
+	return c.run(ctx, sg, exec, "rm", "-f", archivePath)
+}
+
+func (c Cache) run(ctx context.Context, sg *result.StepGroup, exec executors.Executor, name string, args ...string) error {
+	sg.AddStepCmd(name, args...)
+	out, err := exec.Run(ctx, name, args...)
+	if err != nil {
+		if out != nil {
+			sg.LastStep().AddOutput(out.StdOut)
+		}
+		return errors.Wrapf(err, "failed to run %s", name)
+	}
+
+	return nil
+}
+
+// Evict removes archives older than max age and the oldest archives
+// exceeding max total size. It lists all archives, therefore it's run periodically.
+func (c Cache) Evict(ctx context.Context) error {
+	objects, err := c.storage.List(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list cache archives")
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].ModifiedAt.After(objects[j].ModifiedAt)
+	})
+
+	var totalSize int64
+	deletedN := 0
+	for _, o := range objects {
+		totalSize += o.Size
+		if time.Since(o.ModifiedAt) <= c.maxAge && totalSize <= c.maxSize {
+			continue
+		}
+
+		if err = c.storage.Delete(ctx, o.Key); err != nil {
+			return errors.Wrapf(err, "failed to delete cache archive %s", o.Key)
+		}
+		totalSize -= o.Size
+		deletedN++
+	}
+
+	if deletedN != 0 {
+		c.log.Infof("Evicted %d build cache archives, total size is %dMB now", deletedN, totalSize/1024/1024)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package buildcache
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/pkg/goenvbuild/result"
+	"github.com/golangci/golangci-api/pkg/worker/lib/executors"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeArchive(t *testing.T, root, key string, size int, modifiedAt time.Time) {
+	p := filepath.Join(root, key)
+	assert.NoError(t, os.MkdirAll(filepath.Dir(p), os.ModePerm))
+	assert.NoError(t, ioutil.WriteFile(p, make([]byte, size), os.ModePerm))
+	assert.NoError(t, os.Chtimes(p, modifiedAt, modifiedAt))
+}
+
+func listKeys(t *testing.T, s Storage) []string {
+	objects, err := s.List(context.Background())
+	assert.NoError(t, err)
+
+	var keys []string
+	for _, o := range objects {
+		keys = append(keys, o.Key)
+	}
+	return keys
+}
+
+func TestEvict(t *testing.T) {
+	root, err := ioutil.TempDir("", "buildcache")
+	assert.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	now := time.Now()
+	writeArchive(t, root, "github.com/a/b/1.tar.gz", 10, now.Add(-time.Minute))
+	writeArchive(t, root, "github.com/a/b/2.tar.gz", 10, now.Add(-2*time.Minute))
+	writeArchive(t, root, "github.com/a/c/1.tar.gz", 10, now.Add(-3*time.Minute))
+	writeArchive(t, root, "github.com/a/c/2.tar.gz", 1, now.Add(-48*time.Hour))
+
+	s := NewFS(root)
+	c := NewCache(s, logutil.NewStderrLog("test"), 25, 24*time.Hour, time.Hour)
+	assert.NoError(t, c.Evict(context.Background()))
+
+	assert.ElementsMatch(t, []string{"github.com/a/b/1.tar.gz", "github.com/a/b/2.tar.gz"}, listKeys(t, s))
+}
+
+func TestFSDownloadURL(t *testing.T) {
+	root, err := ioutil.TempDir("", "buildcache")
+	assert.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	s := NewFS(root)
+	_, err = s.DownloadURL(context.Background(), "github.com/a/b/1.tar.gz")
+	assert.Equal(t, ErrNotFound, err)
+
+	writeArchive(t, root, "github.com/a/b/1.tar.gz", 1, time.Now())
+	url, err := s.DownloadURL(context.Background(), "github.com/a/b/1.tar.gz")
+	assert.NoError(t, err)
+	assert.Equal(t, "file://"+filepath.Join(root, "github.com/a/b/1.tar.gz"), url)
+}
+
+func TestKeyIncludesNestedGoSums(t *testing.T) {
+	exec, err := executors.NewTempDirShell(t.Name())
+	assert.NoError(t, err)
+	defer exec.Clean()
+
+	ctx := context.Background()
+	c := NewCache(NewFS(exec.WorkDir()), logutil.NewStderrLog("test"), 1, time.Hour, time.Hour)
+	key := func() string {
+		return c.Key(ctx, &result.StepGroup{}, exec, "github.com/A/B")
+	}
+
+	assert.Equal(t, "github.com/a/b/"+noGoSumHash+".tar.gz", key())
+
+	writeFile := func(p, content string) {
+		p = filepath.Join(exec.WorkDir(), p)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(p), os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(p, []byte(content), os.ModePerm))
+	}
+
+	writeFile("go.sum", "root")
+	rootKey := key()
+	assert.NotEqual(t, "github.com/a/b/"+noGoSumHash+".tar.gz", rootKey)
+
+	writeFile("tools/go.sum", "tools")
+	toolsKey := key()
+	assert.NotEqual(t, rootKey, toolsKey)
+
+	writeFile("vendor/x/go.sum", "vendored")
+	assert.Equal(t, toolsKey, key())
+
+	writeFile("tools/go.sum", "tools v2")
+	assert.NotEqual(t, toolsKey, key())
+}
+
+func TestRestoreReturnsNeedSave(t *testing.T) {
+	root, err := ioutil.TempDir("", "buildcache")
+	assert.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	ctx := context.Background()
+	c := NewCache(NewFS(root), logutil.NewStderrLog("test"), 1, time.Hour, time.Hour)
+
+	needSave, err := c.Restore(ctx, &result.StepGroup{}, nil, "github.com/a/b/1.tar.gz")
+	assert.NoError(t, err)
+	assert.True(t, needSave)
+}
@@ -0,0 +1,106 @@
+package buildcache
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// FS is a local filesystem storage. It can be used only with executors
+// sharing the filesystem with the worker, e.g. shell ones.
+type FS struct {
+	root string
+}
+
+var _ Storage = &FS{}
+
+func NewFS(root string) *FS {
+	return &FS{
+		root: root,
+	}
+}
+
+func (s FS) path(key string) string {
+	return filepath.Join(s.root, filepath.FromSlash(key))
+}
+
+func (s FS) Stat(_ context.Context, key string) (*Object, error) {
+	p := s.path(key)
+	info, err := os.Stat(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Wrapf(err, "failed to stat %s", p)
+	}
+
+	return &Object{
+		Key:        key,
+		Size:       info.Size(),
+		ModifiedAt: info.ModTime(),
+	}, nil
+}
+
+func (s FS) DownloadURL(_ context.Context, key string) (string, error) {
+	p := s.path(key)
+	if _, err := os.Stat(p); err != nil {
+		if os.IsNotExist(err) {
+			return "", ErrNotFound
+		}
+		return "", errors.Wrapf(err, "failed to stat %s", p)
+	}
+
+	return "file://" + p, nil
+}
+
+func (s FS) UploadURL(_ context.Context, key string) (string, error) {
+	p := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(p), os.ModePerm); err != nil {
+		return "", errors.Wrapf(err, "failed to make dir for %s", p)
+	}
+
+	return "file://" + p, nil
+}
+
+func (s FS) List(_ context.Context) ([]Object, error) {
+	var ret []Object
+	err := filepath.Walk(s.root, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && p == s.root {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		key, err := filepath.Rel(s.root, p)
+		if err != nil {
+			return err
+		}
+
+		ret = append(ret, Object{
+			Key:        filepath.ToSlash(key),
+			Size:       info.Size(),
+			ModifiedAt: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to walk %s", s.root)
+	}
+
+	return ret, nil
+}
+
+func (s FS) Delete(_ context.Context, key string) error {
+	p := s.path(key)
+	if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to remove %s", p)
+	}
+
+	return nil
+}
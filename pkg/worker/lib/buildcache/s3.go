@@ -0,0 +1,137 @@
+package buildcache
+
+import (
+	"context"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pkg/errors"
+)
+
+const presignTTL = 15 * time.Minute
+
+// S3 is a storage working with AWS S3 and S3-compatible services (minio, etc).
+type S3 struct {
+	client *s3.S3
+	bucket string
+	prefix string
+}
+
+var _ Storage = &S3{}
+
+func NewS3(awsSess *session.Session, endpoint, bucket, prefix string) *S3 {
+	awsCfg := aws.NewConfig()
+	if endpoint != "" {
+		awsCfg = awsCfg.WithEndpoint(endpoint).WithS3ForcePathStyle(true)
+	}
+
+	return &S3{
+		client: s3.New(awsSess, awsCfg),
+		bucket: bucket,
+		prefix: strings.Trim(prefix, "/"),
+	}
+}
+
+func (s S3) objectKey(key string) string {
+	return path.Join(s.prefix, key)
+}
+
+func (s S3) Stat(ctx context.Context, key string) (*Object, error) {
+	objectKey := s.objectKey(key)
+	out, err := s.client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && (aerr.Code() == "NotFound" || aerr.Code() == s3.ErrCodeNoSuchKey) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Wrapf(err, "failed to head object %s", objectKey)
+	}
+
+	return &Object{
+		Key:        key,
+		Size:       aws.Int64Value(out.ContentLength),
+		ModifiedAt: aws.TimeValue(out.LastModified),
+	}, nil
+}
+
+func (s S3) DownloadURL(ctx context.Context, key string) (string, error) {
+	if _, err := s.Stat(ctx, key); err != nil {
+		return "", err
+	}
+
+	objectKey := s.objectKey(key)
+	req, _ := s.client.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(objectKey),
+	})
+	url, err := req.Presign(presignTTL)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to presign download of %s", objectKey)
+	}
+
+	return url, nil
+}
+
+func (s S3) UploadURL(_ context.Context, key string) (string, error) {
+	objectKey := s.objectKey(key)
+	req, _ := s.client.PutObjectRequest(&s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(objectKey),
+	})
+	url, err := req.Presign(presignTTL)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to presign upload of %s", objectKey)
+	}
+
+	return url, nil
+}
+
+func (s S3) List(ctx context.Context) ([]Object, error) {
+	var ret []Object
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+	}
+	if s.prefix != "" {
+		input.Prefix = aws.String(s.prefix + "/")
+	}
+
+	err := s.client.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, o := range page.Contents {
+			key := aws.StringValue(o.Key)
+			if s.prefix != "" {
+				key = strings.TrimPrefix(key, s.prefix+"/")
+			}
+			ret = append(ret, Object{
+				Key:        key,
+				Size:       aws.Int64Value(o.Size),
+				ModifiedAt: aws.TimeValue(o.LastModified),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list objects in bucket %s", s.bucket)
+	}
+
+	return ret, nil
+}
+
+func (s S3) Delete(ctx context.Context, key string) error {
+	objectKey := s.objectKey(key)
+	_, err := s.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete object %s", objectKey)
+	}
+
+	return nil
+}
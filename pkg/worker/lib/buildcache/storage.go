@@ -0,0 +1,27 @@
+package buildcache
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+type Object struct {
+	Key        string
+	Size       int64
+	ModifiedAt time.Time
+}
+
+// Storage stores cache archives. Archives are transferred by the build container itself,
+// therefore storage gives out URLs instead of streaming the content through the worker.
+type Storage interface {
+	Stat(ctx context.Context, key string) (*Object, error)
+	DownloadURL(ctx context.Context, key string) (string, error)
+	UploadURL(ctx context.Context, key string) (string, error)
+
+	List(ctx context.Context) ([]Object, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -9,11 +9,13 @@ import (
 	"time"
 
 	"github.com/golangci/golangci-api/internal/shared/logutil"
+	"github.com/golangci/golangci-api/internal/shared/tracing"
 	"github.com/golangci/golangci-api/pkg/buildagent/build"
 	"github.com/golangci/golangci-api/pkg/buildagent/containers"
 
 	"github.com/levigross/grequests"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type Container struct {
@@ -55,15 +57,18 @@ func (c *Container) wrapExecutorError(err error) error {
 	return errors.Wrap(ErrExecutorFail, err.Error())
 }
 
-func (c *Container) Setup(ctx context.Context, _ *Requirements) error {
+func (c *Container) Setup(ctx context.Context, _ *Requirements) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "orchestrator.Setup")
+	defer func() { tracing.EndSpan(span, err) }()
+
 	resp, err := grequests.Post(fmt.Sprintf("%s/setup", c.orchestratorAddr), &grequests.RequestOptions{
 		Context: ctx,
 		JSON: containers.SetupContainerRequest{
 			TimeoutMs: 30 * 1000, // 30s
 		},
-		Headers: map[string]string{
+		Headers: tracing.InjectHeaders(ctx, map[string]string{
 			containers.TokenHeaderName: c.token,
-		},
+		}),
 	})
 	if err != nil {
 		return errors.Wrap(c.wrapExecutorError(err), "failed to make request to orchestrator")
@@ -112,13 +117,17 @@ func (c Container) Run(ctx context.Context, name string, args ...string) (*RunRe
 	return c.runBuildCommand(ctx, &req)
 }
 
-func (c Container) runBuildCommand(ctx context.Context, req *containers.BuildCommandRequest) (*RunResult, error) {
+func (c Container) runBuildCommand(ctx context.Context, req *containers.BuildCommandRequest) (_ *RunResult, err error) {
+	ctx, span := tracing.StartSpan(ctx, "orchestrator.BuildCommand",
+		attribute.String("kind", req.Kind))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	resp, err := grequests.Post(fmt.Sprintf("%s/buildcommand", c.orchestratorAddr), &grequests.RequestOptions{
 		Context: ctx,
 		JSON:    req,
-		Headers: map[string]string{
+		Headers: tracing.InjectHeaders(ctx, map[string]string{
 			containers.TokenHeaderName: c.token,
-		},
+		}),
 	})
 	if err != nil {
 		return nil, errors.Wrapf(c.wrapExecutorError(err), "failed to make request to orchestrator with req %#v", req)
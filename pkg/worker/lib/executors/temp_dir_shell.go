@@ -69,7 +69,7 @@ func (s TempDirShell) CopyFile(ctx context.Context, dst, src string) error {
 	}
 	defer from.Close()
 
-	to, err := os.OpenFile(dst, os.O_RDWR|os.O_CREATE, 0666)
+	to, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0666)
 	if err != nil {
 		return fmt.Errorf("can't open %s: %s", dst, err)
 	}
@@ -1,7 +1,10 @@
 package executors
 
 import (
+	"context"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -45,3 +48,22 @@ func TestTempDirShellClean(t *testing.T) {
 	ts.Clean()
 	assert.False(t, exists(t, ts.WorkDir()))
 }
+
+func TestTempDirShellCopyFileTruncates(t *testing.T) {
+	ts, err := NewTempDirShell(t.Name())
+	assert.NoError(t, err)
+	defer ts.Clean()
+
+	src := filepath.Join(ts.WorkDir(), "src")
+	copyContent := func(content string) {
+		assert.NoError(t, ioutil.WriteFile(src, []byte(content), os.ModePerm))
+		assert.NoError(t, ts.CopyFile(context.Background(), "dst", src))
+	}
+
+	copyContent("long content")
+	copyContent("short")
+
+	content, err := ioutil.ReadFile(filepath.Join(ts.WorkDir(), "dst"))
+	assert.NoError(t, err)
+	assert.Equal(t, "short", string(content))
+}
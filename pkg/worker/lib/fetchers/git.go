@@ -3,10 +3,11 @@ package fetchers
 import (
 	"context"
 	"strings"
+	"time"
 
+	"github.com/golangci/golangci-api/pkg/goenvbuild/config"
 	"github.com/golangci/golangci-api/pkg/goenvbuild/result"
 	"github.com/golangci/golangci-api/pkg/worker/analytics"
-
 	"github.com/golangci/golangci-api/pkg/worker/lib/executors"
 	"github.com/pkg/errors"
 )
@@ -20,94 +21,246 @@ func NewGit() *Git {
 	return &Git{}
 }
 
-func (gf Git) clone(ctx context.Context, sg *result.StepGroup, repo *Repo, exec executors.Executor) error {
-	args := []string{"clone", "-q", "--branch", repo.Ref, repo.CloneURL, "."}
+func (gf Git) run(ctx context.Context, sg *result.StepGroup, exec executors.Executor, args ...string) (string, error) {
 	gitStep := sg.AddStepCmd("git", args...)
+	runRes, err := exec.Run(ctx, "git", args...)
 
-	cloneRes, err := exec.Run(ctx, "git", args...)
-	if cloneRes != nil {
-		gitStep.AddOutput(cloneRes.StdOut)
-		gitStep.AddOutput(cloneRes.StdErr)
+	var out string
+	if runRes != nil {
+		gitStep.AddOutput(runRes.StdOut)
+		gitStep.AddOutput(runRes.StdErr)
+		out = runRes.StdOut + runRes.StdErr
+	}
+	if err != nil {
+		return out, errors.Wrapf(err, "can't run git cmd %v: %s", args, out)
 	}
 
+	return out, nil
+}
+
+func isNoBranchOrRepoOutput(out string) bool {
+	return strings.Contains(out, "could not read Username for") ||
+		strings.Contains(out, "Could not find remote branch") ||
+		strings.Contains(out, "couldn't find remote ref") ||
+		(strings.Contains(out, "Remote branch") && strings.Contains(out, "not found in upstream"))
+}
+
+// fetchOne fetches only one commit without history and checkouts it
+func (gf Git) fetchOne(ctx context.Context, sg *result.StepGroup, cloneURL, ref string, exec executors.Executor) error {
+	if _, err := gf.run(ctx, sg, exec, "init", "-q", "."); err != nil {
+		return err
+	}
+
+	// set remote by config to be able to call it twice
+	if _, err := gf.run(ctx, sg, exec, "config", "remote.origin.url", cloneURL); err != nil {
+		return err
+	}
+
+	out, err := gf.run(ctx, sg, exec, "fetch", "-q", "--depth=1", "origin", ref)
 	if err != nil {
-		var out string
-		if cloneRes != nil {
-			out = cloneRes.StdOut + cloneRes.StdErr
+		if strings.Contains(out, "could not read Username for") {
+			return ErrNoBranchOrRepo
 		}
-		noBranchOrRepo := strings.Contains(out, "could not read Username for") ||
-			strings.Contains(out, "Could not find remote branch") ||
-			(strings.Contains(out, "Remote branch") && strings.Contains(out, "not found in upstream"))
-		if noBranchOrRepo {
+		if strings.Contains(out, "not our ref") || strings.Contains(out, "couldn't find remote ref") {
+			if isCommitSHA(ref) {
+				return ErrNoCommit
+			}
 			return ErrNoBranchOrRepo
 		}
 
-		return errors.Wrapf(err, "can't run git cmd %v: %s", args, out)
+		return err
+	}
+
+	if _, err = gf.run(ctx, sg, exec, "checkout", "-q", "FETCH_HEAD"); err != nil {
+		return err
 	}
 
 	return nil
 }
 
-func (gf Git) checkout(ctx context.Context, sg *result.StepGroup, repo *Repo, exec executors.Executor) error {
-	if repo.CommitSHA == "" {
-		// TODO: remove it, remporary
-		return nil
+func isCommitSHA(ref string) bool {
+	if len(ref) != 40 {
+		return false
 	}
 
-	args := []string{"checkout", "-q", repo.CommitSHA}
-	gitStep := sg.AddStepCmd("git", args...)
+	for _, c := range ref {
+		if !strings.ContainsRune("0123456789abcdef", c) {
+			return false
+		}
+	}
 
-	checkoutRes, err := exec.Run(ctx, "git", args...)
-	if checkoutRes != nil {
-		gitStep.AddOutput(checkoutRes.StdOut)
-		gitStep.AddOutput(checkoutRes.StdErr)
+	return true
+}
+
+func (gf Git) shallowClone(ctx context.Context, sg *result.StepGroup, repo *Repo, exec executors.Executor) error {
+	ref := repo.CommitSHA
+	if ref == "" {
+		ref = repo.Ref
+	}
+
+	return gf.fetchOne(ctx, sg, repo.CloneURL, ref, exec)
+}
+
+// unshallow fetches the history of the ref into the shallow clone
+func (gf Git) unshallow(ctx context.Context, sg *result.StepGroup, repo *Repo, exec executors.Executor, extraArgs ...string) error {
+	out, err := gf.run(ctx, sg, exec, "rev-parse", "--is-shallow-repository")
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(out) == "false" {
+		return nil // the fetched commit has no parents
 	}
 
+	args := append([]string{"fetch", "-q", "--unshallow"}, extraArgs...)
+	args = append(args, "origin", repo.Ref)
+	out, err = gf.run(ctx, sg, exec, args...)
 	if err != nil {
-		var out string
-		if checkoutRes != nil {
-			out = checkoutRes.StdOut + checkoutRes.StdErr
+		if isNoBranchOrRepoOutput(out) {
+			return ErrNoBranchOrRepo
 		}
-		if strings.Contains(out, "did not match any file") {
-			return ErrNoCommit
+
+		return err
+	}
+
+	return nil
+}
+
+func (gf Git) partialUnshallow(ctx context.Context, sg *result.StepGroup, repo *Repo, exec executors.Executor) error {
+	// blobs of the checked out commit are already fetched, others are fetched on demand
+	if _, err := gf.run(ctx, sg, exec, "config", "remote.origin.promisor", "true"); err != nil {
+		return err
+	}
+	if _, err := gf.run(ctx, sg, exec, "config", "remote.origin.partialclonefilter", "blob:none"); err != nil {
+		return err
+	}
+
+	return gf.unshallow(ctx, sg, repo, exec, "--filter=blob:none")
+}
+
+// commitParents parses parents from the output of git cat-file -p
+func commitParents(catFileOut string) []string {
+	var ret []string
+	for _, line := range strings.Split(catFileOut, "\n") {
+		if line == "" {
+			break // end of headers
+		}
+
+		if strings.HasPrefix(line, "parent ") {
+			ret = append(ret, strings.TrimPrefix(line, "parent "))
+		}
+	}
+
+	return ret
+}
+
+// checkoutMergeRef checkouts the merge commit of the pull request if it contains the head commit.
+// The merge ref is updated by the provider asynchronously and can be stale, then the head commit is analyzed.
+func (gf Git) checkoutMergeRef(ctx context.Context, sg *result.StepGroup, repo *Repo, exec executors.Executor) error {
+	out, err := gf.run(ctx, sg, exec, "fetch", "-q", "--depth=1", repo.MergeRefCloneURL, repo.MergeRef)
+	if err != nil {
+		if isNoBranchOrRepoOutput(out) {
+			// there is no merge ref if the pull request has conflicts
+			sg.LastStep().AddOutputLine("No merge ref %s, analyzing the head commit", repo.MergeRef)
+			return nil
 		}
 
-		return errors.Wrapf(err, "can't run git cmd %v: %s", args, out)
+		return err
+	}
+
+	// cat-file works for shallow commits unlike rev-parse FETCH_HEAD^2
+	out, err = gf.run(ctx, sg, exec, "cat-file", "-p", "FETCH_HEAD")
+	if err != nil {
+		return err
+	}
+
+	for _, parent := range commitParents(out) {
+		if parent == repo.CommitSHA {
+			_, err = gf.run(ctx, sg, exec, "checkout", "-q", "FETCH_HEAD")
+			return err
+		}
 	}
 
+	sg.LastStep().AddOutputLine("Merge ref %s doesn't contain the head commit %s yet, analyzing the head commit",
+		repo.MergeRef, repo.CommitSHA)
 	return nil
 }
 
 func (gf Git) updateSubmodules(ctx context.Context, sg *result.StepGroup, exec executors.Executor) {
 	// some repos have deps in submodules, e.g. https://github.com/orbs-network/orbs-network-go
-	submoduleInitStep := sg.AddStepCmd("git", "submodule", "init")
-	runRes, err := exec.Run(ctx, "git", "submodule", "init")
-	submoduleInitStep.AddOutput(runRes.StdOut)
-	submoduleInitStep.AddOutput(runRes.StdErr)
-	if err != nil {
+	if _, err := gf.run(ctx, sg, exec, "submodule", "init"); err != nil {
 		analytics.Log(ctx).Infof("Failed to init git submodule: %s", err)
 		return
 	}
 
-	submoduleUpdateStep := sg.AddStepCmd("git", "submodule", "update", "--init", "--recursive")
-	runRes, err = exec.Run(ctx, "git", "submodule", "update", "--init", "--recursive")
-	submoduleUpdateStep.AddOutput(runRes.StdOut)
-	submoduleUpdateStep.AddOutput(runRes.StdErr)
-	if err != nil {
+	if _, err := gf.run(ctx, sg, exec, "submodule", "update", "--init", "--recursive"); err != nil {
 		analytics.Log(ctx).Infof("Failed to update git submodule: %s", err)
 		return
 	}
 }
 
+// loadCloneConfig reads the config file from the shallow clone to choose clone strategy.
+// Errors aren't fatal here: the config will be validated later by goenvbuild.
+func (gf Git) loadCloneConfig(ctx context.Context, sg *result.StepGroup, exec executors.Executor) config.CloneConfig {
+	defaultCfg := config.CloneConfig{}
+
+	for _, fileName := range config.FileNames {
+		runRes, err := exec.Run(ctx, "git", "show", "HEAD:"+fileName)
+		if err != nil {
+			continue
+		}
+
+		sg.AddStepCmd("git", "show", "HEAD:"+fileName)
+		cfg, err := config.Parse(fileName, []byte(runRes.StdOut))
+		if err != nil {
+			sg.LastStep().AddOutputLine("Failed to parse config: %s", err)
+			return defaultCfg
+		}
+
+		if err = cfg.Service.Clone.Validate(); err != nil {
+			sg.LastStep().AddOutputLine("Invalid clone config: %s", err)
+			return defaultCfg
+		}
+
+		return cfg.Service.Clone
+	}
+
+	sg.LastStep().AddOutputLine("No config file, using the default clone strategy")
+	return defaultCfg
+}
+
 func (gf Git) Fetch(ctx context.Context, sg *result.StepGroup, repo *Repo, exec executors.Executor) error {
-	if err := gf.clone(ctx, sg, repo, exec); err != nil {
+	startedAt := time.Now()
+
+	// every strategy starts from the shallow clone: it's enough to read the config
+	if err := gf.shallowClone(ctx, sg, repo, exec); err != nil {
 		return err
 	}
 
-	if err := gf.checkout(ctx, sg, repo, exec); err != nil {
+	cloneCfg := gf.loadCloneConfig(ctx, sg, exec)
+	strategy := cloneCfg.GetStrategy()
+	if strategy == config.CloneStrategyMergeRef && repo.MergeRef == "" {
+		strategy = config.CloneStrategyShallow // not a pull request
+	}
+
+	var err error
+	switch strategy {
+	case config.CloneStrategyShallow:
+	case config.CloneStrategyPartial:
+		err = gf.partialUnshallow(ctx, sg, repo, exec)
+	case config.CloneStrategyMergeRef:
+		err = gf.checkoutMergeRef(ctx, sg, repo, exec)
+	default:
+		err = gf.unshallow(ctx, sg, repo, exec)
+	}
+	if err != nil {
 		return err
 	}
+	sg.LastStep().AddOutputLine("Fetched the repo with %q clone strategy in %s",
+		strategy, time.Since(startedAt).Round(time.Millisecond))
+
+	if cloneCfg.Submodules {
+		gf.updateSubmodules(ctx, sg, exec)
+	}
 
-	gf.updateSubmodules(ctx, sg, exec)
 	return nil
 }
@@ -0,0 +1,86 @@
+package fetchers
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golangci/golangci-api/pkg/goenvbuild/result"
+	"github.com/golangci/golangci-api/pkg/worker/lib/executors"
+	"github.com/stretchr/testify/assert"
+)
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@test",
+		"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@test")
+	out, err := cmd.CombinedOutput()
+	assert.NoError(t, err, string(out))
+	return strings.TrimSpace(string(out))
+}
+
+func makeOriginRepo(t *testing.T, golangciConfig string) (string, string) {
+	dir, err := ioutil.TempDir("", "golangci.origin")
+	assert.NoError(t, err)
+
+	runGit(t, dir, "init", "-q", ".")
+	runGit(t, dir, "config", "uploadpack.allowFilter", "true")
+	runGit(t, dir, "config", "uploadpack.allowAnySHA1InWant", "true")
+	runGit(t, dir, "checkout", "-q", "-b", "test-branch")
+
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), os.ModePerm))
+	runGit(t, dir, "add", "main.go")
+	runGit(t, dir, "commit", "-q", "-m", "first")
+
+	if golangciConfig != "" {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, ".golangci.yml"), []byte(golangciConfig), os.ModePerm))
+		runGit(t, dir, "add", ".golangci.yml")
+	}
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "README.md"), []byte("readme\n"), os.ModePerm))
+	runGit(t, dir, "add", "README.md")
+	runGit(t, dir, "commit", "-q", "-m", "second")
+
+	return dir, runGit(t, dir, "rev-parse", "HEAD")
+}
+
+func testFetchWithConfig(t *testing.T, golangciConfig string, expectedHistoryLen int) *result.StepGroup {
+	originDir, commitSHA := makeOriginRepo(t, golangciConfig)
+	defer os.RemoveAll(originDir)
+
+	e, err := executors.NewTempDirShell("test.git")
+	assert.NoError(t, err)
+	defer e.Clean()
+
+	repo := &Repo{
+		Ref:       "test-branch",
+		CloneURL:  "file://" + originDir,
+		CommitSHA: commitSHA,
+	}
+	sg := &result.StepGroup{}
+	assert.NoError(t, NewGit().Fetch(context.Background(), sg, repo, e))
+
+	assert.Equal(t, commitSHA, runGit(t, e.WorkDir(), "rev-parse", "HEAD"))
+	assert.Len(t, strings.Split(runGit(t, e.WorkDir(), "log", "--format=%H"), "\n"), expectedHistoryLen)
+	assert.FileExists(t, filepath.Join(e.WorkDir(), "README.md"))
+	return sg
+}
+
+func TestFetchFullByDefault(t *testing.T) {
+	sg := testFetchWithConfig(t, "", 2)
+	assert.Contains(t, strings.Join(sg.LastStep().OutputLines, "\n"), `"full" clone strategy`)
+}
+
+func TestFetchShallow(t *testing.T) {
+	sg := testFetchWithConfig(t, "service:\n  clone:\n    strategy: shallow\n", 1)
+	assert.Contains(t, strings.Join(sg.LastStep().OutputLines, "\n"), `"shallow" clone strategy`)
+}
+
+func TestFetchPartial(t *testing.T) {
+	sg := testFetchWithConfig(t, "service:\n  clone:\n    strategy: partial\n", 2)
+	assert.Contains(t, strings.Join(sg.LastStep().OutputLines, "\n"), `"partial" clone strategy`)
+}
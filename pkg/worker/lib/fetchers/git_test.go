@@ -3,12 +3,17 @@ package fetchers
 import (
 	"context"
 	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/golangci/golangci-api/pkg/goenvbuild/result"
 
 	"github.com/golangci/golangci-api/pkg/worker/lib/executors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGitOnTestRepo(t *testing.T) {
@@ -33,3 +38,92 @@ func TestGitOnTestRepo(t *testing.T) {
 	assert.Equal(t, "README.md", files[1].Name())
 	assert.Equal(t, "main.go", files[2].Name())
 }
+
+func gitCmd(t *testing.T, dir string, args ...string) string {
+	args = append([]string{"-C", dir, "-c", "user.name=test", "-c", "user.email=test@golangci.com"}, args...)
+	out, err := exec.Command("git", args...).CombinedOutput()
+	require.NoError(t, err, string(out))
+	return strings.TrimSpace(string(out))
+}
+
+func commitFile(t *testing.T, dir, name, content string) string {
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), os.ModePerm))
+	gitCmd(t, dir, "add", name)
+	gitCmd(t, dir, "commit", "-q", "-m", "change "+name)
+	return gitCmd(t, dir, "rev-parse", "HEAD")
+}
+
+// makeLocalRepo makes the repo with the pull request: the base branch master and the head branch pr
+func makeLocalRepo(t *testing.T, strategy string) (string, string) {
+	dir, err := ioutil.TempDir("", "fetchers")
+	require.NoError(t, err)
+
+	gitCmd(t, dir, "init", "-q")
+	gitCmd(t, dir, "checkout", "-q", "-b", "master")
+	commitFile(t, dir, ".golangci.yml", "service:\n  clone:\n    strategy: "+strategy+"\n")
+	commitFile(t, dir, "base.go", "package main\n")
+
+	gitCmd(t, dir, "checkout", "-q", "-b", "pr")
+	headSHA := commitFile(t, dir, "pr.go", "package main\n")
+
+	gitCmd(t, dir, "checkout", "-q", "master")
+	gitCmd(t, dir, "merge", "-q", "--no-ff", "-m", "merge pr", "pr")
+	gitCmd(t, dir, "update-ref", "refs/pull/1/merge", "HEAD")
+	gitCmd(t, dir, "reset", "-q", "--hard", "HEAD^")
+	return dir, headSHA
+}
+
+func fetchLocalRepo(t *testing.T, src, headSHA string) (*executors.TempDirShell, *result.StepGroup) {
+	exec, err := executors.NewTempDirShell("test.git")
+	require.NoError(t, err)
+
+	sg := &result.StepGroup{}
+	err = NewGit().Fetch(context.Background(), sg, &Repo{
+		CloneURL:         "file://" + src,
+		Ref:              "pr",
+		CommitSHA:        headSHA,
+		MergeRef:         "refs/pull/1/merge",
+		MergeRefCloneURL: "file://" + src,
+	}, exec)
+	require.NoError(t, err)
+	return exec, sg
+}
+
+func TestGitStrategiesOnLocalRepo(t *testing.T) {
+	for _, strategy := range []string{"shallow", "full", "partial"} {
+		t.Run(strategy, func(t *testing.T) {
+			src, headSHA := makeLocalRepo(t, strategy)
+			defer os.RemoveAll(src)
+
+			exec, _ := fetchLocalRepo(t, src, headSHA)
+			defer exec.Clean()
+
+			assert.Equal(t, headSHA, gitCmd(t, exec.WorkDir(), "rev-parse", "HEAD"))
+			isShallow := gitCmd(t, exec.WorkDir(), "rev-parse", "--is-shallow-repository")
+			assert.Equal(t, strategy == "shallow", isShallow == "true")
+		})
+	}
+}
+
+func TestGitMergeRefStrategy(t *testing.T) {
+	src, headSHA := makeLocalRepo(t, "merge-ref")
+	defer os.RemoveAll(src)
+
+	exec, _ := fetchLocalRepo(t, src, headSHA)
+	defer exec.Clean()
+	assert.Equal(t, gitCmd(t, src, "rev-parse", "refs/pull/1/merge"), gitCmd(t, exec.WorkDir(), "rev-parse", "HEAD"))
+
+	// the provider didn't update the merge ref after the push yet
+	gitCmd(t, src, "checkout", "-q", "pr")
+	newHeadSHA := commitFile(t, src, "pr2.go", "package main\n")
+	gitCmd(t, src, "checkout", "-q", "master")
+
+	staleExec, sg := fetchLocalRepo(t, src, newHeadSHA)
+	defer staleExec.Clean()
+	assert.Equal(t, newHeadSHA, gitCmd(t, staleExec.WorkDir(), "rev-parse", "HEAD"))
+	var output []string
+	for _, step := range sg.Steps {
+		output = append(output, step.OutputLines...)
+	}
+	assert.Contains(t, strings.Join(output, "\n"), "doesn't contain the head commit")
+}
@@ -5,4 +5,9 @@ type Repo struct {
 	Ref       string
 	CommitSHA string
 	FullPath  string
+
+	// MergeRef is set only for pull requests: it's a ref to the merge commit
+	// of the pull request in the base repo, e.g. refs/pull/1/merge
+	MergeRef         string
+	MergeRefCloneURL string
 }
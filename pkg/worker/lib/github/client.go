@@ -14,6 +14,8 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/cenkalti/backoff"
+	"github.com/golangci/golangci-api/internal/shared/providers/provider"
+	"github.com/golangci/golangci-api/internal/shared/providers/ratelimit"
 	gh "github.com/google/go-github/github"
 	"github.com/sirupsen/logrus"
 )
@@ -27,6 +29,7 @@ var (
 	ErrUnauthorized          = errors.New("invalid authorization")
 	ErrUserIsBlocked         = errors.New("user is blocked")
 	ErrCommitIsNotPartOfPull = errors.New("commit is not part of the pull request")
+	ErrRateLimited           = provider.ErrRateLimited
 )
 
 func IsRecoverableError(err error) bool {
@@ -48,9 +51,14 @@ type Client interface {
 	GetPullRequestPatch(ctx context.Context, c *Context) (string, error)
 	CreateReview(ctx context.Context, c *Context, review *gh.PullRequestReviewRequest) error
 	SetCommitStatus(ctx context.Context, c *Context, ref string, status Status, desc, url string) error
+	GetIssueComments(ctx context.Context, c *Context) ([]*gh.IssueComment, error)
+	CreateIssueComment(ctx context.Context, c *Context, body string) error
+	EditIssueComment(ctx context.Context, c *Context, commentID int, body string) error
 }
 
-type MyClient struct{}
+type MyClient struct {
+	rateLimiter *ratelimit.Manager
+}
 
 var _ Client = &MyClient{}
 
@@ -58,7 +66,29 @@ func NewMyClient() *MyClient {
 	return &MyClient{}
 }
 
+// SetRateLimiter makes calls wait for the rate limit quota shared with the api
+func (gc *MyClient) SetRateLimiter(m *ratelimit.Manager) {
+	gc.rateLimiter = m
+}
+
+func (gc MyClient) httpClient(ctx context.Context, c *Context) *http.Client {
+	hc := c.GetHTTPClient(ctx)
+	if gc.rateLimiter != nil {
+		hc.Transport = gc.rateLimiter.NewTransport(c.GithubAccessToken, hc.Transport)
+	}
+	return hc
+}
+
+func (gc MyClient) client(ctx context.Context, c *Context) *gh.Client {
+	return gh.NewClient(gc.httpClient(ctx, c))
+}
+
 func transformGithubError(err error) error {
+	if ratelimit.IsRateLimitError(err) {
+		logrus.Warnf("Got rate limit error from github: %s", err)
+		return ErrRateLimited
+	}
+
 	if er, ok := err.(*gh.ErrorResponse); ok {
 		if er.Response.StatusCode == http.StatusNotFound {
 			logrus.Warnf("Got 404 from github: %+v", er)
@@ -87,7 +117,16 @@ func retryGet(f func() error) error {
 
 	bmr := backoff.WithMaxRetries(b, 5)
 
-	if err := backoff.Retry(f, bmr); err != nil {
+	retryable := func() error {
+		err := f()
+		if err != nil && ratelimit.IsRateLimitError(err) {
+			// retries would only spend the exhausted quota
+			return backoff.Permanent(err)
+		}
+		return err
+	}
+
+	if err := backoff.Retry(retryable, bmr); err != nil {
 		logrus.Warnf("Github operation failed to retry with %v and took %s: %s", b, b.GetElapsedTime(), err)
 		return err
 	}
@@ -99,7 +138,7 @@ func (gc *MyClient) GetPullRequest(ctx context.Context, c *Context) (*gh.PullReq
 	var retPR *gh.PullRequest
 
 	f := func() error {
-		pr, _, err := c.GetClient(ctx).PullRequests.Get(ctx, c.Repo.Owner, c.Repo.Name, c.PullRequestNumber)
+		pr, _, err := gc.client(ctx, c).PullRequests.Get(ctx, c.Repo.Owner, c.Repo.Name, c.PullRequestNumber)
 		if err != nil {
 			return err
 		}
@@ -142,7 +181,7 @@ func (gc *MyClient) CreateReview(ctx context.Context, c *Context, review *gh.Pul
 	const mediaTypeV3 = "application/vnd.github.v3+json"
 	req.Header.Set("Accept", mediaTypeV3)
 
-	resp, err := c.GetHTTPClient(ctx).Do(req)
+	resp, err := gc.httpClient(ctx, c).Do(req)
 	if err != nil {
 		return errors.Wrap(err, "failed to perform http request")
 	}
@@ -178,7 +217,7 @@ func (gc *MyClient) GetPullRequestPatch(ctx context.Context, c *Context) (string
 
 	f := func() error {
 		opts := gh.RawOptions{Type: gh.Diff}
-		raw, _, err := c.GetClient(ctx).PullRequests.GetRaw(ctx, c.Repo.Owner, c.Repo.Name,
+		raw, _, err := gc.client(ctx, c).PullRequests.GetRaw(ctx, c.Repo.Owner, c.Repo.Name,
 			c.PullRequestNumber, opts)
 		if err != nil {
 			return err
@@ -208,7 +247,7 @@ func (gc *MyClient) SetCommitStatus(ctx context.Context, c *Context, ref string,
 	if url != "" {
 		rs.TargetURL = gh.String(url)
 	}
-	_, _, err := c.GetClient(ctx).Repositories.CreateStatus(ctx, c.Repo.Owner, c.Repo.Name, ref, rs)
+	_, _, err := gc.client(ctx, c).Repositories.CreateStatus(ctx, c.Repo.Owner, c.Repo.Name, ref, rs)
 	if err != nil {
 		if terr := transformGithubError(err); terr != nil {
 			return terr
@@ -229,7 +268,7 @@ func (gc *MyClient) GetPullRequestComments(ctx context.Context, c *Context) ([]*
 				PerPage: 100, // max allowed value, TODO: fetch all comments if >100
 			},
 		}
-		comments, _, err := c.GetClient(ctx).PullRequests.ListComments(ctx, c.Repo.Owner, c.Repo.Name, c.PullRequestNumber, opt)
+		comments, _, err := gc.client(ctx, c).PullRequests.ListComments(ctx, c.Repo.Owner, c.Repo.Name, c.PullRequestNumber, opt)
 		if err != nil {
 			return err
 		}
@@ -248,3 +287,64 @@ func (gc *MyClient) GetPullRequestComments(ctx context.Context, c *Context) ([]*
 
 	return ret, nil
 }
+
+func (gc *MyClient) GetIssueComments(ctx context.Context, c *Context) ([]*gh.IssueComment, error) {
+	var ret []*gh.IssueComment
+
+	f := func() error {
+		opt := &gh.IssueListCommentsOptions{
+			ListOptions: gh.ListOptions{
+				PerPage: 100, // max allowed value, TODO: fetch all comments if >100
+			},
+		}
+		comments, _, err := gc.client(ctx, c).Issues.ListComments(ctx, c.Repo.Owner, c.Repo.Name, c.PullRequestNumber, opt)
+		if err != nil {
+			return err
+		}
+
+		ret = comments
+		return nil
+	}
+
+	if err := retryGet(f); err != nil {
+		if terr := transformGithubError(err); terr != nil {
+			return nil, terr
+		}
+
+		return nil, fmt.Errorf("can't get pull request %d issue comments from github: %s", c.PullRequestNumber, err)
+	}
+
+	return ret, nil
+}
+
+func (gc *MyClient) CreateIssueComment(ctx context.Context, c *Context, body string) error {
+	comment := &gh.IssueComment{
+		Body: gh.String(body),
+	}
+	_, _, err := gc.client(ctx, c).Issues.CreateComment(ctx, c.Repo.Owner, c.Repo.Name, c.PullRequestNumber, comment)
+	if err != nil {
+		if terr := transformGithubError(err); terr != nil {
+			return terr
+		}
+
+		return fmt.Errorf("can't create comment for pull request %d: %s", c.PullRequestNumber, err)
+	}
+
+	return nil
+}
+
+func (gc *MyClient) EditIssueComment(ctx context.Context, c *Context, commentID int, body string) error {
+	comment := &gh.IssueComment{
+		Body: gh.String(body),
+	}
+	_, _, err := gc.client(ctx, c).Issues.EditComment(ctx, c.Repo.Owner, c.Repo.Name, commentID, comment)
+	if err != nil {
+		if terr := transformGithubError(err); terr != nil {
+			return terr
+		}
+
+		return fmt.Errorf("can't edit comment %d of pull request %d: %s", commentID, c.PullRequestNumber, err)
+	}
+
+	return nil
+}
@@ -106,3 +106,46 @@ func (mr *MockClientMockRecorder) SetCommitStatus(ctx, c, ref, status, desc, url
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCommitStatus", reflect.TypeOf((*MockClient)(nil).SetCommitStatus), ctx, c, ref, status, desc, url)
 }
+
+// GetIssueComments mocks base method
+func (m *MockClient) GetIssueComments(ctx context.Context, c *Context) ([]*github0.IssueComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIssueComments", ctx, c)
+	ret0, _ := ret[0].([]*github0.IssueComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIssueComments indicates an expected call of GetIssueComments
+func (mr *MockClientMockRecorder) GetIssueComments(ctx, c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIssueComments", reflect.TypeOf((*MockClient)(nil).GetIssueComments), ctx, c)
+}
+
+// CreateIssueComment mocks base method
+func (m *MockClient) CreateIssueComment(ctx context.Context, c *Context, body string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIssueComment", ctx, c, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateIssueComment indicates an expected call of CreateIssueComment
+func (mr *MockClientMockRecorder) CreateIssueComment(ctx, c, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIssueComment", reflect.TypeOf((*MockClient)(nil).CreateIssueComment), ctx, c, body)
+}
+
+// EditIssueComment mocks base method
+func (m *MockClient) EditIssueComment(ctx context.Context, c *Context, commentID int, body string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditIssueComment", ctx, c, commentID, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EditIssueComment indicates an expected call of EditIssueComment
+func (mr *MockClientMockRecorder) EditIssueComment(ctx, c, commentID, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditIssueComment", reflect.TypeOf((*MockClient)(nil).EditIssueComment), ctx, c, commentID, body)
+}
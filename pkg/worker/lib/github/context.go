@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/golangci/golangci-api/internal/shared/metrics"
 	"github.com/google/go-github/github"
 	gh "github.com/google/go-github/github"
 	"golang.org/x/oauth2"
@@ -23,13 +24,19 @@ type Context struct {
 	Repo              Repo
 	GithubAccessToken string
 	PullRequestNumber int
+
+	// GithubInstallationID is set if the repo is accessed by GitHub App:
+	// GithubAccessToken is an installation token then and reviews are posted as the app
+	GithubInstallationID int `json:",omitempty"`
 }
 
 func (c Context) GetHTTPClient(ctx context.Context) *http.Client {
 	ts := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: c.GithubAccessToken},
 	)
-	return oauth2.NewClient(ctx, ts)
+	hc := oauth2.NewClient(ctx, ts)
+	hc.Transport = metrics.NewProviderTransport("github.com", hc.Transport)
+	return hc
 }
 
 func (c Context) GetClient(ctx context.Context) *github.Client {
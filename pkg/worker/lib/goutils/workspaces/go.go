@@ -10,6 +10,8 @@ import (
 
 	"github.com/golangci/golangci-api/internal/shared/logutil"
 	"github.com/golangci/golangci-api/pkg/goenvbuild/result"
+	"github.com/golangci/golangci-api/pkg/worker/analyze/lintregistry"
+	"github.com/golangci/golangci-api/pkg/worker/lib/buildcache"
 	"github.com/golangci/golangci-api/pkg/worker/lib/executors"
 	"github.com/golangci/golangci-api/pkg/worker/lib/fetchers"
 	"github.com/pkg/errors"
@@ -19,15 +21,24 @@ type Go struct {
 	exec        executors.Executor
 	log         logutil.Log
 	repoFetcher fetchers.Fetcher
+	registry    lintregistry.Storage
+
+	buildCache *buildcache.Cache // nil if disabled
+	cacheKey   string            // non-empty if cache needs to be saved
+
+	golangciLintVersion string
 }
 
 var _ Installer = &Go{}
 
-func NewGo(exec executors.Executor, log logutil.Log, repoFetcher fetchers.Fetcher) *Go {
+func NewGo(exec executors.Executor, log logutil.Log, repoFetcher fetchers.Fetcher,
+	registry lintregistry.Storage, buildCache *buildcache.Cache) *Go {
 	return &Go{
 		exec:        exec,
 		log:         log,
 		repoFetcher: repoFetcher,
+		registry:    registry,
+		buildCache:  buildCache,
 	}
 }
 
@@ -41,6 +52,13 @@ func (w *Go) Setup(ctx context.Context, buildLog *result.Log,
 		return nil, nil, groupErr
 	}
 
+	if w.buildCache != nil {
+		w.restoreCache(ctx, buildLog, repo)
+		for k, v := range buildcache.Environment() {
+			w.exec = w.exec.WithEnv(k, v)
+		}
+	}
+
 	exec := w.exec.
 		WithEnv("REPO", path.Join(projectPathParts...)).
 		WithEnv("FORMAT_JSON", "1")
@@ -51,6 +69,14 @@ func (w *Go) Setup(ctx context.Context, buildLog *result.Log,
 	var envbuildResult result.Result
 
 	groupErr = buildLog.RunNewGroup("run goenvbuild", func(sg *result.StepGroup) error {
+		// projectPathParts are provider, owner and repo name
+		sg.AddStep("load golangci-lint versions registry")
+		registry, err := w.registry.Get(ctx, projectPathParts[0], projectPathParts[1])
+		if err != nil {
+			return errors.Wrap(err, "failed to load golangci-lint versions registry")
+		}
+		exec = exec.WithEnv("GOLANGCI_LINT_REGISTRY", registry)
+
 		sg.AddStepCmd("goenvbuild")
 		runRes, err := exec.Run(ctx, "goenvbuild")
 		if err != nil {
@@ -75,9 +101,17 @@ func (w *Go) Setup(ctx context.Context, buildLog *result.Log,
 	}
 
 	if envbuildResult.Error != "" {
+		if config.HasValidationErrors(envbuildResult.ConfigIssues) {
+			return nil, nil, &config.ValidationError{
+				FileName: envbuildResult.ConfigFileName,
+				Issues:   envbuildResult.ConfigIssues,
+			}
+		}
 		return nil, nil, fmt.Errorf("goenvbuild internal error: %s", envbuildResult.Error)
 	}
 
+	w.golangciLintVersion = envbuildResult.GolangciLintVersion
+
 	retExec := w.exec.WithWorkDir(envbuildResult.WorkDir)
 	for k, v := range envbuildResult.Environment {
 		retExec = retExec.WithEnv(k, v)
@@ -85,3 +119,39 @@ func (w *Go) Setup(ctx context.Context, buildLog *result.Log,
 
 	return retExec, &envbuildResult.ServiceConfig, nil
 }
+
+func (w *Go) GolangciLintVersion() string {
+	return w.golangciLintVersion
+}
+
+func (w *Go) restoreCache(ctx context.Context, buildLog *result.Log, repo *fetchers.Repo) {
+	err := buildLog.RunNewGroup("restore build cache", func(sg *result.StepGroup) error {
+		key := w.buildCache.Key(ctx, sg, w.exec, repo.FullPath)
+		needSave, err := w.buildCache.Restore(ctx, sg, w.exec, key)
+		if err != nil {
+			return err
+		}
+
+		if needSave {
+			w.cacheKey = key
+		}
+		return nil
+	})
+	if err != nil {
+		// build cache is only an optimization: don't fail analysis
+		w.log.Warnf("Failed to restore build cache: %s", err)
+	}
+}
+
+func (w *Go) SaveCache(ctx context.Context, buildLog *result.Log) {
+	if w.buildCache == nil || w.cacheKey == "" {
+		return
+	}
+
+	err := buildLog.RunNewGroup("save build cache", func(sg *result.StepGroup) error {
+		return w.buildCache.Save(ctx, sg, w.exec, w.cacheKey)
+	})
+	if err != nil {
+		w.log.Warnf("Failed to save build cache: %s", err)
+	}
+}
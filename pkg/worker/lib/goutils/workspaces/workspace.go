@@ -13,4 +13,10 @@ import (
 type Installer interface {
 	Setup(ctx context.Context, buildLog *result.Log, privateAccessToken string,
 		repo *fetchers.Repo, projectPathParts ...string) (executors.Executor, *config.Service, error)
+
+	// GolangciLintVersion returns the golangci-lint version installed by Setup
+	GolangciLintVersion() string
+
+	// SaveCache saves build cache after a successful analysis, it's no-op if the cache was restored
+	SaveCache(ctx context.Context, buildLog *result.Log)
 }
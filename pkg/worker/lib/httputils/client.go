@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/golangci/golangci-api/internal/shared/tracing"
 	"github.com/golangci/golangci-api/pkg/worker/analytics"
 	"github.com/levigross/grequests"
 )
@@ -29,7 +30,7 @@ func NewGrequestsClient(header map[string]string) *GrequestsClient {
 func (c GrequestsClient) Get(ctx context.Context, url string) (io.ReadCloser, error) {
 	resp, err := grequests.Get(url, &grequests.RequestOptions{
 		Context: ctx,
-		Headers: c.header,
+		Headers: tracing.InjectHeaders(ctx, c.header),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("unable to make GET http request %q: %s", url, err)
@@ -49,7 +50,7 @@ func (c GrequestsClient) Get(ctx context.Context, url string) (io.ReadCloser, er
 func (c GrequestsClient) Put(ctx context.Context, url string, jsonObj interface{}) error {
 	resp, err := grequests.Put(url, &grequests.RequestOptions{
 		Context: ctx,
-		Headers: c.header,
+		Headers: tracing.InjectHeaders(ctx, c.header),
 		JSON:    jsonObj,
 	})
 	if err != nil {
@@ -46,3 +46,19 @@ func (f ProviderFactory) BuildForToken(providerName, accessToken string) (provid
 	}
 	return p, err
 }
+
+func (f ProviderFactory) BuildForRepo(db *gorm.DB, repo *models.Repo) (provider.Provider, error) {
+	p, err := f.orig.BuildForRepo(db, repo)
+	if p != nil {
+		p = f.transformer(p)
+	}
+	return p, err
+}
+
+func (f ProviderFactory) BuildForInstallation(providerName string, installationID int) (provider.Provider, error) {
+	p, err := f.orig.BuildForInstallation(providerName, installationID)
+	if p != nil {
+		p = f.transformer(p)
+	}
+	return p, err
+}